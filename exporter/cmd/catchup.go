@@ -19,6 +19,7 @@ import (
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/healthz"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
 )
 
@@ -89,10 +90,18 @@ func (c *CatchUpConfig) Exec(ctx context.Context, args []string) error {
 	}
 
 	// initialize grpc clients
-	clients, launchers, err := initGrpcClients(cfg)
+	clients, launchers, spools, err := initGrpcClients(cfg)
 	if err != nil {
 		return fmt.Errorf("initialize grpc clients: %w", err)
 	}
+	defer closeSpools(spools)
+
+	// initialize trace sinks
+	sinks, err := initTraceSinks(cfg)
+	if err != nil {
+		return fmt.Errorf("initialize trace sinks: %w", err)
+	}
+	defer closeTraceSinks(sinks)
 
 	// setup exporter
 	exp := exporter.New()
@@ -101,19 +110,24 @@ func (c *CatchUpConfig) Exec(ctx context.Context, args []string) error {
 			return fmt.Errorf("add grpc client: %w", err)
 		}
 	}
-	g := &run.Group{}
+	for _, sink := range sinks {
+		if err := exp.AddTraceSink(sink); err != nil {
+			return fmt.Errorf("add trace sink: %w", err)
+		}
+	}
+	ctrl := tasks.NewController(glab, exp, tasks.ControllerConfig{
+		GitLab:     cfg.GitLab,
+		Projects:   cfg.Projects,
+		Namespaces: cfg.Namespaces,
 
-	{ // controller
-		ctrl := tasks.NewController(glab, exp, tasks.ControllerConfig{
-			GitLab:     cfg.GitLab,
-			Projects:   cfg.Projects,
-			Namespaces: cfg.Namespaces,
+		Export: cfg.Export,
 
-			Export: cfg.Export,
+		CatchUpInterval: 24 * time.Hour,
+	})
 
-			CatchUpInterval: 24 * time.Hour,
-		})
+	g := &run.Group{}
 
+	{ // controller
 		ctx, cancel := context.WithCancel(context.Background())
 
 		g.Add(func() error { // execute
@@ -159,18 +173,29 @@ func (c *CatchUpConfig) Exec(ctx context.Context, args []string) error {
 		})
 	}
 
+	if len(spools) > 0 { // spool replay
+		g.Add(replaySpools(clients, spools))
+	}
+
 	if cfg.HTTP.Enabled {
 		colls := []prometheus.Collector{
 			collectors.NewGoCollector(),
 			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 		}
+		colls = append(colls, glab.MetricsCollector(), ctrl.MetricsCollector(), exp.MetricsCollector())
 		for _, client := range clients {
 			colls = append(colls, client.MetricsCollector())
 		}
+		for _, s := range spools {
+			colls = append(colls, s.MetricsCollector())
+		}
 		reg := prometheus.NewRegistry()
 		reg.MustRegister(colls...)
 
-		g.Add(serveHTTP(cfg.HTTP, reg))
+		health := healthz.NewHandler()
+		health.SetReadinessCheck(readinessCheck(glab, clients, launchers))
+
+		g.Add(serveHTTP(cfg.HTTP, reg, health, nil))
 	}
 
 	{ // signal handler
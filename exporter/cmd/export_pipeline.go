@@ -15,8 +15,6 @@ import (
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
 
 	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ExportPipelineConfig struct {
@@ -96,7 +94,7 @@ func (c *ExportPipelineConfig) Exec(ctx context.Context, args []string) error {
 	}
 	recorderConfigs = append(recorderConfigs, cfg.Recorders...)
 	for _, rec := range recorderConfigs {
-		if !rec.Enabled {
+		if !rec.Enabled || rec.Type == config.RecorderTypeOTLP {
 			continue
 		}
 		if rec.Mode != config.RecorderModeExternal {
@@ -107,9 +105,12 @@ func (c *ExportPipelineConfig) Exec(ctx context.Context, args []string) error {
 			return fmt.Errorf("external recorder %s: address is required", rec.Type)
 		}
 
-		client, err := grpc_client.NewCLient(rec.Address,
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
+		opts, err := dialOptions(rec)
+		if err != nil {
+			return fmt.Errorf("recorder %s: %w", rec.Type, err)
+		}
+
+		client, err := grpc_client.NewCLient(rec.Address, opts...)
 		if err != nil {
 			return fmt.Errorf("connect to external recorder %s at %s: %w", rec.Type, rec.Address, err)
 		}
@@ -117,6 +118,13 @@ func (c *ExportPipelineConfig) Exec(ctx context.Context, args []string) error {
 		clients = append(clients, client)
 	}
 
+	// initialize trace sinks
+	sinks, err := initTraceSinks(cfg)
+	if err != nil {
+		return fmt.Errorf("initialize trace sinks: %w", err)
+	}
+	defer closeTraceSinks(sinks)
+
 	// create exporter
 	exp := exporter.New()
 	for _, client := range clients {
@@ -124,6 +132,11 @@ func (c *ExportPipelineConfig) Exec(ctx context.Context, args []string) error {
 			return fmt.Errorf("add grpc client: %w", err)
 		}
 	}
+	for _, sink := range sinks {
+		if err := exp.AddTraceSink(sink); err != nil {
+			return fmt.Errorf("add trace sink: %w", err)
+		}
+	}
 
 	projectGid := graphql.GlobalIdProjectPrefix + strconv.FormatInt(projectId, 10)
 	pipelineGid := graphql.GlobalIdPipelinePrefix + strconv.FormatInt(pipelineId, 10)
@@ -30,11 +30,14 @@ func NewFetchCmd(out io.Writer) *cli.Command {
 		Flags:      cfg.flags,
 		Subcommands: []*cli.Command{
 			NewFetchArtifactsCmd(out),
+			NewFetchCommitsCmd(out),
 			NewFetchDeploymentsCmd(out),
+			NewFetchEnvironmentsCmd(out),
 			NewFetchJobLogCmd(out),
 			NewFetchMergeRequestCmd(out),
 			NewFetchPipelineCmd(out),
 			NewFetchProjectsCommand(out),
+			NewFetchReleasesCmd(out),
 			NewFetchReportCmd(out),
 			NewFetchRunnerCmd(out),
 			NewFetchTestReportCmd(out),
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cluttrdev/cli"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+type FetchCommitsConfig struct {
+	FetchConfig
+
+	projectId       int64
+	ref             string
+	committedAfter  *time.Time
+	committedBefore *time.Time
+}
+
+func NewFetchCommitsCmd(out io.Writer) *cli.Command {
+	cfg := FetchCommitsConfig{
+		FetchConfig: FetchConfig{
+			RootConfig: RootConfig{
+				out:   out,
+				flags: flag.NewFlagSet("fetch-commits", flag.ContinueOnError),
+			},
+		},
+	}
+
+	cfg.RegisterFlags(cfg.flags)
+
+	return &cli.Command{
+		Name:       "commits",
+		ShortUsage: fmt.Sprintf("%s fetch commits [option]...", exeName),
+		ShortHelp:  "Fetch project branch commits.",
+		Flags:      cfg.flags,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *FetchCommitsConfig) RegisterFlags(fs *flag.FlagSet) {
+	c.FetchConfig.RegisterFlags(fs)
+
+	fs.Int64Var(&c.projectId, "project-id", 0, "The project id.")
+	fs.StringVar(&c.ref, "ref", "", "The branch name. (default: the default branch)")
+
+	fs.Func("committed-after", "", func(s string) error {
+		t, err := parseTimeISO8601(s)
+		if err != nil {
+			return err
+		}
+		c.committedAfter = &t
+		return nil
+	})
+	fs.Func("committed-before", "", func(s string) error {
+		t, err := parseTimeISO8601(s)
+		if err != nil {
+			return err
+		}
+		c.committedBefore = &t
+		return nil
+	})
+}
+
+func (c *FetchCommitsConfig) Exec(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("too many arguments: %v", args)
+	}
+
+	if c.projectId == 0 {
+		return fmt.Errorf("missing required option: --project-id")
+	}
+
+	cfg := config.Default()
+	if err := loadConfig(c.FetchConfig.RootConfig.filename, c.flags, &cfg); err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	glab, err := createGitLabClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create gitlab client: %w", err)
+	}
+
+	opt := rest.GetProjectCommitsOptions{
+		RefName: c.ref,
+		Since:   c.committedAfter,
+		Until:   c.committedBefore,
+	}
+
+	cs, err := glab.Rest.GetProjectCommits(ctx, c.projectId, opt)
+	if err != nil {
+		return fmt.Errorf("fetch commits: %w", err)
+	}
+
+	commits := make([]types.Commit, 0, len(cs))
+	for _, commit := range cs {
+		commits = append(commits, rest.ConvertCommit(c.projectId, c.ref, commit))
+	}
+
+	out, err := json.Marshal(commits)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(c.FetchConfig.RootConfig.out, string(out))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
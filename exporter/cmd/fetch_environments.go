@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cluttrdev/cli"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
+)
+
+type FetchEnvironmentsConfig struct {
+	FetchConfig
+
+	projectId int64
+}
+
+func NewFetchEnvironmentsCmd(out io.Writer) *cli.Command {
+	cfg := FetchEnvironmentsConfig{
+		FetchConfig: FetchConfig{
+			RootConfig: RootConfig{
+				out:   out,
+				flags: flag.NewFlagSet("fetch-environments", flag.ContinueOnError),
+			},
+		},
+	}
+
+	cfg.RegisterFlags(cfg.flags)
+
+	return &cli.Command{
+		Name:       "environments",
+		ShortUsage: fmt.Sprintf("%s fetch environments [option]...", exeName),
+		ShortHelp:  "Fetch project environments.",
+		Flags:      cfg.flags,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *FetchEnvironmentsConfig) RegisterFlags(fs *flag.FlagSet) {
+	c.FetchConfig.RegisterFlags(fs)
+
+	fs.Int64Var(&c.projectId, "project-id", 0, "The project id.")
+}
+
+func (c *FetchEnvironmentsConfig) Exec(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("too many arguments: %v", args)
+	}
+
+	if c.projectId == 0 {
+		return fmt.Errorf("missing required option: --project-id")
+	}
+
+	cfg := config.Default()
+	if err := loadConfig(c.FetchConfig.RootConfig.filename, c.flags, &cfg); err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	glab, err := createGitLabClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create gitlab client: %w", err)
+	}
+
+	environments, err := tasks.FetchProjectEnvironments(ctx, glab, c.projectId)
+	if err != nil {
+		return fmt.Errorf("fetch environments: %w", err)
+	}
+
+	out, err := json.Marshal(environments)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(c.FetchConfig.RootConfig.out, string(out))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
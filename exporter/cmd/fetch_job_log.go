@@ -13,6 +13,7 @@ import (
 	"github.com/cluttrdev/cli"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
 )
 
 type FetchJobLogConfig struct {
@@ -77,7 +78,9 @@ func (c *FetchJobLogConfig) Exec(ctx context.Context, args []string) error {
 	}
 
 	if c.printSections || c.printMetrics {
-		data, err := glab.Rest.GetJobLogData(ctx, projectID, jobID)
+		data, err := glab.Rest.GetJobLogData(ctx, projectID, jobID, rest.JobLogOptions{
+			MaxSize: cfg.Export.JobLogs.MaxSize,
+		})
 		if err != nil {
 			return fmt.Errorf("error fetching job log data: %w", err)
 		}
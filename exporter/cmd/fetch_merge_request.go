@@ -11,6 +11,7 @@ import (
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/graphql"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
 )
 
@@ -19,6 +20,7 @@ type FetchMergeRequestConfig struct {
 
 	projectPath     string
 	mergeRequestIid int64
+	events          bool
 }
 
 func NewFetchMergeRequestCmd(out io.Writer) *cli.Command {
@@ -47,6 +49,7 @@ func (c *FetchMergeRequestConfig) RegisterFlags(fs *flag.FlagSet) {
 
 	fs.StringVar(&c.projectPath, "project-path", "", "The merge request project's full path.")
 	fs.Int64Var(&c.mergeRequestIid, "merge-request-iid", 0, "The merge request's iid.")
+	fs.BoolVar(&c.events, "events", false, "Whether to fetch the merge request's state, label and milestone events.")
 }
 
 func (c *FetchMergeRequestConfig) Exec(ctx context.Context, args []string) error {
@@ -75,13 +78,14 @@ func (c *FetchMergeRequestConfig) Exec(ctx context.Context, args []string) error
 		return fmt.Errorf("convert merge request fields: %w", err)
 	}
 
+	mrRef := types.MergeRequestReference{
+		Id:      mr.Id,
+		Iid:     mr.Iid,
+		Project: mr.Project,
+	}
+
 	var mrCommits []types.MergeRequestCommit
 	if len(mrf.Commits) > 0 {
-		mrRef := types.MergeRequestReference{
-			Id:      mr.Id,
-			Iid:     mr.Iid,
-			Project: mr.Project,
-		}
 		for _, cf := range mrf.Commits {
 			mrc, err := graphql.ConvertMergeRequestCommit(mrRef, cf)
 			if err != nil {
@@ -91,12 +95,22 @@ func (c *FetchMergeRequestConfig) Exec(ctx context.Context, args []string) error
 		}
 	}
 
+	var mrEvents []types.MergeRequestEvent
+	if c.events {
+		mrEvents, err = tasks.FetchMergeRequestEvents(ctx, glab, mrRef)
+		if err != nil {
+			return fmt.Errorf("fetch merge request events: %w", err)
+		}
+	}
+
 	v := struct {
 		types.MergeRequest
 		Commits []types.MergeRequestCommit
+		Events  []types.MergeRequestEvent `json:",omitempty"`
 	}{
 		MergeRequest: mr,
 		Commits:      mrCommits,
+		Events:       mrEvents,
 	}
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/cluttrdev/cli"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
+)
+
+type FetchReleasesConfig struct {
+	FetchConfig
+
+	projectId int64
+}
+
+func NewFetchReleasesCmd(out io.Writer) *cli.Command {
+	cfg := FetchReleasesConfig{
+		FetchConfig: FetchConfig{
+			RootConfig: RootConfig{
+				out:   out,
+				flags: flag.NewFlagSet("fetch-releases", flag.ContinueOnError),
+			},
+		},
+	}
+
+	cfg.RegisterFlags(cfg.flags)
+
+	return &cli.Command{
+		Name:       "releases",
+		ShortUsage: fmt.Sprintf("%s fetch releases [option]...", exeName),
+		ShortHelp:  "Fetch project releases with their pipelines.",
+		Flags:      cfg.flags,
+		Exec:       cfg.Exec,
+	}
+}
+
+func (c *FetchReleasesConfig) RegisterFlags(fs *flag.FlagSet) {
+	c.FetchConfig.RegisterFlags(fs)
+
+	fs.Int64Var(&c.projectId, "project-id", 0, "The project id.")
+}
+
+func (c *FetchReleasesConfig) Exec(ctx context.Context, args []string) error {
+	if len(args) > 0 {
+		return fmt.Errorf("too many arguments: %v", args)
+	}
+
+	if c.projectId == 0 {
+		return fmt.Errorf("missing required option: --project-id")
+	}
+
+	cfg := config.Default()
+	if err := loadConfig(c.FetchConfig.RootConfig.filename, c.flags, &cfg); err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	glab, err := createGitLabClient(cfg)
+	if err != nil {
+		return fmt.Errorf("create gitlab client: %w", err)
+	}
+
+	releases, err := tasks.FetchProjectReleases(ctx, glab, c.projectId, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("fetch releases: %w", err)
+	}
+
+	out, err := json.Marshal(releases)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(c.FetchConfig.RootConfig.out, string(out))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
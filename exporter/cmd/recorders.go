@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/otlp"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/spool"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/subprocess"
+	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+)
+
+// recorderSet manages the recorders the exporter sends data to, so that they
+// can be added and removed while running.
+type recorderSet struct {
+	exp   *exporter.Exporter
+	spool config.Spool
+
+	mu        sync.Mutex
+	ctx       context.Context // nil until the recorders are started
+	recorders map[string]*recorder
+}
+
+type recorder struct {
+	config config.Recorder
+
+	client   *grpc_client.Client
+	launcher *subprocess.Launcher
+	spool    *spool.Spool
+	sink     *otlp.Sink
+
+	// stops the spool replay
+	cancel context.CancelFunc
+}
+
+func newRecorderSet(exp *exporter.Exporter, spoolCfg config.Spool) *recorderSet {
+	return &recorderSet{
+		exp:       exp,
+		spool:     spoolCfg,
+		recorders: make(map[string]*recorder),
+	}
+}
+
+// recorderKey identifies a recorder across configuration changes.
+func recorderKey(rec config.Recorder) string {
+	return fmt.Sprintf("%s/%s/%s", rec.Mode, rec.Type, rec.Address)
+}
+
+// Apply adds the enabled recorders that are not yet exported to and removes
+// the ones that are no longer configured. Recorders whose configuration
+// changed are replaced.
+func (s *recorderSet) Apply(recs []config.Recorder) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wanted := make(map[string]config.Recorder)
+	for _, rec := range recs {
+		if rec.Enabled {
+			wanted[recorderKey(rec)] = rec
+		}
+	}
+
+	var errs error
+	for key, r := range s.recorders {
+		if rec, ok := wanted[key]; ok && reflect.DeepEqual(rec, r.config) {
+			delete(wanted, key)
+			continue
+		}
+		if err := s.remove(key); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	for key, rec := range wanted {
+		if err := s.add(key, rec); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func (s *recorderSet) add(key string, rec config.Recorder) error {
+	r := &recorder{config: rec}
+	if rec.Type == config.RecorderTypeOTLP {
+		sinkCfg, err := traceSinkConfig(rec)
+		if err != nil {
+			return fmt.Errorf("recorder %s: %w", rec.Type, err)
+		}
+		r.sink, err = otlp.NewSink(sinkCfg)
+		if err != nil {
+			return fmt.Errorf("create trace sink for %s: %w", rec.Address, err)
+		}
+		if err := s.exp.AddTraceSink(r.sink); err != nil {
+			r.close()
+			return fmt.Errorf("add trace sink: %w", err)
+		}
+	} else {
+		var err error
+		r.client, r.launcher, r.spool, err = initGrpcClient(s.spool, rec)
+		if err != nil {
+			return err
+		}
+		if err := s.exp.AddClient(r.client); err != nil {
+			r.close()
+			return fmt.Errorf("add grpc client: %w", err)
+		}
+	}
+
+	s.recorders[key] = r
+	if s.ctx != nil {
+		slog.Info("Added recorder", "type", rec.Type, "mode", rec.Mode, "address", rec.Address)
+		return s.start(r)
+	}
+	return nil
+}
+
+func (s *recorderSet) remove(key string) error {
+	r := s.recorders[key]
+	delete(s.recorders, key)
+
+	var err error
+	if r.sink != nil {
+		err = s.exp.RemoveTraceSink(r.sink.Target())
+	} else {
+		err = s.exp.RemoveClient(r.client.Target())
+	}
+	r.close()
+
+	slog.Info("Removed recorder", "type", r.config.Type, "mode", r.config.Mode, "address", r.config.Address)
+	return err
+}
+
+// start launches the subprocess of the recorder and replays its spool.
+func (s *recorderSet) start(r *recorder) error {
+	if r.launcher != nil {
+		if err := r.launcher.Start(s.ctx); err != nil {
+			return fmt.Errorf("start recorder subprocess: %w", err)
+		}
+	}
+
+	if r.spool != nil {
+		ctx, cancel := context.WithCancel(s.ctx)
+		r.cancel = cancel
+		go func() {
+			if err := r.spool.Run(ctx, r.client.Conn()); err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("error replaying spool", "target", r.client.Target(), "error", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// close stops the recorder and releases its resources.
+func (r *recorder) close() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.launcher != nil {
+		if err := r.launcher.Stop(context.Background()); err != nil {
+			slog.Error("error stopping subprocess recorder", "error", err)
+		}
+	}
+	if r.client != nil {
+		if err := r.client.Close(); err != nil {
+			slog.Error("error closing grpc client", "target", r.client.Target(), "error", err)
+		}
+	}
+	if r.spool != nil {
+		if err := r.spool.Close(); err != nil {
+			slog.Error("error closing spool", "error", err)
+		}
+	}
+	if r.sink != nil {
+		if err := r.sink.Close(); err != nil {
+			slog.Error("error closing trace sink", "target", r.sink.Target(), "error", err)
+		}
+	}
+}
+
+// Run starts the recorders and blocks until the context is done.
+func (s *recorderSet) Run(ctx context.Context) error {
+	s.mu.Lock()
+	s.ctx = ctx
+	for _, r := range s.recorders {
+		if err := s.start(r); err != nil {
+			s.mu.Unlock()
+			return err
+		}
+	}
+	s.mu.Unlock()
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// Close stops and removes all recorders.
+func (s *recorderSet) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key, r := range s.recorders {
+		delete(s.recorders, key)
+		r.close()
+	}
+}
+
+// list returns the clients and launchers of the current recorders.
+func (s *recorderSet) list() ([]*grpc_client.Client, []*subprocess.Launcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		clients   []*grpc_client.Client
+		launchers []*subprocess.Launcher
+	)
+	for _, r := range s.recorders {
+		if r.client != nil {
+			clients = append(clients, r.client)
+		}
+		if r.launcher != nil {
+			launchers = append(launchers, r.launcher)
+		}
+	}
+	return clients, launchers
+}
+
+// Describe sends no descriptors, which makes the set an unchecked collector,
+// since the metrics of its recorders change with them.
+func (s *recorderSet) Describe(chan<- *prometheus.Desc) {}
+
+func (s *recorderSet) Collect(ch chan<- prometheus.Metric) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.recorders {
+		if r.client != nil {
+			r.client.MetricsCollector().Collect(ch)
+		}
+		if r.spool != nil {
+			r.spool.MetricsCollector().Collect(ch)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
+)
+
+const (
+	configPollInterval = 10 * time.Second
+)
+
+// watchConfig calls reload whenever the content of the configuration file
+// changes or the process receives SIGHUP, until the context is done.
+func watchConfig(ctx context.Context, filename string, interval time.Duration, reload func()) error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var (
+		ticks <-chan time.Time
+		sum   [sha256.Size]byte
+	)
+	if filename != "" {
+		if data, err := os.ReadFile(filename); err == nil {
+			sum = sha256.Sum256(data)
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		ticks = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-hup:
+			slog.Info("Got SIGHUP, reloading configuration")
+			reload()
+		case <-ticks:
+			data, err := os.ReadFile(filename)
+			if err != nil {
+				slog.Warn("error reading configuration file", "file", filename, "error", err)
+				continue
+			}
+			if s := sha256.Sum256(data); s != sum {
+				sum = s
+				slog.Info("Configuration file changed, reloading", "file", filename)
+				reload()
+			}
+		}
+	}
+}
+
+// reloadConfig loads the configuration again and applies the changes to the
+// projects, export settings, intervals and recorders. It returns the
+// configuration now in effect. Changes to the other settings are only
+// applied on restart.
+func (c *RunConfig) reloadConfig(ctx context.Context, current config.Config, ctrl *tasks.Controller, recorders *recorderSet) (config.Config, error) {
+	next, err := c.loadConfig()
+	if err != nil {
+		return current, fmt.Errorf("load configuration: %w", err)
+	}
+
+	restartOnly := []struct {
+		name          string
+		current, next any
+	}{
+		{"gitlab", current.GitLab, next.GitLab},
+		{"http", current.HTTP, next.HTTP},
+		{"log", current.Log, next.Log},
+		{"checkpoints", current.Checkpoints, next.Checkpoints},
+		{"spool", current.Spool, next.Spool},
+		{"webhook", current.Webhook, next.Webhook},
+	}
+	for _, s := range restartOnly {
+		if !reflect.DeepEqual(s.current, s.next) {
+			slog.Warn("Changed settings require a restart to take effect", "settings", s.name)
+		}
+	}
+	next.GitLab = current.GitLab
+	next.HTTP = current.HTTP
+	next.Log = current.Log
+	next.Checkpoints = current.Checkpoints
+	next.Spool = current.Spool
+	next.Webhook = current.Webhook
+
+	ctrlConfig, err := controllerConfig(next)
+	if err != nil {
+		return current, fmt.Errorf("invalid configuration: %w", err)
+	}
+	if err := ctrl.Reload(ctx, ctrlConfig); err != nil {
+		return current, fmt.Errorf("reload controller: %w", err)
+	}
+
+	if err := recorders.Apply(recorderConfigs(next)); err != nil {
+		return next, fmt.Errorf("apply recorders: %w", err)
+	}
+
+	return next, nil
+}
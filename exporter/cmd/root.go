@@ -97,9 +97,9 @@ func writeConfig(out io.Writer, cfg config.Config) {
 	}
 	fmt.Fprintln(out, "----")
 
-	projects := []int64{}
+	projects := []any{}
 	for _, p := range cfg.Projects {
-		projects = append(projects, p.Id)
+		projects = append(projects, p.Pid())
 	}
 	fmt.Fprintf(out, "Projects: %v\n", projects)
 	fmt.Fprintln(out, "----")
@@ -10,9 +10,11 @@ import (
 	"net/http"
 	"net/http/pprof"
 	"os/signal"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -25,16 +27,28 @@ import (
 
 	"github.com/cluttrdev/cli"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/version"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/healthz"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/otlp"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/spool"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/subprocess"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/tasks"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/webhook"
 	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
 )
 
+const (
+	webhookPath      = "/webhooks/gitlab"
+	webhookQueueSize = 1024
+
+	healthCheckTimeout = 5 * time.Second
+)
+
 type RunConfig struct {
 	RootConfig
 
@@ -90,48 +104,24 @@ func (c *RunConfig) RegisterFlags(fs *flag.FlagSet) {
 }
 
 func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
-	var err error
-
 	// load configuration
-	cfg := config.Default()
-	if err := loadConfig(c.RootConfig.filename, c.flags, &cfg); err != nil {
+	cfg, err := c.loadConfig()
+	if err != nil {
 		return fmt.Errorf("error loading configuration: %w", err)
 	}
 
-	// override values passed as env vars or flags
-	c.flags.Visit(func(f *flag.Flag) {
-		switch f.Name {
-		case "log-level":
-			cfg.Log.Level = f.Value.String()
-		case "log-format":
-			cfg.Log.Format = f.Value.String()
-		}
-	})
-
-	if c.debug {
-		cfg.HTTP.Enabled = true
-		cfg.HTTP.Debug = true
-		cfg.Log.Level = "debug"
-	}
-
 	if cfg.Log.Level == "debug" {
 		writeConfig(c.out, cfg)
 	}
 	initLogging(c.out, cfg.Log)
 
-	// add projects passed as arguments
-	for _, pid := range c.projects {
-		exists := slices.ContainsFunc(cfg.Projects, func(p config.Project) bool {
-			return p.Id == pid
-		})
-		if exists {
-			continue
+	if cfg.Webhook.Enabled {
+		if !cfg.HTTP.Enabled {
+			return fmt.Errorf("webhook receiver requires the http server to be enabled")
+		}
+		if cfg.Webhook.Secret == "" {
+			return fmt.Errorf("webhook receiver requires a secret token")
 		}
-
-		cfg.Projects = append(cfg.Projects, config.Project{
-			Id:              pid,
-			ProjectSettings: config.DefaultProjectSettings(),
-		})
 	}
 
 	// create gitlab client
@@ -140,34 +130,45 @@ func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
 		return fmt.Errorf("create gitlab client: %w", err)
 	}
 
-	// initialize grpc clients
-	clients, launchers, err := initGrpcClients(cfg)
-	if err != nil {
-		return fmt.Errorf("initialize grpc clients: %w", err)
+	// setup exporter and recorders
+	exp := exporter.New()
+	recorders := newRecorderSet(exp, cfg.Spool)
+	if err := recorders.Apply(recorderConfigs(cfg)); err != nil {
+		recorders.Close()
+		return fmt.Errorf("initialize recorders: %w", err)
 	}
+	defer recorders.Close()
 
-	// setup exporter
-	exp := exporter.New()
-	for _, client := range clients {
-		if err := exp.AddClient(client); err != nil {
-			return fmt.Errorf("add grpc client: %w", err)
+	// open checkpoint store
+	var checkpoints checkpoint.Store
+	if cfg.Checkpoints.Enabled {
+		store, err := checkpoint.OpenFileStore(cfg.Checkpoints.Path)
+		if err != nil {
+			return fmt.Errorf("open checkpoint store: %w", err)
 		}
+		defer func() {
+			if err := store.Close(); err != nil {
+				slog.Error("error closing checkpoint store", "error", err)
+			}
+		}()
+		checkpoints = store
 	}
 
-	g := &run.Group{}
-
-	{ // controller
-		ctrl := tasks.NewController(glab, exp, tasks.ControllerConfig{
-			GitLab:     cfg.GitLab,
-			Projects:   cfg.Projects,
-			Namespaces: cfg.Namespaces,
+	var events chan webhook.Event
+	if cfg.Webhook.Enabled {
+		events = make(chan webhook.Event, webhookQueueSize)
+	}
 
-			Export: cfg.Export,
+	ctrlConfig, err := controllerConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	ctrlConfig.Checkpoints = checkpoints
+	ctrl := tasks.NewController(glab, exp, ctrlConfig)
 
-			ExportInterval:  5 * time.Minute,
-			CatchUpInterval: 24 * time.Hour,
-		})
+	g := &run.Group{}
 
+	{ // controller
 		ctx, cancel := context.WithCancel(context.Background())
 
 		g.Add(func() error { // execute
@@ -188,6 +189,14 @@ func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
 				}()
 			}
 
+			if events != nil {
+				go func() {
+					if err := ctrl.HandleEvents(ctx, events); err != nil && !errors.Is(err, context.Canceled) {
+						slog.Error("error handling webhook events", "error", err)
+					}
+				}()
+			}
+
 			return ctrl.Run(ctx)
 		}, func(err error) { // interrupt
 			slog.Info("Stopping controller...")
@@ -200,24 +209,30 @@ func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
 		ctx, cancel := context.WithCancel(context.Background())
 
 		g.Add(func() error { //execute
-			slog.Info("Starting recorder subprocesses...")
-			for _, launcher := range launchers {
-				if err := launcher.Start(ctx); err != nil {
-					return fmt.Errorf("start recorder subprocess: %w", err)
-				}
-			}
-			slog.Info("Starting recorder subprocesses... done")
-			<-ctx.Done()
-			return ctx.Err()
+			slog.Info("Starting recorders...")
+			return recorders.Run(ctx)
 		}, func(err error) { // interrupt
-			slog.Info("Stopping recorder subprocesses...")
+			slog.Info("Stopping recorders...")
 			cancel()
-			for _, launcher := range launchers {
-				if err := launcher.Stop(ctx); err != nil {
-					slog.Error("error stopping subprocess recorder", "error", err)
+			recorders.Close()
+			slog.Info("Stopping recorders... done")
+		})
+	}
+
+	{ // configuration reload
+		ctx, cancel := context.WithCancel(context.Background())
+
+		current := cfg
+		g.Add(func() error { // execute
+			return watchConfig(ctx, c.filename, configPollInterval, func() {
+				var err error
+				current, err = c.reloadConfig(ctx, current, ctrl, recorders)
+				if err != nil {
+					slog.Error("error reloading configuration", "error", err)
 				}
-			}
-			slog.Info("Stopping recorder subprocesses... done")
+			})
+		}, func(err error) { // interrupt
+			cancel()
 		})
 	}
 
@@ -226,13 +241,27 @@ func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
 			collectors.NewGoCollector(),
 			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
 		}
-		for _, client := range clients {
-			colls = append(colls, client.MetricsCollector())
-		}
+		colls = append(colls, glab.MetricsCollector(), ctrl.MetricsCollector(), exp.MetricsCollector(), recorders)
 		reg := prometheus.NewRegistry()
 		reg.MustRegister(colls...)
 
-		g.Add(serveHTTP(cfg.HTTP, reg))
+		var handlers map[string]http.Handler
+		if cfg.Webhook.Enabled {
+			handlers = map[string]http.Handler{
+				webhookPath: webhook.NewHandler(cfg.Webhook.Secret, events),
+			}
+		}
+
+		health := healthz.NewHandler()
+		health.SetReadinessCheck(func() error {
+			clients, launchers := recorders.list()
+			return readinessCheck(glab, clients, launchers)()
+		})
+		health.SetLivenessCheck(func() error {
+			return ctrl.CheckLiveness(cfg.HTTP.Healthz.MaxStalledIntervals)
+		})
+
+		g.Add(serveHTTP(cfg.HTTP, reg, health, handlers))
 	}
 
 	{ // signal handler
@@ -252,10 +281,134 @@ func (c *RunConfig) Exec(ctx context.Context, _ []string) error {
 	return g.Run()
 }
 
-func initGrpcClients(cfg config.Config) ([]*grpc_client.Client, []*subprocess.Launcher, error) {
+// loadConfig loads the configuration file and applies the command line
+// overrides.
+func (c *RunConfig) loadConfig() (config.Config, error) {
+	cfg := config.Default()
+	if err := loadConfig(c.RootConfig.filename, c.flags, &cfg); err != nil {
+		return cfg, err
+	}
+
+	// override values passed as env vars or flags
+	c.flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "log-level":
+			cfg.Log.Level = f.Value.String()
+		case "log-format":
+			cfg.Log.Format = f.Value.String()
+		}
+	})
+
+	if c.debug {
+		cfg.HTTP.Enabled = true
+		cfg.HTTP.Debug = true
+		cfg.Log.Level = "debug"
+	}
+
+	// add projects passed as arguments
+	for _, pid := range c.projects {
+		exists := slices.ContainsFunc(cfg.Projects, func(p config.Project) bool {
+			return p.Id == pid
+		})
+		if exists {
+			continue
+		}
+
+		cfg.Projects = append(cfg.Projects, config.Project{
+			Id:              pid,
+			ProjectSettings: config.DefaultProjectSettings(),
+		})
+	}
+
+	return cfg, nil
+}
+
+// controllerConfig returns the controller configuration for the given
+// configuration, without a checkpoint store.
+func controllerConfig(cfg config.Config) (tasks.ControllerConfig, error) {
+	export := cfg.Schedule.Export
+	if export == "" {
+		export = "5m"
+		if cfg.Webhook.Enabled {
+			// webhook events trigger exports as soon as data changes, polling
+			// only serves to reconcile events that got lost
+			export = "1h"
+		}
+	}
+
+	sched := tasks.Schedule{
+		Jitter: cfg.Schedule.Jitter,
+	}
+	for _, s := range []struct {
+		name string
+		spec string
+		dst  *schedule.Schedule
+	}{
+		{"resolve", cfg.Schedule.Resolve, &sched.Resolve},
+		{"export", export, &sched.Export},
+		{"pipelines", cfg.Schedule.Pipelines, &sched.Pipelines},
+		{"merge_requests", cfg.Schedule.MergeRequests, &sched.MergeRequests},
+		{"issues", cfg.Schedule.Issues, &sched.Issues},
+		{"deployments", cfg.Schedule.Deployments, &sched.Deployments},
+		{"commits", cfg.Schedule.Commits, &sched.Commits},
+		{"releases", cfg.Schedule.Releases, &sched.Releases},
+		{"environments", cfg.Schedule.Environments, &sched.Environments},
+		{"job_artifacts", cfg.Schedule.JobArtifacts, &sched.JobArtifacts},
+		{"tags", cfg.Schedule.Tags, &sched.Tags},
+		{"runners", cfg.Schedule.Runners, &sched.Runners},
+	} {
+		if s.spec == "" {
+			continue
+		}
+		parsed, err := schedule.Parse(s.spec)
+		if err != nil {
+			return tasks.ControllerConfig{}, fmt.Errorf("schedule %s: %w", s.name, err)
+		}
+		*s.dst = parsed
+	}
+
+	return tasks.ControllerConfig{
+		GitLab:     cfg.GitLab,
+		Projects:   cfg.Projects,
+		Namespaces: cfg.Namespaces,
+
+		Export: cfg.Export,
+
+		Schedule:        sched,
+		CatchUpInterval: 24 * time.Hour,
+	}, nil
+}
+
+func initGrpcClients(cfg config.Config) ([]*grpc_client.Client, []*subprocess.Launcher, map[string]*spool.Spool, error) {
 	var clients []*grpc_client.Client
 	var launchers []*subprocess.Launcher
+	spools := make(map[string]*spool.Spool)
+
+	for _, rec := range recorderConfigs(cfg) {
+		if !rec.Enabled || rec.Type == config.RecorderTypeOTLP {
+			continue
+		}
+
+		client, launcher, sp, err := initGrpcClient(cfg.Spool, rec)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		clients = append(clients, client)
+		if launcher != nil {
+			launchers = append(launchers, launcher)
+		}
+		if sp != nil {
+			spools[client.Target()] = sp
+		}
+	}
+
+	return clients, launchers, spools, nil
+}
 
+// recorderConfigs returns the configured recorders, including those of the
+// deprecated endpoints config.
+func recorderConfigs(cfg config.Config) []config.Recorder {
 	// for backwards compatibility with deprecated endpoints config
 	var recorderConfigs []config.Recorder
 	for _, endpoint := range cfg.Endpoints {
@@ -266,75 +419,325 @@ func initGrpcClients(cfg config.Config) ([]*grpc_client.Client, []*subprocess.La
 		})
 	}
 
-	recorderConfigs = append(recorderConfigs, cfg.Recorders...)
+	return append(recorderConfigs, cfg.Recorders...)
+}
 
-	for _, rec := range recorderConfigs {
-		if !rec.Enabled {
-			continue
+// initGrpcClient creates the client for the given recorder, along with the
+// launcher of its subprocess and its spool if any.
+func initGrpcClient(spoolCfg config.Spool, rec config.Recorder) (*grpc_client.Client, *subprocess.Launcher, *spool.Spool, error) {
+	opts, err := dialOptions(rec)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("recorder %s: %w", rec.Type, err)
+	}
+
+	var sp *spool.Spool
+	if spoolCfg.Enabled {
+		var err error
+		sp, err = spool.Open(filepath.Join(spoolCfg.Path, spoolName(rec)), spool.Options{
+			MaxSize: spoolCfg.MaxSize,
+			MaxAge:  spoolCfg.MaxAge,
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("open spool for recorder %s: %w", rec.Type, err)
 		}
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(sp.UnaryClientInterceptor()),
+			grpc.WithChainStreamInterceptor(sp.StreamClientInterceptor()),
+		)
+	}
 
-		switch rec.Mode {
-		case config.RecorderModeExternal:
-			if rec.Address == "" {
-				return nil, nil, fmt.Errorf("external recorder %s: address is required", rec.Type)
-			}
+	var (
+		client   *grpc_client.Client
+		launcher *subprocess.Launcher
+	)
+	switch rec.Mode {
+	case config.RecorderModeExternal:
+		if rec.Address == "" {
+			err = fmt.Errorf("external recorder %s: address is required", rec.Type)
+			break
+		}
 
-			client, err := grpc_client.NewCLient(rec.Address,
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
-			if err != nil {
-				return nil, nil, fmt.Errorf("connect to external recorder %s at %s: %w", rec.Type, rec.Address, err)
+		client, err = grpc_client.NewCLient(rec.Address, opts...)
+		if err != nil {
+			err = fmt.Errorf("connect to external recorder %s at %s: %w", rec.Type, rec.Address, err)
+		}
+	case config.RecorderModeSubprocess:
+		// Extract settings for launcher configuration
+		var command string
+		var maxRestarts int = subprocess.DefaultMaxRestarts
+
+		// Everything else is forwarded to the recorder
+		settings := make(map[string]any, len(rec.Settings))
+		for k, v := range rec.Settings {
+			switch k {
+			case "command":
+				command, _ = v.(string)
+			case "max_restarts":
+				if mr, ok := v.(int); ok {
+					maxRestarts = mr
+				}
+			default:
+				settings[k] = v
 			}
+		}
+
+		launcher, err = subprocess.NewLauncher(subprocess.LauncherConfig{
+			RecorderType: rec.Type,
+			Command:      command,
+			SocketPath:   rec.Address,
+			MaxRestarts:  maxRestarts,
+			Settings:     settings,
+		})
+		if err != nil {
+			err = fmt.Errorf("create launcher for %s: %w", rec.Type, err)
+			break
+		}
+
+		client, err = grpc_client.NewCLient("unix://"+launcher.SocketPath(), opts...)
+		if err != nil {
+			err = fmt.Errorf("create client: %w", err)
+		}
+	default:
+		err = fmt.Errorf("recorder %s: invalid mode %q", rec.Type, rec.Mode)
+	}
+
+	if err != nil {
+		if sp != nil {
+			_ = sp.Close()
+		}
+		return nil, nil, nil, err
+	}
+	return client, launcher, sp, nil
+}
+
+// dialOptions returns the transport and authentication options to connect
+// to the given recorder.
+func dialOptions(rec config.Recorder) ([]grpc.DialOption, error) {
+	if rec.Mode != config.RecorderModeExternal {
+		if rec.TLS.Enabled || rec.Token != "" {
+			return nil, fmt.Errorf("tls and token are only supported for external recorders")
+		}
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	}
+
+	if !rec.TLS.Enabled {
+		if rec.Token != "" {
+			return nil, fmt.Errorf("token requires tls to be enabled")
+		}
+		return []grpc.DialOption{
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}, nil
+	}
+
+	creds, err := grpc_client.NewTLSCredentials(grpc_client.TLSConfig{
+		CAFile:     rec.TLS.CAFile,
+		CertFile:   rec.TLS.CertFile,
+		KeyFile:    rec.TLS.KeyFile,
+		ServerName: rec.TLS.ServerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if rec.Token != "" {
+		opts = append(opts, grpc_client.WithToken(rec.Token))
+	}
+	return opts, nil
+}
+
+// initTraceSinks creates the trace sinks for the enabled otlp recorders.
+func initTraceSinks(cfg config.Config) ([]*otlp.Sink, error) {
+	var sinks []*otlp.Sink
+	for _, rec := range cfg.Recorders {
+		if !rec.Enabled || rec.Type != config.RecorderTypeOTLP {
+			continue
+		}
 
-			clients = append(clients, client)
-		case config.RecorderModeSubprocess:
-			// Extract settings for launcher configuration
-			var command string
-			var maxRestarts int = subprocess.DefaultMaxRestarts
+		sinkCfg, err := traceSinkConfig(rec)
+		if err != nil {
+			closeTraceSinks(sinks)
+			return nil, fmt.Errorf("recorder %s: %w", rec.Type, err)
+		}
+
+		sink, err := otlp.NewSink(sinkCfg)
+		if err != nil {
+			closeTraceSinks(sinks)
+			return nil, fmt.Errorf("create trace sink for %s: %w", rec.Address, err)
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
 
-			if cmd, ok := rec.Settings["command"].(string); ok {
-				command = cmd
+// traceSinkConfig returns the sink config for the given otlp recorder. The
+// address is the OTLP endpoint, the settings configure the protocol, headers
+// and timeout.
+func traceSinkConfig(rec config.Recorder) (otlp.Config, error) {
+	sinkCfg := otlp.Config{
+		Protocol: otlp.ProtocolGRPC,
+		Endpoint: rec.Address,
+		Headers:  make(map[string]string),
+	}
+	if rec.Address == "" {
+		return sinkCfg, fmt.Errorf("address is required")
+	}
+
+	for k, v := range rec.Settings {
+		switch k {
+		case "protocol":
+			p, _ := v.(string)
+			sinkCfg.Protocol = otlp.Protocol(p)
+		case "headers":
+			headers, ok := v.(map[string]any)
+			if !ok {
+				return sinkCfg, fmt.Errorf("invalid headers: %v", v)
 			}
-			if mr, ok := rec.Settings["max_restarts"].(int); ok {
-				maxRestarts = mr
+			for name, value := range headers {
+				sinkCfg.Headers[name] = fmt.Sprint(value)
 			}
-
-			launcher, err := subprocess.NewLauncher(subprocess.LauncherConfig{
-				RecorderType: rec.Type,
-				Command:      command,
-				SocketPath:   rec.Address,
-				MaxRestarts:  maxRestarts,
-			})
+		case "timeout":
+			t, _ := v.(string)
+			timeout, err := time.ParseDuration(t)
 			if err != nil {
-				return nil, nil, fmt.Errorf("create launcher for %s: %w", rec.Type, err)
+				return sinkCfg, fmt.Errorf("invalid timeout: %w", err)
 			}
+			sinkCfg.Timeout = timeout
+		default:
+			return sinkCfg, fmt.Errorf("unknown setting: %q", k)
+		}
+	}
 
-			client, err := grpc_client.NewCLient("unix://"+launcher.SocketPath(),
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
-			)
-			if err != nil {
-				return nil, nil, fmt.Errorf("create client: %w", err)
-			}
+	secure := rec.TLS.Enabled || strings.HasPrefix(rec.Address, "https://")
+	if rec.Token != "" {
+		if !secure {
+			return sinkCfg, fmt.Errorf("token requires tls to be enabled")
+		}
+		sinkCfg.Headers["Authorization"] = "Bearer " + rec.Token
+	}
 
-			clients = append(clients, client)
-			launchers = append(launchers, launcher)
+	if rec.TLS.Enabled {
+		tlsConfig, err := grpc_client.NewTLSConfig(grpc_client.TLSConfig{
+			CAFile:     rec.TLS.CAFile,
+			CertFile:   rec.TLS.CertFile,
+			KeyFile:    rec.TLS.KeyFile,
+			ServerName: rec.TLS.ServerName,
+		})
+		if err != nil {
+			return sinkCfg, fmt.Errorf("tls: %w", err)
+		}
+		sinkCfg.TLS = tlsConfig
+	}
 
-		default:
-			return nil, nil, fmt.Errorf("recorder %s: invalid mode %q", rec.Type, rec.Mode)
+	return sinkCfg, nil
+}
+
+func closeTraceSinks(sinks []*otlp.Sink) {
+	for _, s := range sinks {
+		if err := s.Close(); err != nil {
+			slog.Error("error closing trace sink", "target", s.Target(), "error", err)
 		}
 	}
+}
+
+// spoolName returns a name for the spool of the given recorder that does not
+// change across restarts.
+func spoolName(rec config.Recorder) string {
+	name := rec.Type
+	if rec.Mode == config.RecorderModeExternal {
+		name = strings.Trim(rec.Type+"_"+rec.Address, "_")
+	}
 
-	return clients, launchers, nil
+	return strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
 }
 
 func (c *RunConfig) startRecorders(ctx context.Context, launchers []*subprocess.Launcher) error {
 	return nil
 }
 
-func serveHTTP(cfg config.HTTP, reg *prometheus.Registry) (func() error, func(error)) {
+func replaySpools(clients []*grpc_client.Client, spools map[string]*spool.Spool) (func() error, func(error)) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	execute := func() error {
+		var wg sync.WaitGroup
+		for _, client := range clients {
+			s, ok := spools[client.Target()]
+			if !ok {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := s.Run(ctx, client.Conn()); err != nil && !errors.Is(err, context.Canceled) {
+					slog.Error("error replaying spool", "target", client.Target(), "error", err)
+				}
+			}()
+		}
+		wg.Wait()
+		return ctx.Err()
+	}
+
+	interrupt := func(error) {
+		cancel()
+	}
+
+	return execute, interrupt
+}
+
+// readinessCheck returns a check that fails unless GitLab is reachable with
+// the configured credentials, all recorders report to be serving and all
+// recorder subprocesses are running.
+func readinessCheck(glab *gitlab.Client, clients []*grpc_client.Client, launchers []*subprocess.Launcher) healthz.Check {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		defer cancel()
+
+		var errs error
+		if err := glab.CheckReadiness(ctx); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("gitlab: %w", err))
+		}
+		for _, client := range clients {
+			if err := grpc_client.CheckHealth(client, ctx); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("recorder %s: %w", client.Target(), err))
+			}
+		}
+		for _, launcher := range launchers {
+			if err := launcher.CheckHealth(); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+		return errs
+	}
+}
+
+func closeSpools(spools map[string]*spool.Spool) {
+	for _, s := range spools {
+		if err := s.Close(); err != nil {
+			slog.Error("error closing spool", "error", err)
+		}
+	}
+}
+
+func serveHTTP(cfg config.HTTP, reg *prometheus.Registry, health healthz.Handler, handlers map[string]http.Handler) (func() error, func(error)) {
 	m := http.NewServeMux()
 
-	m.Handle("/healthz/", http.StripPrefix("/healthz", healthz.NewHandler()))
+	for pattern, handler := range handlers {
+		m.Handle(pattern, handler)
+	}
+
+	m.Handle("/healthz/", http.StripPrefix("/healthz", health))
 
 	m.Handle(
 		"/metrics",
@@ -379,7 +782,8 @@ func createGitLabClient(cfg config.Config) (*gitlab.Client, error) {
 		URL:   cfg.GitLab.Url,
 		Token: cfg.GitLab.Token,
 
-		RateLimit: cfg.GitLab.Client.Rate.Limit,
+		RateLimit:       cfg.GitLab.Client.Rate.Limit,
+		ComplexityLimit: cfg.GitLab.Client.Rate.GraphQLComplexity,
 	}
 
 	if cfg.GitLab.Username != "" && cfg.GitLab.Password != "" {
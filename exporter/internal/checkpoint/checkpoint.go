@@ -0,0 +1,163 @@
+package checkpoint
+
+import (
+	"sync"
+	"time"
+)
+
+// Kind identifies the type of data a checkpoint refers to.
+type Kind string
+
+const (
+	KindPipelines     Kind = "pipelines"
+	KindMergeRequests Kind = "merge_requests"
+	KindIssues        Kind = "issues"
+	KindDeployments   Kind = "deployments"
+	KindCommits       Kind = "commits"
+	KindReleases      Kind = "releases"
+)
+
+// Kinds returns all kinds of data that are checkpointed.
+func Kinds() []Kind {
+	return []Kind{
+		KindPipelines,
+		KindMergeRequests,
+		KindIssues,
+		KindDeployments,
+		KindCommits,
+		KindReleases,
+	}
+}
+
+// Checkpoint marks the point in time up to which data of a given kind has
+// been successfully exported for a project.
+type Checkpoint struct {
+	ProjectId     int64     `json:"project_id"`
+	Kind          Kind      `json:"kind"`
+	UpdatedBefore time.Time `json:"updated_before"`
+}
+
+// Head marks the commit of a branch up to which the commits of a project
+// have been successfully exported. Unlike the commit date, it also covers
+// commits that were pushed or merged long after they were made.
+type Head struct {
+	ProjectId int64  `json:"project_id"`
+	Ref       string `json:"ref"`
+	SHA       string `json:"sha"`
+}
+
+// Store persists export checkpoints across restarts.
+type Store interface {
+	// Get returns the checkpoint for the given project and kind, if any.
+	Get(projectId int64, kind Kind) (time.Time, bool)
+	// Set stores the given checkpoints, overwriting existing ones.
+	Set(checkpoints ...Checkpoint) error
+	// GetHead returns the last exported head of the given project branch, if
+	// any.
+	GetHead(projectId int64, ref string) (string, bool)
+	// SetHeads stores the given heads, overwriting existing ones.
+	SetHeads(heads ...Head) error
+	// Close releases any resources held by the store.
+	Close() error
+}
+
+type key struct {
+	projectId int64
+	kind      Kind
+}
+
+type headKey struct {
+	projectId int64
+	ref       string
+}
+
+// MemoryStore is a Store that keeps checkpoints in memory only.
+type MemoryStore struct {
+	mu          sync.RWMutex
+	checkpoints map[key]time.Time
+	heads       map[headKey]string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		checkpoints: make(map[key]time.Time),
+		heads:       make(map[headKey]string),
+	}
+}
+
+func (s *MemoryStore) Get(projectId int64, kind Kind) (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.checkpoints[key{projectId, kind}]
+	return t, ok
+}
+
+func (s *MemoryStore) Set(checkpoints ...Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.set(checkpoints...)
+	return nil
+}
+
+func (s *MemoryStore) set(checkpoints ...Checkpoint) {
+	for _, cp := range checkpoints {
+		k := key{cp.ProjectId, cp.Kind}
+		// never move a checkpoint backwards
+		if t, ok := s.checkpoints[k]; ok && t.After(cp.UpdatedBefore) {
+			continue
+		}
+		s.checkpoints[k] = cp.UpdatedBefore.UTC()
+	}
+}
+
+func (s *MemoryStore) list() []Checkpoint {
+	checkpoints := make([]Checkpoint, 0, len(s.checkpoints))
+	for k, t := range s.checkpoints {
+		checkpoints = append(checkpoints, Checkpoint{
+			ProjectId:     k.projectId,
+			Kind:          k.kind,
+			UpdatedBefore: t,
+		})
+	}
+	return checkpoints
+}
+
+func (s *MemoryStore) GetHead(projectId int64, ref string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sha, ok := s.heads[headKey{projectId, ref}]
+	return sha, ok
+}
+
+func (s *MemoryStore) SetHeads(heads ...Head) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.setHeads(heads...)
+	return nil
+}
+
+func (s *MemoryStore) setHeads(heads ...Head) {
+	for _, h := range heads {
+		s.heads[headKey{h.ProjectId, h.Ref}] = h.SHA
+	}
+}
+
+func (s *MemoryStore) listHeads() []Head {
+	heads := make([]Head, 0, len(s.heads))
+	for k, sha := range s.heads {
+		heads = append(heads, Head{
+			ProjectId: k.projectId,
+			Ref:       k.ref,
+			SHA:       sha,
+		})
+	}
+	return heads
+}
+
+func (s *MemoryStore) Close() error {
+	return nil
+}
@@ -0,0 +1,134 @@
+package checkpoint
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// FileStore is a Store that persists checkpoints as JSON in a local file.
+//
+// The file is rewritten atomically on every call to Set, so that a crash
+// never leaves a partially written checkpoint file behind.
+type FileStore struct {
+	MemoryStore
+
+	path string
+}
+
+type fileData struct {
+	Checkpoints []Checkpoint `json:"checkpoints"`
+	Heads       []Head       `json:"heads,omitempty"`
+}
+
+// OpenFileStore opens the checkpoint file at the given path, creating it on
+// the first write if it does not exist yet.
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		MemoryStore: MemoryStore{
+			checkpoints: make(map[key]time.Time),
+			heads:       make(map[headKey]string),
+		},
+		path: filepath.Clean(path),
+	}
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read checkpoint file: %w", err)
+	}
+
+	if len(data) == 0 {
+		return s, nil
+	}
+
+	var fd fileData
+	if err := json.Unmarshal(data, &fd); err != nil {
+		return nil, fmt.Errorf("parse checkpoint file: %w", err)
+	}
+	s.MemoryStore.set(fd.Checkpoints...)
+	s.MemoryStore.setHeads(fd.Heads...)
+
+	return s, nil
+}
+
+func (s *FileStore) Set(checkpoints ...Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.MemoryStore.set(checkpoints...)
+
+	return s.write()
+}
+
+func (s *FileStore) SetHeads(heads ...Head) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.MemoryStore.setHeads(heads...)
+
+	return s.write()
+}
+
+// write must be called with the lock held.
+func (s *FileStore) write() error {
+	fd := fileData{
+		Checkpoints: s.MemoryStore.list(),
+		Heads:       s.MemoryStore.listHeads(),
+	}
+	slices.SortFunc(fd.Checkpoints, func(a, b Checkpoint) int {
+		return cmp.Or(
+			cmp.Compare(a.ProjectId, b.ProjectId),
+			cmp.Compare(a.Kind, b.Kind),
+		)
+	})
+	slices.SortFunc(fd.Heads, func(a, b Head) int {
+		return cmp.Or(
+			cmp.Compare(a.ProjectId, b.ProjectId),
+			cmp.Compare(a.Ref, b.Ref),
+		)
+	})
+
+	data, err := json.MarshalIndent(fd, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal checkpoints: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("create checkpoint directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temporary checkpoint file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write checkpoint file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("sync checkpoint file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close checkpoint file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace checkpoint file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileStore) Close() error {
+	return nil
+}
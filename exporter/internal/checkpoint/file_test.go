@@ -0,0 +1,120 @@
+package checkpoint_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
+)
+
+func TestFileStore_Persist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+
+	store, err := checkpoint.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := store.Get(42, checkpoint.KindPipelines); ok {
+		t.Fatalf("expected no checkpoint in empty store")
+	}
+
+	ts := time.Date(2024, time.March, 15, 8, 0, 0, 0, time.UTC)
+	err = store.Set(
+		checkpoint.Checkpoint{ProjectId: 42, Kind: checkpoint.KindPipelines, UpdatedBefore: ts},
+		checkpoint.Checkpoint{ProjectId: 42, Kind: checkpoint.KindIssues, UpdatedBefore: ts.Add(-time.Hour)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := checkpoint.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		kind checkpoint.Kind
+		want time.Time
+		ok   bool
+	}{
+		{checkpoint.KindPipelines, ts, true},
+		{checkpoint.KindIssues, ts.Add(-time.Hour), true},
+		{checkpoint.KindMergeRequests, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.kind), func(t *testing.T) {
+			got, ok := reopened.Get(42, tt.kind)
+			if ok != tt.ok {
+				t.Fatalf("want ok=%v, got ok=%v", tt.ok, ok)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_NeverMovesBackwards(t *testing.T) {
+	store := checkpoint.NewMemoryStore()
+
+	ts := time.Date(2024, time.March, 15, 8, 0, 0, 0, time.UTC)
+	_ = store.Set(checkpoint.Checkpoint{ProjectId: 1, Kind: checkpoint.KindDeployments, UpdatedBefore: ts})
+	_ = store.Set(checkpoint.Checkpoint{ProjectId: 1, Kind: checkpoint.KindDeployments, UpdatedBefore: ts.Add(-time.Minute)})
+
+	got, _ := store.Get(1, checkpoint.KindDeployments)
+	if !got.Equal(ts) {
+		t.Errorf("want %v, got %v", ts, got)
+	}
+}
+
+func TestFileStore_PersistHeads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+
+	store, err := checkpoint.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.GetHead(42, "main"); ok {
+		t.Fatalf("expected no head in empty store")
+	}
+
+	err = store.SetHeads(
+		checkpoint.Head{ProjectId: 42, Ref: "main", SHA: "a1"},
+		checkpoint.Head{ProjectId: 42, Ref: "release", SHA: "b2"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetHeads(checkpoint.Head{ProjectId: 42, Ref: "main", SHA: "c3"}); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := checkpoint.OpenFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		ref  string
+		want string
+		ok   bool
+	}{
+		{"main", "c3", true},
+		{"release", "b2", true},
+		{"develop", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, ok := reopened.GetHead(42, tt.ref)
+			if ok != tt.ok {
+				t.Fatalf("want ok=%v, got ok=%v", tt.ok, ok)
+			}
+			if got != tt.want {
+				t.Errorf("want %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
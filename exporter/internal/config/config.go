@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/creasty/defaults"
 )
@@ -20,8 +21,16 @@ type Config struct {
 	Namespaces []Namespace `default:"[]" yaml:"namespaces"`
 	// Non-project specific export options
 	Export Export `default:"{}" yaml:"export"`
+	// Schedule of resolving projects and fetching data
+	Schedule Schedule `default:"{}" yaml:"schedule"`
+	// Export checkpoint settings
+	Checkpoints Checkpoints `default:"{}" yaml:"checkpoints"`
+	// Spool settings for buffering data while recorders are unavailable
+	Spool Spool `default:"{}" yaml:"spool"`
 	// HTTP server settings
 	HTTP HTTP `default:"{}" yaml:"http"`
+	// Webhook receiver settings
+	Webhook Webhook `default:"{}" yaml:"webhook"`
 	// Log configuration settings
 	Log Log `default:"{}" yaml:"log"`
 
@@ -39,7 +48,11 @@ type GitLab struct {
 
 	Client struct {
 		Rate struct {
+			// Maximum number of requests per second, shared by the REST,
+			// GraphQL and HTTP clients
 			Limit float64 `default:"0.0" yaml:"limit"`
+			// Maximum GraphQL query complexity points per minute
+			GraphQLComplexity float64 `default:"0.0" yaml:"graphql_complexity"`
 		} `yaml:"rate"`
 	} `yaml:"client"`
 }
@@ -54,12 +67,32 @@ const (
 	RecorderModeExternal RecorderMode = "external"
 )
 
+// RecorderTypeOTLP is the type of the built-in recorder that sends pipeline,
+// job and section traces to an OTLP endpoint, e.g. an OpenTelemetry collector.
+const RecorderTypeOTLP = "otlp"
+
 type Recorder struct {
 	Type     string         `default:"" yaml:"type"`
 	Address  string         `default:"" yaml:"address"`
 	Mode     RecorderMode   `default:"subprocess" yaml:"mode"`
 	Enabled  bool           `default:"true" yaml:"enabled"`
 	Settings map[string]any `default:"{}" yaml:"settings"`
+
+	// TLS settings for connecting to external recorders
+	TLS RecorderTLS `default:"{}" yaml:"tls"`
+	// Bearer token to authenticate with external recorders, requires TLS
+	Token string `default:"" yaml:"token"`
+}
+
+type RecorderTLS struct {
+	Enabled bool `default:"false" yaml:"enabled"`
+	// CA bundle to verify the recorder certificate, defaults to the system roots
+	CAFile string `default:"" yaml:"ca_file"`
+	// Client certificate and key for mutual TLS
+	CertFile string `default:"" yaml:"cert_file"`
+	KeyFile  string `default:"" yaml:"key_file"`
+	// Name to verify the recorder certificate against, defaults to the address host
+	ServerName string `default:"" yaml:"server_name"`
 }
 
 type Endpoint struct {
@@ -70,6 +103,16 @@ type Project struct {
 	ProjectSettings `default:"{}" yaml:",inline"`
 
 	Id int64 `yaml:"id"`
+	// Full path of the project, used if no id is given
+	Path string `default:"" yaml:"path"`
+}
+
+// Pid returns the id of the project, or its full path if no id is given.
+func (p Project) Pid() any {
+	if p.Id != 0 {
+		return int(p.Id)
+	}
+	return p.Path
 }
 
 type ProjectSettings struct {
@@ -78,7 +121,9 @@ type ProjectSettings struct {
 }
 
 type ProjectExport struct {
+	Commits       ProjectExportCommits       `default:"{}" yaml:"commits"`
 	Deployments   ProjectExportDeployments   `default:"{}" yaml:"deployments"`
+	Environments  ProjectExportEnvironments  `default:"{}" yaml:"environments"`
 	Issues        ProjectExportIssues        `default:"{}" yaml:"issues"`
 	Jobs          ProjectExportJobs          `default:"{}" yaml:"jobs"`
 	Sections      ProjectExportSections      `default:"{}" yaml:"sections"`
@@ -87,24 +132,60 @@ type ProjectExport struct {
 	Traces        ProjectExportTraces        `default:"{}" yaml:"traces"`
 	Metrics       ProjectExportMetrics       `default:"{}" yaml:"metrics"`
 	MergeRequests ProjectExportMergeRequests `default:"{}" yaml:"mergerequests"`
+	Releases      ProjectExportReleases      `default:"{}" yaml:"releases"`
+}
+
+type ProjectExportCommits struct {
+	Enabled bool `default:"false" yaml:"enabled"`
+	// Patterns of protected branches whose commits are exported in addition
+	// to those of the default branch, e.g. `release/*`
+	ProtectedBranches []string `default:"" yaml:"protected_branches"`
 }
 
 type ProjectExportDeployments struct {
 	Enabled bool `default:"true" yaml:"enabled"`
 }
 
+type ProjectExportEnvironments struct {
+	Enabled bool `default:"true" yaml:"enabled"`
+}
+
 type ProjectExportIssues struct {
 	Enabled bool `default:"true" yaml:"enabled"`
 }
 
 type ProjectExportJobs struct {
-	Properties ProjectExportJobsProperties `default:"{}" yaml:"properties"`
+	Properties        ProjectExportJobsProperties        `default:"{}" yaml:"properties"`
+	FailureCategories ProjectExportJobsFailureCategories `default:"{}" yaml:"failure_categories"`
+	Artifacts         ProjectExportJobsArtifacts         `default:"{}" yaml:"artifacts"`
 }
 
 type ProjectExportJobsProperties struct {
 	Enabled bool `default:"true" yaml:"enabled"`
 }
 
+type ProjectExportJobsFailureCategories struct {
+	Enabled bool `default:"false" yaml:"enabled"`
+	// Whether the default rules are appended to the configured rules
+	DefaultRules bool                                     `default:"true" yaml:"default_rules"`
+	Rules        []ProjectExportJobsFailureCategoriesRule `default:"" yaml:"rules"`
+}
+
+type ProjectExportJobsFailureCategoriesRule struct {
+	Category string `default:"" yaml:"category"`
+	// Either a regular expression or a LogQL line filter matching log lines
+	Regexp     string `default:"" yaml:"regexp"`
+	LineFilter string `default:"" yaml:"line_filter"`
+}
+
+type ProjectExportJobsArtifacts struct {
+	Enabled bool `default:"false" yaml:"enabled"`
+}
+
+type ProjectExportReleases struct {
+	Enabled bool `default:"false" yaml:"enabled"`
+}
+
 type ProjectExportSections struct {
 	Enabled bool `default:"true" yaml:"enabled"`
 }
@@ -136,7 +217,10 @@ type ProjectExportMetrics struct {
 }
 
 type ProjectExportMetricsLogQuery struct {
-	Name       string            `default:"" yaml:"name"`
+	Name string `default:"" yaml:"name"`
+	// LogQL-like query that extracts metric samples from the log lines, see
+	// logql.Query, takes precedence over the line filter
+	Query      string            `default:"" yaml:"query"`
 	LineFilter string            `default:"" yaml:"line_filter"`
 	LabelAdd   map[string]string `default:"{}" yaml:"label_add"`
 }
@@ -145,6 +229,8 @@ type ProjectExportMergeRequests struct {
 	Enabled bool `default:"true" yaml:"enabled"`
 
 	NoteEvents bool `default:"true" yaml:"note_events"`
+
+	Events bool `default:"false" yaml:"events"`
 }
 
 type ProjectCatchUp struct {
@@ -164,21 +250,106 @@ type Namespace struct {
 	IncludeSubgroups bool   `default:"false" yaml:"include_subgroups"`
 
 	ExcludeProjects []string `default:"[]" yaml:"exclude_projects"`
+
+	// Projects are selected if they match any include filter, or there are
+	// none, and do not match any exclude filter
+	Include []ProjectFilter `default:"[]" yaml:"include"`
+	Exclude []ProjectFilter `default:"[]" yaml:"exclude"`
+}
+
+// ProjectFilter matches projects that satisfy all of its set conditions.
+type ProjectFilter struct {
+	// Full path of the project, where `*` matches any characters but `/` and
+	// `**` matches any characters, e.g. `platform/**/service-*`
+	Path string `default:"" yaml:"path"`
+	// Topics the project must have
+	Topics []string `default:"[]" yaml:"topics"`
+	// Archived state of the project, matches both if unset
+	Archived *bool `yaml:"archived"`
+	// Maximum number of days since the last activity in the project
+	ActiveWithinDays int `default:"0" yaml:"active_within_days"`
 }
 
 type Export struct {
 	Runners ExportRunners `default:"{}" yaml:"runners"`
+	JobLogs ExportJobLogs `default:"{}" yaml:"job_logs"`
+
+	// Size in bytes after which fetched records are exported, instead of
+	// waiting for all data of a batch of projects to be fetched
+	FlushSize int64 `default:"4194304" yaml:"flush_size"`
+	// Maximum size in bytes of the records being exported at the same time,
+	// fetching waits while it is reached, 0 means unlimited
+	MemoryBudget int64 `default:"268435456" yaml:"memory_budget"`
 }
 
 type ExportRunners struct {
 	Enabled bool `default:"false" yaml:"enabled"`
 }
 
+type ExportJobLogs struct {
+	// Maximum number of bytes of a job log that are parsed, the rest of the
+	// log is skipped, 0 means unlimited
+	MaxSize int64 `default:"104857600" yaml:"max_size"`
+	// Number of bytes at the end of a job log that are fetched if only job
+	// properties are exported, 0 means the whole log
+	TailSize int64 `default:"0" yaml:"tail_size"`
+}
+
+// Schedule holds when each kind of data is fetched. Every schedule is either
+// an interval like `5m` or a cron expression like `*/15 * * * *`.
+type Schedule struct {
+	// Schedule of resolving the projects of namespaces
+	Resolve string `default:"30m" yaml:"resolve"`
+	// Default schedule of fetching data, if empty `5m`, or `1h` if the webhook
+	// receiver is enabled
+	Export string `default:"" yaml:"export"`
+
+	// Schedules of fetching each kind of data, if empty the default is used
+	Pipelines     string `default:"" yaml:"pipelines"`
+	MergeRequests string `default:"" yaml:"merge_requests"`
+	Issues        string `default:"" yaml:"issues"`
+	Deployments   string `default:"" yaml:"deployments"`
+	Commits       string `default:"" yaml:"commits"`
+	Releases      string `default:"" yaml:"releases"`
+	Environments  string `default:"" yaml:"environments"`
+	Runners       string `default:"" yaml:"runners"`
+	// Schedule of inventorying the artifacts of all jobs again
+	JobArtifacts string `default:"24h" yaml:"job_artifacts"`
+	// Schedule of fetching all tags and releases again
+	Tags string `default:"24h" yaml:"tags"`
+
+	// Maximum random delay added to each scheduled run
+	Jitter time.Duration `default:"0s" yaml:"jitter"`
+}
+
+type Checkpoints struct {
+	Enabled bool   `default:"false" yaml:"enabled"`
+	Path    string `default:"gitlab-exporter-checkpoints.json" yaml:"path"`
+}
+
+type Spool struct {
+	Enabled bool          `default:"false" yaml:"enabled"`
+	Path    string        `default:"gitlab-exporter-spool" yaml:"path"`
+	MaxSize int64         `default:"1073741824" yaml:"max_size"`
+	MaxAge  time.Duration `default:"168h" yaml:"max_age"`
+}
+
 type HTTP struct {
 	Enabled bool   `default:"true" yaml:"enabled"`
 	Host    string `default:"127.0.0.1" yaml:"host"`
 	Port    string `default:"9100" yaml:"port"`
 	Debug   bool   `default:"false" yaml:"debug"`
+
+	Healthz struct {
+		// Number of export intervals without a completed export after which
+		// the liveness check fails, 0 disables the check
+		MaxStalledIntervals int `default:"3" yaml:"max_stalled_intervals"`
+	} `yaml:"healthz"`
+}
+
+type Webhook struct {
+	Enabled bool   `default:"false" yaml:"enabled"`
+	Secret  string `default:"" yaml:"secret"`
 }
 
 type Log struct {
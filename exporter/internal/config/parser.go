@@ -19,7 +19,11 @@ func (c *Config) UnmarshalYAML(v *yaml.Node) error {
 		Projects        []yaml.Node     `yaml:"projects"`
 		Namespaces      []yaml.Node     `yaml:"namespaces"`
 		Export          Export          `yaml:"export"`
+		Schedule        Schedule        `yaml:"schedule"`
+		Checkpoints     Checkpoints     `yaml:"checkpoints"`
+		Spool           Spool           `yaml:"spool"`
 		HTTP            HTTP            `yaml:"http"`
+		Webhook         Webhook         `yaml:"webhook"`
 		Log             Log             `yaml:"log"`
 	}
 
@@ -29,7 +33,11 @@ func (c *Config) UnmarshalYAML(v *yaml.Node) error {
 	_cfg.Endpoints = c.Endpoints
 	_cfg.ProjectDefaults = c.ProjectDefaults
 	_cfg.Export = c.Export
+	_cfg.Schedule = c.Schedule
+	_cfg.Checkpoints = c.Checkpoints
+	_cfg.Spool = c.Spool
 	_cfg.HTTP = c.HTTP
+	_cfg.Webhook = c.Webhook
 	_cfg.Log = c.Log
 
 	if err := v.Decode(&_cfg); err != nil {
@@ -41,7 +49,11 @@ func (c *Config) UnmarshalYAML(v *yaml.Node) error {
 	c.Endpoints = _cfg.Endpoints
 	c.ProjectDefaults = _cfg.ProjectDefaults
 	c.Export = _cfg.Export
+	c.Schedule = _cfg.Schedule
+	c.Checkpoints = _cfg.Checkpoints
+	c.Spool = _cfg.Spool
 	c.HTTP = _cfg.HTTP
+	c.Webhook = _cfg.Webhook
 	c.Log = _cfg.Log
 
 	for _, n := range _cfg.Projects {
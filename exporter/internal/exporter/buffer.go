@@ -0,0 +1,135 @@
+package exporter
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
+)
+
+// Budget limits the total size of the records that are exported at the same
+// time by all buffers sharing it.
+type Budget struct {
+	size int64
+	sem  *semaphore.Weighted
+}
+
+// NewBudget returns a budget of the given number of bytes. A nil budget,
+// returned if size is not positive, is unlimited.
+func NewBudget(size int64) *Budget {
+	if size <= 0 {
+		return nil
+	}
+	return &Budget{
+		size: size,
+		sem:  semaphore.NewWeighted(size),
+	}
+}
+
+// Size returns the number of bytes of the budget, or 0 if it is unlimited.
+func (b *Budget) Size() int64 {
+	if b == nil {
+		return 0
+	}
+	return b.size
+}
+
+// acquire waits until n bytes, but at most the whole budget, are available
+// and returns the number of bytes acquired.
+func (b *Budget) acquire(ctx context.Context, n int64) (int64, error) {
+	if b == nil {
+		return 0, nil
+	}
+	n = min(n, b.size)
+	if err := b.sem.Acquire(ctx, n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (b *Budget) release(n int64) {
+	if b == nil || n <= 0 {
+		return
+	}
+	b.sem.Release(n)
+}
+
+// Buffer collects records and exports them in batches once their size
+// reaches the flush size. Exporting a batch waits for the budget and blocks
+// adding further records until it is done, so that fetching slows down to
+// the pace of the slowest recorder instead of piling up data in memory.
+type Buffer[T any] struct {
+	budget    *Budget
+	flushSize int64
+	size      func(T) int
+	export    func(ctx context.Context, data []T) error
+
+	mu    sync.Mutex
+	data  []T
+	bytes int64
+}
+
+// NewBuffer returns a buffer that exports its records with the given
+// function. The size function estimates the number of bytes a record takes
+// when exported, see MessageSize. If flushSize is not positive, records are
+// exported only when the buffer is flushed.
+func NewBuffer[T any](budget *Budget, flushSize int64, size func(T) int, export func(ctx context.Context, data []T) error) *Buffer[T] {
+	return &Buffer[T]{
+		budget:    budget,
+		flushSize: flushSize,
+		size:      size,
+		export:    export,
+	}
+}
+
+// Add adds records to the buffer and exports the buffered records if their
+// size reaches the flush size.
+func (b *Buffer[T]) Add(ctx context.Context, data ...T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, d := range data {
+		b.data = append(b.data, d)
+		b.bytes += int64(b.size(d))
+	}
+
+	if b.flushSize <= 0 || b.bytes < b.flushSize {
+		return nil
+	}
+	return b.flush(ctx)
+}
+
+// Flush exports the buffered records.
+func (b *Buffer[T]) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.flush(ctx)
+}
+
+func (b *Buffer[T]) flush(ctx context.Context) error {
+	if len(b.data) == 0 {
+		return nil
+	}
+
+	n, err := b.budget.acquire(ctx, b.bytes)
+	if err != nil {
+		return err
+	}
+	defer b.budget.release(n)
+
+	data := b.data
+	b.data = nil
+	b.bytes = 0
+
+	return b.export(ctx, data)
+}
+
+// MessageSize returns a function that estimates the size of a record by the
+// size of the message it is converted to.
+func MessageSize[T any, M proto.Message](cfun func(data T) M) func(T) int {
+	return func(data T) int {
+		return proto.Size(cfun(data))
+	}
+}
@@ -0,0 +1,105 @@
+package exporter
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuffer_Add(t *testing.T) {
+	var batches [][]int
+	export := func(_ context.Context, data []int) error {
+		batches = append(batches, data)
+		return nil
+	}
+	size := func(int) int { return 10 }
+
+	buf := NewBuffer(nil, 30, size, export)
+	ctx := context.Background()
+
+	for i := range 7 {
+		if err := buf.Add(ctx, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := buf.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := buf.Flush(ctx); err != nil { // noop
+		t.Fatal(err)
+	}
+
+	expected := [][]int{{0, 1, 2}, {3, 4, 5}, {6}}
+	if diff := cmp.Diff(expected, batches); diff != "" {
+		t.Errorf("Mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuffer_Budget(t *testing.T) {
+	budget := NewBudget(100)
+	size := func(int) int { return 60 }
+
+	var (
+		mu       sync.Mutex
+		inflight int
+		maxSeen  int
+	)
+	export := func(_ context.Context, data []int) error {
+		mu.Lock()
+		inflight += len(data)
+		maxSeen = max(maxSeen, inflight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inflight -= len(data)
+		mu.Unlock()
+		return nil
+	}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for range 4 {
+		buf := NewBuffer(budget, 1, size, export)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 3 {
+				if err := buf.Add(ctx, i); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// a single record fits into the budget, two do not
+	if maxSeen != 1 {
+		t.Errorf("Expected at most 1 record to be exported at a time, got %d", maxSeen)
+	}
+
+	// records larger than the budget are exported on their own
+	buf := NewBuffer(budget, 0, func(int) int { return 1000 }, export)
+	if err := buf.Add(ctx, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := buf.Flush(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// waiting for the budget is canceled with the context
+	if _, err := budget.acquire(ctx, 100); err != nil {
+		t.Fatal(err)
+	}
+	defer budget.release(100)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := NewBuffer(budget, 1, size, export).Add(ctx, 1); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
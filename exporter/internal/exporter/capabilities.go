@@ -0,0 +1,157 @@
+package exporter
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+const protocolVersion = servicepb.ProtocolVersion_PROTOCOL_VERSION_1
+
+// capabilities holds the record kinds accepted by a recorder.
+type capabilities struct {
+	// kinds is nil if the recorder does not advertise its capabilities,
+	// in which case all kinds are sent until the recorder rejects them.
+	kinds map[servicepb.RecordKind]bool
+
+	// reported remembers the kinds already reported as skipped.
+	reported map[servicepb.RecordKind]bool
+
+	// stream is true if the recorder implements the RecordStream RPC.
+	stream bool
+}
+
+func (c *capabilities) accepts(kind servicepb.RecordKind) bool {
+	return c.kinds == nil || c.kinds[kind]
+}
+
+// accepts reports whether the client's recorder accepts the given kind of
+// data. The recorder capabilities are queried on first use. If they cannot be
+// determined yet, e.g. because the recorder is still starting, the data is
+// sent anyway and the query is retried on the next export.
+func (e *Exporter) accepts(ctx context.Context, client *grpc_client.Client, kind servicepb.RecordKind) bool {
+	caps := e.capabilitiesOf(ctx, client)
+	if caps == nil || caps.accepts(kind) {
+		return true
+	}
+
+	e.reportSkipped(client, kind)
+	return false
+}
+
+// streams reports whether the client's recorder accepts records over a
+// RecordStream call.
+func (e *Exporter) streams(ctx context.Context, client *grpc_client.Client) bool {
+	caps := e.capabilitiesOf(ctx, client)
+	return caps != nil && caps.stream
+}
+
+// capabilitiesOf returns the capabilities of the client's recorder, querying
+// them if they are not known yet. It returns nil if the query failed.
+func (e *Exporter) capabilitiesOf(ctx context.Context, client *grpc_client.Client) *capabilities {
+	e.mu.Lock()
+	caps, ok := e.capabilities[client.Target()]
+	e.mu.Unlock()
+	if ok {
+		return caps
+	}
+
+	caps, err := negotiate(ctx, client)
+	if err != nil {
+		slog.Debug("error querying recorder capabilities", "target", client.Target(), "error", err)
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if c, ok := e.capabilities[client.Target()]; ok {
+		return c // negotiated concurrently
+	}
+	e.capabilities[client.Target()] = caps
+	return caps
+}
+
+// reject marks the given kind as not accepted by the client's recorder after
+// it refused a request with codes.Unimplemented.
+func (e *Exporter) reject(client *grpc_client.Client, kind servicepb.RecordKind) {
+	e.mu.Lock()
+	caps, ok := e.capabilities[client.Target()]
+	if !ok {
+		caps = &capabilities{}
+		e.capabilities[client.Target()] = caps
+	}
+	if caps.kinds == nil {
+		caps.kinds = make(map[servicepb.RecordKind]bool)
+		for k := range servicepb.RecordKind_name {
+			caps.kinds[servicepb.RecordKind(k)] = true
+		}
+	}
+	caps.kinds[kind] = false
+	e.mu.Unlock()
+
+	e.reportSkipped(client, kind)
+}
+
+// reportSkipped logs once per client that a kind of data is skipped.
+func (e *Exporter) reportSkipped(client *grpc_client.Client, kind servicepb.RecordKind) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	caps := e.capabilities[client.Target()]
+	if caps.reported[kind] {
+		return
+	}
+	if caps.reported == nil {
+		caps.reported = make(map[servicepb.RecordKind]bool)
+	}
+	caps.reported[kind] = true
+
+	slog.Warn("Recorder does not accept data kind, skipping",
+		"target", client.Target(),
+		"kind", kind.String(),
+	)
+}
+
+// negotiate queries the capabilities of the client's recorder. Recorders
+// that do not implement the capabilities RPC are assumed to accept all kinds.
+func negotiate(ctx context.Context, client *grpc_client.Client) (*capabilities, error) {
+	resp, err := grpc_client.GetCapabilities(client, ctx)
+	if status.Code(err) == codes.Unimplemented {
+		slog.Info("Recorder does not advertise its capabilities, assuming all data kinds are accepted",
+			"target", client.Target(),
+		)
+		return &capabilities{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if v := resp.GetProtocolVersion(); v != protocolVersion {
+		slog.Warn("Recorder protocol version differs from exporter",
+			"target", client.Target(),
+			"recorder_version", int32(v),
+			"exporter_version", int32(protocolVersion),
+		)
+	}
+
+	caps := &capabilities{
+		kinds:  make(map[servicepb.RecordKind]bool, len(resp.GetRecordKinds())),
+		stream: resp.GetRecordStream(),
+	}
+	for _, kind := range resp.GetRecordKinds() {
+		caps.kinds[kind] = true
+	}
+
+	slog.Info("Negotiated recorder capabilities",
+		"target", client.Target(),
+		"protocol_version", int32(resp.GetProtocolVersion()),
+		"record_kinds", len(caps.kinds),
+		"record_stream", caps.stream,
+	)
+	return caps, nil
+}
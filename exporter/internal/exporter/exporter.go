@@ -8,25 +8,40 @@ import (
 	"time"
 
 	tracepb_v1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter/messages"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
 	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
 )
 
 type Exporter struct {
-	clients map[string]*grpc_client.Client
+	recordersMu sync.RWMutex
+	clients     map[string]*grpc_client.Client
+	sinks       []TraceSink
+
+	mu           sync.Mutex
+	capabilities map[string]*capabilities
+
+	metrics *metrics
 }
 
 func New() *Exporter {
 	return &Exporter{
-		clients: make(map[string]*grpc_client.Client),
+		clients:      make(map[string]*grpc_client.Client),
+		capabilities: make(map[string]*capabilities),
+		metrics:      newMetrics(),
 	}
 }
 
 func (e *Exporter) AddClient(client *grpc_client.Client) error {
+	e.recordersMu.Lock()
+	defer e.recordersMu.Unlock()
+
 	if _, exists := e.clients[client.Target()]; exists {
 		return fmt.Errorf("client already exists for target URI: %q", client.Target())
 	}
@@ -34,6 +49,36 @@ func (e *Exporter) AddClient(client *grpc_client.Client) error {
 	return nil
 }
 
+// RemoveClient stops exporting to the client with the given target URI.
+// Exports that are already in progress are not affected.
+func (e *Exporter) RemoveClient(target string) error {
+	e.recordersMu.Lock()
+	defer e.recordersMu.Unlock()
+
+	if _, exists := e.clients[target]; !exists {
+		return fmt.Errorf("no client exists for target URI: %q", target)
+	}
+	delete(e.clients, target)
+
+	e.mu.Lock()
+	delete(e.capabilities, target)
+	e.mu.Unlock()
+
+	return nil
+}
+
+// clientList returns the clients currently exported to.
+func (e *Exporter) clientList() []*grpc_client.Client {
+	e.recordersMu.RLock()
+	defer e.recordersMu.RUnlock()
+
+	clients := make([]*grpc_client.Client, 0, len(e.clients))
+	for _, client := range e.clients {
+		clients = append(clients, client)
+	}
+	return clients
+}
+
 type convertFunc[T any, M proto.Message] func(data T) M
 
 func convert[T any, M proto.Message](data []T, cfun convertFunc[T, M]) []M {
@@ -71,7 +116,9 @@ func filterNil[T any](data []*T) []*T {
 
 type recordFunc[T proto.Message] func(client *grpc_client.Client, ctx context.Context, data []T) error
 
-func export[T proto.Message](exp *Exporter, ctx context.Context, data []T, record recordFunc[T]) error {
+type streamFunc[T proto.Message] func(stream *grpc_client.RecordStream, data []T) error
+
+func export[T proto.Message](exp *Exporter, ctx context.Context, data []T, kind servicepb.RecordKind, record recordFunc[T], send streamFunc[T]) error {
 	if len(data) == 0 { // noop
 		return nil
 	}
@@ -85,7 +132,29 @@ func export[T proto.Message](exp *Exporter, ctx context.Context, data []T, recor
 	// for each client, export batches concurrently
 	var wg sync.WaitGroup
 	errChan := make(chan error)
-	for _, client := range exp.clients {
+	for _, client := range exp.clientList() {
+		// skip data the recorder does not accept
+		if !exp.accepts(ctx, client, kind) {
+			continue
+		}
+
+		// send batches in order over the client's stream, if any
+		if stream, ok := streamFor(ctx, client); ok {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				for _, batch := range batches {
+					if err := send(stream, batch); err != nil {
+						exp.metrics.recorderError(client.Target(), kindLabel(kind))
+						errChan <- err
+						return
+					}
+				}
+			}()
+			continue
+		}
+
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -107,6 +176,11 @@ func export[T proto.Message](exp *Exporter, ctx context.Context, data []T, recor
 					}()
 					// send batch
 					if err := record(client, ctx, batch); err != nil {
+						if status.Code(err) == codes.Unimplemented {
+							exp.reject(client, kind)
+							return
+						}
+						exp.metrics.recorderError(client.Target(), kindLabel(kind))
 						errChan <- err
 					}
 				}()
@@ -168,86 +242,112 @@ func rangeEndForSize[T proto.Message](data []T, size int, start int) int {
 	return end
 }
 
-func (e *Exporter) ExportCommits(ctx context.Context, data []*typespb.Commit) error {
-	return export[*typespb.Commit](e, ctx, data, grpc_client.RecordCommits)
+func (e *Exporter) ExportCommits(ctx context.Context, data []types.Commit) error {
+	msgs := convert(data, messages.NewCommit)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_COMMITS, grpc_client.RecordCommits, grpc_client.StreamCommits)
 }
 
 func (e *Exporter) ExportCoverageReports(ctx context.Context, data []types.CoverageReport) error {
 	msgs := convert(data, messages.NewCoverageReport)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordCoverageReports)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_COVERAGE_REPORTS, grpc_client.RecordCoverageReports, grpc_client.StreamCoverageReports)
 }
 
 func (e *Exporter) ExportCoveragePackages(ctx context.Context, data []types.CoveragePackage) error {
 	msgs := convert(data, messages.NewCoveragePackage)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordCoveragePackages)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_COVERAGE_PACKAGES, grpc_client.RecordCoveragePackages, grpc_client.StreamCoveragePackages)
 }
 
 func (e *Exporter) ExportCoverageClasses(ctx context.Context, data []types.CoverageClass) error {
 	msgs := convert(data, messages.NewCoverageClass)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordCoverageClasses)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_COVERAGE_CLASSES, grpc_client.RecordCoverageClasses, grpc_client.StreamCoverageClasses)
 }
 
 func (e *Exporter) ExportCoverageMethods(ctx context.Context, data []types.CoverageMethod) error {
 	msgs := convert(data, messages.NewCoverageMethod)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordCoverageMethods)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_COVERAGE_METHODS, grpc_client.RecordCoverageMethods, grpc_client.StreamCoverageMethods)
 }
 
 func (e *Exporter) ExportDeployments(ctx context.Context, data []types.Deployment) error {
 	msgs := convert(data, messages.NewDeployment)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordDeployments)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_DEPLOYMENTS, grpc_client.RecordDeployments, grpc_client.StreamDeployments)
+}
+
+func (e *Exporter) ExportEnvironments(ctx context.Context, data []types.Environment) error {
+	msgs := convert(data, messages.NewEnvironment)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_ENVIRONMENTS, grpc_client.RecordEnvironments, grpc_client.StreamEnvironments)
 }
 
 func (e *Exporter) ExportIssues(ctx context.Context, data []types.Issue) error {
 	msgs := convert(data, messages.NewIssue)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordIssues)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_ISSUES, grpc_client.RecordIssues, grpc_client.StreamIssues)
 }
 
 func (e *Exporter) ExportJobs(ctx context.Context, data []types.Job) error {
 	msgs := convert(data, messages.NewJob)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordJobs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_JOBS, grpc_client.RecordJobs, grpc_client.StreamJobs)
+}
+
+func (e *Exporter) ExportJobArtifacts(ctx context.Context, data []types.JobArtifact) error {
+	msgs := convert(data, messages.NewJobArtifact)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_JOB_ARTIFACTS, grpc_client.RecordJobArtifacts, grpc_client.StreamJobArtifacts)
 }
 
 func (e *Exporter) ExportMergeRequests(ctx context.Context, data []types.MergeRequest) error {
 	msgs := convert(data, messages.NewMergeRequest)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordMergeRequests)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_MERGE_REQUESTS, grpc_client.RecordMergeRequests, grpc_client.StreamMergeRequests)
 }
 
 func (e *Exporter) ExportMergeRequestCommits(ctx context.Context, data []types.MergeRequestCommit) error {
 	msgs := convert(data, messages.NewMergeRequestCommit)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordMergeRequestCommits)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_COMMITS, grpc_client.RecordMergeRequestCommits, grpc_client.StreamMergeRequestCommits)
+}
+
+func (e *Exporter) ExportMergeRequestEvents(ctx context.Context, data []types.MergeRequestEvent) error {
+	msgs := convert(data, messages.NewMergeRequestEvent)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_EVENTS, grpc_client.RecordMergeRequestEvents, grpc_client.StreamMergeRequestEvents)
 }
 
 func (e *Exporter) ExportMergeRequestNoteEvents(ctx context.Context, data []types.MergeRequestNoteEvent) error {
 	msgs := convert(data, messages.NewMergeRequestNoteEvent)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordMergeRequestNoteEvents)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS, grpc_client.RecordMergeRequestNoteEvents, grpc_client.StreamMergeRequestNoteEvents)
 }
 
 func (e *Exporter) ExportMetrics(ctx context.Context, data []types.Metric) error {
 	msgs := convert(data, messages.NewMetric)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordMetrics)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_METRICS, grpc_client.RecordMetrics, grpc_client.StreamMetrics)
 }
 
 func (e *Exporter) ExportPipelines(ctx context.Context, data []types.Pipeline) error {
 	msgs := convert(data, messages.NewPipeline)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordPipelines)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_PIPELINES, grpc_client.RecordPipelines, grpc_client.StreamPipelines)
 }
 
 func (e *Exporter) ExportProjects(ctx context.Context, data []types.Project) error {
 	msgs := convert(data, messages.NewProject)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordProjects)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_PROJECTS, grpc_client.RecordProjects, grpc_client.StreamProjects)
+}
+
+func (e *Exporter) ExportReleases(ctx context.Context, data []types.Release) error {
+	msgs := convert(data, messages.NewRelease)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_RELEASES, grpc_client.RecordReleases, grpc_client.StreamReleases)
 }
 
 func (e *Exporter) ExportRunners(ctx context.Context, data []types.Runner, fetchedAt time.Time) error {
@@ -256,31 +356,40 @@ func (e *Exporter) ExportRunners(ctx context.Context, data []types.Runner, fetch
 	record := func(client *grpc_client.Client, ctx context.Context, data []*typespb.Runner) error {
 		return grpc_client.RecordRunners(client, ctx, data, fetchedAt)
 	}
-	return export(e, ctx, msgs, record)
+	send := func(stream *grpc_client.RecordStream, data []*typespb.Runner) error {
+		return grpc_client.StreamRunners(stream, data, fetchedAt)
+	}
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_RUNNERS, record, send)
 }
 
 func (e *Exporter) ExportSections(ctx context.Context, data []types.Section) error {
 	msgs := convert(data, messages.NewSection)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordSections)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_SECTIONS, grpc_client.RecordSections, grpc_client.StreamSections)
+}
+
+func (e *Exporter) ExportTags(ctx context.Context, data []types.Tag) error {
+	msgs := convert(data, messages.NewTag)
+	msgs = filterNil(msgs)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TAGS, grpc_client.RecordTags, grpc_client.StreamTags)
 }
 
 func (e *Exporter) ExportTestCases(ctx context.Context, data []types.TestCase) error {
 	msgs := convert(data, messages.NewTestCase)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordTestCases)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TEST_CASES, grpc_client.RecordTestCases, grpc_client.StreamTestCases)
 }
 
 func (e *Exporter) ExportTestReports(ctx context.Context, data []types.TestReport) error {
 	msgs := convert(data, messages.NewTestReport)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordTestReports)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TEST_REPORTS, grpc_client.RecordTestReports, grpc_client.StreamTestReports)
 }
 
 func (e *Exporter) ExportTestSuites(ctx context.Context, data []types.TestSuite) error {
 	msgs := convert(data, messages.NewTestSuite)
 	msgs = filterNil(msgs)
-	return export(e, ctx, msgs, grpc_client.RecordTestSuites)
+	return export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TEST_SUITES, grpc_client.RecordTestSuites, grpc_client.StreamTestSuites)
 }
 
 func (e *Exporter) ExportPipelineSpans(ctx context.Context, data []types.Pipeline) error {
@@ -305,7 +414,16 @@ func (e *Exporter) ExportPipelineSpans(ctx context.Context, data []types.Pipelin
 		})
 	}
 
-	return export(e, ctx, msgs, grpc_client.RecordTraces)
+	err = export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TRACES, grpc_client.RecordTraces, grpc_client.StreamTraces)
+
+	resourceSpans := groupSpans(data, "gitlab_ci.pipeline", messages.NewPipelineSpan, func(p types.Pipeline) traceResource {
+		return traceResource{
+			ProjectId:   p.Project.Id,
+			ProjectPath: p.Project.FullPath,
+			Ref:         p.Ref,
+		}
+	})
+	return errors.Join(err, e.exportToSinks(ctx, resourceSpans))
 }
 
 func (e *Exporter) ExportJobSpans(ctx context.Context, data []types.Job) error {
@@ -330,7 +448,16 @@ func (e *Exporter) ExportJobSpans(ctx context.Context, data []types.Job) error {
 		})
 	}
 
-	return export(e, ctx, msgs, grpc_client.RecordTraces)
+	err = export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TRACES, grpc_client.RecordTraces, grpc_client.StreamTraces)
+
+	resourceSpans := groupSpans(data, "gitlab_ci.job", messages.NewJobSpan, func(j types.Job) traceResource {
+		return traceResource{
+			ProjectId:   j.Pipeline.Project.Id,
+			ProjectPath: j.Pipeline.Project.FullPath,
+			Ref:         j.Ref,
+		}
+	})
+	return errors.Join(err, e.exportToSinks(ctx, resourceSpans))
 }
 
 func (e *Exporter) ExportSectionSpans(ctx context.Context, data []types.Section) error {
@@ -355,5 +482,13 @@ func (e *Exporter) ExportSectionSpans(ctx context.Context, data []types.Section)
 		})
 	}
 
-	return export(e, ctx, msgs, grpc_client.RecordTraces)
+	err = export(e, ctx, msgs, servicepb.RecordKind_RECORD_KIND_TRACES, grpc_client.RecordTraces, grpc_client.StreamTraces)
+
+	resourceSpans := groupSpans(data, "gitlab_ci.section", messages.NewSectionSpan, func(s types.Section) traceResource {
+		return traceResource{
+			ProjectId:   s.Job.Pipeline.Project.Id,
+			ProjectPath: s.Job.Pipeline.Project.FullPath,
+		}
+	})
+	return errors.Join(err, e.exportToSinks(ctx, resourceSpans))
 }
@@ -1,12 +1,26 @@
 package exporter
 
 import (
+	"context"
+	"net"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
 	tracepb_v1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 )
 
 func Test_convert_and_filter(t *testing.T) {
@@ -30,3 +44,330 @@ func Test_convert_and_filter(t *testing.T) {
 		t.Errorf("Expected 0 results after filtering, got %d", len(results))
 	}
 }
+
+type capabilitiesServer struct {
+	servicepb.UnimplementedGitLabExporterServer
+
+	kinds  []servicepb.RecordKind // nil: capabilities not implemented
+	stream bool
+	fail   bool // fail all record calls
+
+	mu       sync.Mutex
+	recorded []string
+}
+
+func (s *capabilitiesServer) record(kind string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorded = append(s.recorded, kind)
+}
+
+func (s *capabilitiesServer) GetCapabilities(ctx context.Context, r *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	if s.kinds == nil {
+		return s.UnimplementedGitLabExporterServer.GetCapabilities(ctx, r)
+	}
+	return &servicepb.Capabilities{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+		RecordKinds:     s.kinds,
+		RecordStream:    s.stream,
+	}, nil
+}
+
+func (s *capabilitiesServer) RecordPipelines(ctx context.Context, r *servicepb.RecordPipelinesRequest) (*servicepb.RecordSummary, error) {
+	s.record("pipelines")
+	return &servicepb.RecordSummary{RecordedCount: int32(len(r.Data))}, nil
+}
+
+func (s *capabilitiesServer) RecordJobs(ctx context.Context, r *servicepb.RecordJobsRequest) (*servicepb.RecordSummary, error) {
+	if s.fail {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	s.record("jobs")
+	return &servicepb.RecordSummary{RecordedCount: int32(len(r.Data))}, nil
+}
+
+func (s *capabilitiesServer) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(s, stream)
+}
+
+// newCapabilitiesExporter returns an exporter connected to the given server
+// and a function returning the number of calls per method.
+func newCapabilitiesExporter(t *testing.T, srv *capabilitiesServer) (*Exporter, func(method string) int) {
+	t.Helper()
+
+	var (
+		mu    sync.Mutex
+		calls = make(map[string]int)
+	)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			mu.Lock()
+			calls[info.FullMethod]++
+			mu.Unlock()
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			mu.Lock()
+			calls[info.FullMethod]++
+			mu.Unlock()
+			return handler(srv, ss)
+		}),
+	)
+	servicepb.RegisterGitLabExporterServer(grpcServer, srv)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := grpc_client.NewCLient(
+		"passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exp := New()
+	if err := exp.AddClient(client); err != nil {
+		t.Fatal(err)
+	}
+
+	return exp, func(method string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[method]
+	}
+}
+
+func TestExporter_Capabilities(t *testing.T) {
+	exp, calls := newCapabilitiesExporter(t, &capabilitiesServer{
+		kinds: []servicepb.RecordKind{servicepb.RecordKind_RECORD_KIND_PIPELINES},
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		if err := exp.ExportPipelines(ctx, []types.Pipeline{{Id: 1}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := exp.ExportIssues(ctx, []types.Issue{{Id: 1}}); err != nil {
+			t.Fatalf("want unsupported kinds to be skipped, got error: %v", err)
+		}
+	}
+
+	if n := calls(servicepb.GitLabExporter_GetCapabilities_FullMethodName); n != 1 {
+		t.Errorf("want capabilities to be queried once, got %d", n)
+	}
+	if n := calls(servicepb.GitLabExporter_RecordPipelines_FullMethodName); n != 2 {
+		t.Errorf("want 2 pipeline requests, got %d", n)
+	}
+	if n := calls(servicepb.GitLabExporter_RecordIssues_FullMethodName); n != 0 {
+		t.Errorf("want no issue requests, got %d", n)
+	}
+}
+
+func TestExporter_Capabilities_Unimplemented(t *testing.T) {
+	exp, calls := newCapabilitiesExporter(t, &capabilitiesServer{})
+
+	ctx := context.Background()
+	for range 2 {
+		if err := exp.ExportPipelines(ctx, []types.Pipeline{{Id: 1}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := exp.ExportIssues(ctx, []types.Issue{{Id: 1}}); err != nil {
+			t.Fatalf("want unimplemented kinds to be skipped, got error: %v", err)
+		}
+	}
+
+	if n := calls(servicepb.GitLabExporter_RecordPipelines_FullMethodName); n != 2 {
+		t.Errorf("want 2 pipeline requests, got %d", n)
+	}
+	if n := calls(servicepb.GitLabExporter_RecordIssues_FullMethodName); n != 1 {
+		t.Errorf("want issues to be sent once until rejected, got %d", n)
+	}
+}
+
+func TestExporter_Stream(t *testing.T) {
+	srv := &capabilitiesServer{
+		kinds: []servicepb.RecordKind{
+			servicepb.RecordKind_RECORD_KIND_PIPELINES,
+			servicepb.RecordKind_RECORD_KIND_JOBS,
+		},
+		stream: true,
+	}
+	exp, calls := newCapabilitiesExporter(t, srv)
+
+	err := exp.Stream(context.Background(), func(ctx context.Context) error {
+		if err := exp.ExportPipelines(ctx, []types.Pipeline{{Id: 1}}); err != nil {
+			return err
+		}
+		if err := exp.ExportJobs(ctx, []types.Job{{Id: 2}}); err != nil {
+			return err
+		}
+		return exp.ExportPipelines(ctx, []types.Pipeline{{Id: 3}})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := calls(servicepb.GitLabExporter_RecordStream_FullMethodName); n != 1 {
+		t.Errorf("want a single stream, got %d", n)
+	}
+	if n := calls(servicepb.GitLabExporter_RecordPipelines_FullMethodName); n != 0 {
+		t.Errorf("want no unary pipeline requests, got %d", n)
+	}
+
+	want := []string{"pipelines", "jobs", "pipelines"}
+	if diff := cmp.Diff(want, srv.recorded); diff != "" {
+		t.Errorf("recorded mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestExporter_RemoveClient(t *testing.T) {
+	exp, calls := newCapabilitiesExporter(t, &capabilitiesServer{
+		kinds: []servicepb.RecordKind{servicepb.RecordKind_RECORD_KIND_PIPELINES},
+	})
+
+	ctx := context.Background()
+	if err := exp.ExportPipelines(ctx, []types.Pipeline{{Id: 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exp.RemoveClient("passthrough://bufnet"); err != nil {
+		t.Fatal(err)
+	}
+	if err := exp.RemoveClient("passthrough://bufnet"); err == nil {
+		t.Error("expected error removing a client twice")
+	}
+
+	if err := exp.ExportPipelines(ctx, []types.Pipeline{{Id: 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if n := calls(servicepb.GitLabExporter_RecordPipelines_FullMethodName); n != 1 {
+		t.Errorf("want no requests to removed client, got %d in total", n)
+	}
+}
+
+func TestExporter_RecorderErrors(t *testing.T) {
+	exp, _ := newCapabilitiesExporter(t, &capabilitiesServer{
+		kinds: []servicepb.RecordKind{servicepb.RecordKind_RECORD_KIND_JOBS},
+		fail:  true,
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		if err := exp.ExportJobs(ctx, []types.Job{{Id: 1}}); err == nil {
+			t.Fatal("want error from failing recorder")
+		}
+	}
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(exp.MetricsCollector())
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]float64)
+	for _, mf := range mfs {
+		for _, m := range mf.GetMetric() {
+			labels := make([]string, 0, len(m.GetLabel()))
+			for _, l := range m.GetLabel() {
+				labels = append(labels, l.GetName()+"="+l.GetValue())
+			}
+			got[mf.GetName()+"{"+strings.Join(labels, ",")+"}"] = m.GetCounter().GetValue()
+		}
+	}
+	want := map[string]float64{
+		"gitlab_exporter_recorder_errors_total{kind=jobs,recorder=passthrough://bufnet}": 2,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("metrics mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+type fakeTraceSink struct {
+	mu   sync.Mutex
+	data []*tracepb_v1.ResourceSpans
+}
+
+func (s *fakeTraceSink) Target() string { return "fake" }
+
+func (s *fakeTraceSink) ExportTraces(_ context.Context, data []*tracepb_v1.ResourceSpans) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = append(s.data, data...)
+	return nil
+}
+
+func TestExporter_TraceSink(t *testing.T) {
+	exp := New()
+	sink := &fakeTraceSink{}
+	if err := exp.AddTraceSink(sink); err != nil {
+		t.Fatal(err)
+	}
+	if err := exp.AddTraceSink(sink); err == nil {
+		t.Error("expected error adding a sink twice")
+	}
+
+	started, finished := time.Unix(1, 0), time.Unix(2, 0)
+	project := types.ProjectReference{Id: 42, FullPath: "group/project"}
+	data := []types.Pipeline{
+		{Id: 1, Project: project, Ref: "main", StartedAt: &started, FinishedAt: &finished},
+		{Id: 2, Project: project, Ref: "feature", StartedAt: &started, FinishedAt: &finished},
+		{Id: 3, Project: project, Ref: "main", StartedAt: &started, FinishedAt: &finished},
+		{Id: 4, Project: project, Ref: "main"}, // not started
+	}
+	if err := exp.ExportPipelineSpans(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+
+	type resource struct {
+		Attributes map[string]string
+		Spans      int
+	}
+	var got []resource
+	for _, rs := range sink.data {
+		attrs := make(map[string]string)
+		for _, kv := range rs.GetResource().GetAttributes() {
+			attrs[kv.GetKey()] = kv.GetValue().GetStringValue()
+		}
+		got = append(got, resource{Attributes: attrs, Spans: len(rs.GetScopeSpans()[0].GetSpans())})
+	}
+
+	want := []resource{
+		{
+			Attributes: map[string]string{
+				"service.name":    "gitlab_ci.pipeline",
+				"ci.project.id":   "42",
+				"ci.project.path": "group/project",
+				"ci.ref":          "main",
+			},
+			Spans: 2,
+		},
+		{
+			Attributes: map[string]string{
+				"service.name":    "gitlab_ci.pipeline",
+				"ci.project.id":   "42",
+				"ci.project.path": "group/project",
+				"ci.ref":          "feature",
+			},
+			Spans: 1,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("resource spans mismatch (-want, +got):\n%s", diff)
+	}
+
+	if err := exp.RemoveTraceSink(sink.Target()); err != nil {
+		t.Fatal(err)
+	}
+	if err := exp.ExportPipelineSpans(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+	if len(sink.data) != len(want) {
+		t.Errorf("want no spans exported to removed sink, got %d resources", len(sink.data))
+	}
+}
@@ -0,0 +1,58 @@
+package messages
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func NewCommit(commit types.Commit) *typespb.Commit {
+	c := &typespb.Commit{
+		Id:        commit.Sha,
+		ShortId:   commit.ShortSha,
+		ParentIds: commit.ParentIds,
+		ProjectId: commit.Project.Id,
+
+		AuthorName:     commit.AuthorName,
+		AuthorEmail:    commit.AuthorEmail,
+		AuthoredDate:   timestamppb.New(valOrZero(commit.AuthoredDate)),
+		CommitterName:  commit.CommitterName,
+		CommitterEmail: commit.CommitterEmail,
+		CommittedDate:  timestamppb.New(valOrZero(commit.CommittedDate)),
+		CreatedAt:      timestamppb.New(valOrZero(commit.CreatedAt)),
+
+		Title:   commit.Title,
+		Message: commit.Message,
+		// Trailers: nil,
+
+		// Stats: nil,
+
+		Status: commit.Status,
+		WebUrl: commit.WebUrl,
+
+		Ref: commit.Ref,
+	}
+
+	// Trailers
+	if len(commit.Trailers) > 0 {
+		c.Trailers = make([]*typespb.CommitTrailer, 0, len(commit.Trailers))
+		for _, trailer := range commit.Trailers {
+			c.Trailers = append(c.Trailers, &typespb.CommitTrailer{
+				Key:   trailer.Key,
+				Value: trailer.Value,
+			})
+		}
+	}
+
+	// Stats
+	if commit.Stats != nil {
+		c.Stats = &typespb.CommitStats{
+			Additions: commit.Stats.Additions,
+			Deletions: commit.Stats.Deletions,
+			Total:     commit.Stats.Total,
+		}
+	}
+
+	return c
+}
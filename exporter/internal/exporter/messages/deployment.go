@@ -10,29 +10,32 @@ import (
 )
 
 func NewEnvironmentReference(env types.EnvironmentReference) *typespb.EnvironmentReference {
-	var tier typespb.DeploymentTier = typespb.DeploymentTier_DEPLOYMENT_TIER_UNSPECIFIED
-	switch strings.ToLower(env.Tier) {
-	case "production":
-		tier = typespb.DeploymentTier_DEPLOYMENT_TIER_PRODUCTION
-	case "staging":
-		tier = typespb.DeploymentTier_DEPLOYMENT_TIER_STAGING
-	case "testing":
-		tier = typespb.DeploymentTier_DEPLOYMENT_TIER_TESTING
-	case "development":
-		tier = typespb.DeploymentTier_DEPLOYMENT_TIER_DEVELOPMENT
-	case "other":
-		tier = typespb.DeploymentTier_DEPLOYMENT_TIER_OTHER
-	}
-
 	return &typespb.EnvironmentReference{
 		Id:   env.Id,
 		Name: env.Name,
-		Tier: tier,
+		Tier: convertDeploymentTier(env.Tier),
 
 		Project: NewProjectReference(env.Project),
 	}
 }
 
+func convertDeploymentTier(tier string) typespb.DeploymentTier {
+	switch strings.ToLower(tier) {
+	case "production":
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_PRODUCTION
+	case "staging":
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_STAGING
+	case "testing":
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_TESTING
+	case "development":
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_DEVELOPMENT
+	case "other":
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_OTHER
+	default:
+		return typespb.DeploymentTier_DEPLOYMENT_TIER_UNSPECIFIED
+	}
+}
+
 func NewDeployment(dep types.Deployment) *typespb.Deployment {
 	var status typespb.DeploymentStatus = typespb.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED
 	switch strings.ToLower(dep.Status) {
@@ -0,0 +1,50 @@
+package messages
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func NewEnvironment(env types.Environment) *typespb.Environment {
+	e := &typespb.Environment{
+		Id:      env.Id,
+		Project: NewProjectReference(env.Project),
+
+		Name:        env.Name,
+		Slug:        env.Slug,
+		Description: env.Description,
+		ExternalUrl: env.ExternalUrl,
+
+		State: convertEnvironmentState(env.State),
+		Tier:  convertDeploymentTier(env.Tier),
+
+		Timestamps: &typespb.EnvironmentTimestamps{
+			CreatedAt:  timestamppb.New(valOrZero(env.CreatedAt)),
+			UpdatedAt:  timestamppb.New(valOrZero(env.UpdatedAt)),
+			AutoStopAt: timestamppb.New(valOrZero(env.AutoStopAt)),
+		},
+	}
+
+	if env.LastDeployment != nil {
+		e.LastDeployment = NewDeployment(*env.LastDeployment)
+	}
+
+	return e
+}
+
+func convertEnvironmentState(state string) typespb.EnvironmentState {
+	switch strings.ToLower(state) {
+	case "available":
+		return typespb.EnvironmentState_ENVIRONMENT_STATE_AVAILABLE
+	case "stopping":
+		return typespb.EnvironmentState_ENVIRONMENT_STATE_STOPPING
+	case "stopped":
+		return typespb.EnvironmentState_ENVIRONMENT_STATE_STOPPED
+	default:
+		return typespb.EnvironmentState_ENVIRONMENT_STATE_UNSPECIFIED
+	}
+}
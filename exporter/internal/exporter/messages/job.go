@@ -22,11 +22,13 @@ func NewJob(job types.Job) *typespb.Job {
 		Name:     job.Name,
 		Pipeline: NewPipelineReference(job.Pipeline),
 
-		Ref:           job.Ref,
-		RefPath:       job.RefPath,
-		Status:        job.Status,
-		FailureReason: job.FailureReason,
-		ExitCode:      job.ExitCode,
+		Ref:             job.Ref,
+		RefPath:         job.RefPath,
+		Status:          job.Status,
+		FailureReason:   job.FailureReason,
+		FailureCategory: job.FailureCategory,
+		FailureExcerpt:  job.FailureExcerpt,
+		ExitCode:        job.ExitCode,
 
 		Timestamps: &typespb.JobTimestamps{
 			CreatedAt:  timestamppb.New(valOrZero(job.CreatedAt)),
@@ -86,3 +88,19 @@ func convertJobProperties(props []types.JobLogProperty) []*typespb.JobProperty {
 	}
 	return pbProps
 }
+
+func NewJobArtifact(artifact types.JobArtifact) *typespb.JobArtifact {
+	return &typespb.JobArtifact{
+		Id:  artifact.Id,
+		Job: NewJobReference(artifact.Job),
+
+		FileType: artifact.FileType,
+		Name:     artifact.Name,
+		Size:     artifact.Size,
+
+		ExpireAt: timestamppb.New(valOrZero(artifact.ExpireAt)),
+		Locked:   artifact.Locked,
+
+		JobFinishedAt: timestamppb.New(valOrZero(artifact.JobFinishedAt)),
+	}
+}
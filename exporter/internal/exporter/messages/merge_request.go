@@ -1,6 +1,8 @@
 package messages
 
 import (
+	"strings"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
@@ -154,3 +156,47 @@ func NewMergeRequestNoteEvent(event types.MergeRequestNoteEvent) *typespb.MergeR
 		Resolver:    NewUserReference(event.Resolver),
 	}
 }
+
+func NewMergeRequestEvent(event types.MergeRequestEvent) *typespb.MergeRequestEvent {
+	e := &typespb.MergeRequestEvent{
+		Id:           event.Id,
+		MergeRequest: NewMergeRequestReference(event.MergeRequest),
+		Kind:         convertMergeRequestEventKind(event.Kind),
+
+		CreatedAt: timestamppb.New(valOrZero(event.CreatedAt)),
+		User:      NewUserReference(event.User),
+
+		Action: event.Action,
+
+		Label: event.Label,
+	}
+
+	if event.Milestone != nil {
+		e.Milestone = &typespb.MilestoneReference{
+			Id:      event.Milestone.Id,
+			Iid:     event.Milestone.Iid,
+			Project: NewProjectReference(event.Milestone.Project),
+		}
+	}
+
+	return e
+}
+
+func convertMergeRequestEventKind(kind string) typespb.MergeRequestEventKind {
+	switch strings.ToLower(kind) {
+	case "state":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_STATE
+	case "label":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_LABEL
+	case "milestone":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_MILESTONE
+	case "approval":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_APPROVAL
+	case "review":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_REVIEW
+	case "draft":
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_DRAFT
+	default:
+		return typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_UNSPECIFIED
+	}
+}
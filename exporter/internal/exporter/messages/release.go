@@ -0,0 +1,80 @@
+package messages
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func NewTag(tag types.Tag) *typespb.Tag {
+	return &typespb.Tag{
+		Name:    tag.Name,
+		Project: NewProjectReference(tag.Project),
+
+		CommitSha: tag.CommitSha,
+		Target:    tag.Target,
+		Message:   tag.Message,
+		Protected: tag.Protected,
+
+		CreatedAt:   timestamppb.New(valOrZero(tag.CreatedAt)),
+		CommittedAt: timestamppb.New(valOrZero(tag.CommittedAt)),
+	}
+}
+
+func NewRelease(release types.Release) *typespb.Release {
+	r := &typespb.Release{
+		TagName: release.TagName,
+		Project: NewProjectReference(release.Project),
+
+		Name:        release.Name,
+		Description: release.Description,
+
+		CreatedAt:       timestamppb.New(valOrZero(release.CreatedAt)),
+		ReleasedAt:      timestamppb.New(valOrZero(release.ReleasedAt)),
+		UpcomingRelease: release.UpcomingRelease,
+
+		Author:    NewUserReference(release.Author),
+		CommitSha: release.CommitSha,
+
+		// Milestones: nil,
+		// Evidences: nil,
+
+		// Pipeline: nil,
+	}
+
+	// Milestones
+	if len(release.Milestones) > 0 {
+		r.Milestones = make([]*typespb.ReleaseMilestone, 0, len(release.Milestones))
+		for _, m := range release.Milestones {
+			r.Milestones = append(r.Milestones, &typespb.ReleaseMilestone{
+				Milestone: &typespb.MilestoneReference{
+					Id:      m.Id,
+					Iid:     m.Iid,
+					Project: NewProjectReference(m.Project),
+				},
+				Title: m.Title,
+				State: m.State,
+			})
+		}
+	}
+
+	// Evidences
+	if len(release.Evidences) > 0 {
+		r.Evidences = make([]*typespb.ReleaseEvidence, 0, len(release.Evidences))
+		for _, e := range release.Evidences {
+			r.Evidences = append(r.Evidences, &typespb.ReleaseEvidence{
+				Sha:         e.Sha,
+				Filepath:    e.Filepath,
+				CollectedAt: timestamppb.New(valOrZero(e.CollectedAt)),
+			})
+		}
+	}
+
+	// Pipeline
+	if release.Pipeline != nil {
+		r.Pipeline = NewPipelineReference(*release.Pipeline)
+	}
+
+	return r
+}
@@ -0,0 +1,46 @@
+package exporter
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+type metrics struct {
+	recorderErrors *prometheus.CounterVec
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		recorderErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_exporter_recorder_errors_total",
+			Help: "Total number of failed record calls by recorder and kind of data.",
+		}, []string{"recorder", "kind"}),
+	}
+}
+
+// MetricsCollector returns a collector for the exporter metrics.
+func (e *Exporter) MetricsCollector() prometheus.Collector {
+	return e.metrics
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.recorderErrors.Describe(ch)
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.recorderErrors.Collect(ch)
+}
+
+// recorderError counts a failed record call of the given recorder.
+func (m *metrics) recorderError(recorder string, kind string) {
+	m.recorderErrors.WithLabelValues(recorder, kind).Inc()
+}
+
+// kindLabel returns the metric label of the given kind of data, e.g.
+// "merge_requests" for RECORD_KIND_MERGE_REQUESTS.
+func kindLabel(kind servicepb.RecordKind) string {
+	return strings.ToLower(strings.TrimPrefix(kind.String(), "RECORD_KIND_"))
+}
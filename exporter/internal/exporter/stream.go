@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+)
+
+type streamsKey struct{}
+
+// Stream calls fn with a context in which all exports to recorders that
+// support it go over a single RecordStream call per recorder, so they are
+// recorded in the order they were exported. Recorders without stream support,
+// or whose stream could not be opened, receive unary calls as usual.
+//
+// The streams are closed when fn returns. The returned error includes errors
+// reported by the recorders when closing the streams.
+func (e *Exporter) Stream(ctx context.Context, fn func(ctx context.Context) error) error {
+	streams := make(map[string]*grpc_client.RecordStream)
+	for _, client := range e.clientList() {
+		target := client.Target()
+		if !e.streams(ctx, client) {
+			continue
+		}
+
+		s, err := grpc_client.NewRecordStream(client, ctx)
+		if err != nil {
+			slog.Debug("error opening record stream, falling back to unary calls", "target", target, "error", err)
+			continue
+		}
+		streams[target] = s
+	}
+
+	err := fn(context.WithValue(ctx, streamsKey{}, streams))
+
+	for target, s := range streams {
+		if _, cerr := s.CloseAndRecv(); cerr != nil {
+			e.metrics.recorderError(target, "stream")
+			err = errors.Join(err, fmt.Errorf("%s: %w", target, cerr))
+		}
+	}
+	return err
+}
+
+// streamFor returns the record stream opened by Stream for the given client,
+// if any.
+func streamFor(ctx context.Context, client *grpc_client.Client) (*grpc_client.RecordStream, bool) {
+	streams, _ := ctx.Value(streamsKey{}).(map[string]*grpc_client.RecordStream)
+	s, ok := streams[client.Target()]
+	return s, ok
+}
@@ -0,0 +1,139 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+
+	tracepb_v1 "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter/messages"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+// TraceSink receives the pipeline, job and section spans in addition to the
+// recorders, e.g. to send them to an OpenTelemetry collector.
+type TraceSink interface {
+	Target() string
+	ExportTraces(ctx context.Context, data []*tracepb_v1.ResourceSpans) error
+}
+
+func (e *Exporter) AddTraceSink(sink TraceSink) error {
+	e.recordersMu.Lock()
+	defer e.recordersMu.Unlock()
+
+	for _, s := range e.sinks {
+		if s.Target() == sink.Target() {
+			return fmt.Errorf("trace sink already exists for target: %q", sink.Target())
+		}
+	}
+	e.sinks = append(e.sinks, sink)
+	return nil
+}
+
+// RemoveTraceSink stops exporting to the trace sink with the given target.
+func (e *Exporter) RemoveTraceSink(target string) error {
+	e.recordersMu.Lock()
+	defer e.recordersMu.Unlock()
+
+	i := slices.IndexFunc(e.sinks, func(s TraceSink) bool {
+		return s.Target() == target
+	})
+	if i < 0 {
+		return fmt.Errorf("no trace sink exists for target: %q", target)
+	}
+	e.sinks = slices.Delete(e.sinks, i, i+1)
+	return nil
+}
+
+// traceResource identifies the resource spans are grouped by.
+type traceResource struct {
+	ProjectId   int64
+	ProjectPath string
+	Ref         string
+}
+
+func (r traceResource) attributes(serviceName string) map[string]string {
+	attrs := map[string]string{
+		"service.name":    serviceName,
+		"ci.project.id":   strconv.FormatInt(r.ProjectId, 10),
+		"ci.project.path": r.ProjectPath,
+	}
+	if r.Ref != "" {
+		attrs["ci.ref"] = r.Ref
+	}
+	return attrs
+}
+
+type resourceFunc[T any] func(data T) traceResource
+
+// groupSpans converts data to spans grouped by their resource, keeping the
+// order in which resources first appear.
+func groupSpans[T any](data []T, serviceName string, cfun convertFunc[T, *tracepb_v1.Span], rfun resourceFunc[T]) []*tracepb_v1.ResourceSpans {
+	var (
+		resources []traceResource
+		spans     = make(map[traceResource][]*tracepb_v1.Span)
+	)
+	for _, d := range data {
+		span := cfun(d)
+		if span == nil {
+			continue
+		}
+		r := rfun(d)
+		if _, ok := spans[r]; !ok {
+			resources = append(resources, r)
+		}
+		spans[r] = append(spans[r], span)
+	}
+
+	result := make([]*tracepb_v1.ResourceSpans, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, messages.NewResourceSpan(r.attributes(serviceName), spans[r]))
+	}
+	return result
+}
+
+// exportToSinks sends the resource spans to all trace sinks concurrently.
+func (e *Exporter) exportToSinks(ctx context.Context, data []*tracepb_v1.ResourceSpans) error {
+	e.recordersMu.RLock()
+	sinks := slices.Clone(e.sinks)
+	e.recordersMu.RUnlock()
+
+	if len(sinks) == 0 || len(data) == 0 {
+		return nil
+	}
+
+	// split data into batches to keep max message size
+	batches, err := createBatches(data)
+	if err != nil {
+		return fmt.Errorf("create batches: %w", err)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs error
+	)
+	for _, sink := range sinks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for _, batch := range batches {
+				if err := sink.ExportTraces(ctx, batch); err != nil {
+					e.metrics.recorderError(sink.Target(), kindLabel(servicepb.RecordKind_RECORD_KIND_TRACES))
+					mu.Lock()
+					errs = errors.Join(errs, fmt.Errorf("trace sink %s: %w", sink.Target(), err))
+					mu.Unlock()
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
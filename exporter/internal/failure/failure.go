@@ -0,0 +1,158 @@
+// Package failure classifies failed jobs by matching their log lines against
+// a list of rules.
+package failure
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/logql"
+)
+
+// maxExcerptSize is the maximum number of bytes of a matched log line that
+// is kept as excerpt.
+const maxExcerptSize = 512
+
+// Rule assigns a category to jobs whose logs contain a line matching either
+// a regular expression or a LogQL line filter.
+type Rule struct {
+	Category   string
+	Regexp     string
+	LineFilter string
+}
+
+// DefaultRules is the default rule set, ordered by precedence.
+var DefaultRules = []Rule{
+	{Category: "job_timeout", Regexp: `ERROR: Job failed: execution took longer than`},
+	{Category: "runner_system_failure", Regexp: `ERROR: Job failed \(system failure\)`},
+	{Category: "docker_rate_limit", Regexp: `toomanyrequests|(?i)pull rate limit`},
+	{Category: "image_pull", Regexp: `(?i)failed to pull image|pull access denied|manifest unknown|ErrImagePull|ImagePullBackOff`},
+	{Category: "out_of_memory", Regexp: `(?i)\bout of memory\b|OOMKilled|OutOfMemoryError|cannot allocate memory|exit code 137\b`},
+	{Category: "disk_full", Regexp: `(?i)no space left on device`},
+	{Category: "network", Regexp: `(?i)i/o timeout|connection timed out|connection reset by peer|connection refused|TLS handshake timeout|could not resolve host|temporary failure in name resolution|no such host|ETIMEDOUT|ECONNRESET|ECONNREFUSED|EAI_AGAIN|50[234] (Bad Gateway|Service Unavailable|Gateway Time-?out)`},
+	{Category: "flaky_test", Regexp: `\bRERUN\b|(?i)\bflaky\b|retrying failed test`},
+	{Category: "test_failure", Regexp: `(?i)\b[1-9]\d* (failed|failures?)\b|^--- FAIL:|^FAIL\s|Tests run: \d+, Failures: [1-9]|AssertionError`},
+}
+
+type rule struct {
+	category string
+	re       *regexp.Regexp
+	filter   logql.LineFilter
+}
+
+func (r *rule) match(line []byte) bool {
+	if r.re != nil {
+		return r.re.Match(line)
+	}
+	return r.filter.Match(line)
+}
+
+// Classifier holds a compiled list of rules, where earlier rules take
+// precedence over later ones.
+type Classifier struct {
+	rules []rule
+}
+
+// NewClassifier compiles the rules into a classifier.
+func NewClassifier(rules []Rule) (*Classifier, error) {
+	c := &Classifier{
+		rules: make([]rule, 0, len(rules)),
+	}
+	for i, r := range rules {
+		if r.Category == "" {
+			return nil, fmt.Errorf("rule %d: missing category", i)
+		}
+
+		cr := rule{category: r.Category}
+		switch {
+		case r.Regexp != "" && r.LineFilter != "":
+			return nil, fmt.Errorf("rule %d (%s): regexp and line filter are mutually exclusive", i, r.Category)
+		case r.Regexp != "":
+			re, err := regexp.Compile(r.Regexp)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d (%s): %w", i, r.Category, err)
+			}
+			cr.re = re
+		case r.LineFilter != "":
+			filter, err := logql.ParseLineFilter(r.LineFilter)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d (%s): %w", i, r.Category, err)
+			}
+			cr.filter = filter
+		default:
+			return nil, fmt.Errorf("rule %d (%s): missing regexp or line filter", i, r.Category)
+		}
+		c.rules = append(c.rules, cr)
+	}
+	return c, nil
+}
+
+// Matcher returns a matcher that classifies a single job log.
+func (c *Classifier) Matcher() *Matcher {
+	return &Matcher{
+		rules: c.rules,
+		best:  len(c.rules),
+	}
+}
+
+// Result is the classification of a job log.
+type Result struct {
+	Category string
+	// Excerpt is the first log line matching the rule of the category.
+	Excerpt string
+}
+
+// Matcher matches the lines of a job log against the rules of a classifier.
+type Matcher struct {
+	rules   []rule
+	best    int
+	excerpt string
+}
+
+// Add matches a log line against the rules that take precedence over the
+// best matching rule so far.
+func (m *Matcher) Add(line []byte) {
+	if m.best == 0 {
+		return
+	}
+	line = stripANSI(line)
+	for i := range m.rules[:m.best] {
+		if m.rules[i].match(line) {
+			m.best = i
+			m.excerpt = excerpt(line)
+			return
+		}
+	}
+}
+
+// Result returns the category of the rule with the highest precedence that
+// matched any line, or false if no rule matched.
+func (m *Matcher) Result() (Result, bool) {
+	if m.best >= len(m.rules) {
+		return Result{}, false
+	}
+	return Result{
+		Category: m.rules[m.best].category,
+		Excerpt:  m.excerpt,
+	}, true
+}
+
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// stripANSI removes the ANSI escape sequences used to color job logs.
+func stripANSI(line []byte) []byte {
+	if bytes.IndexByte(line, 0x1b) < 0 {
+		return line
+	}
+	return ansiEscape.ReplaceAll(line, nil)
+}
+
+func excerpt(line []byte) string {
+	line = bytes.TrimSpace(line)
+	if len(line) > maxExcerptSize {
+		line = line[:maxExcerptSize]
+	}
+	return strings.ToValidUTF8(string(line), "")
+}
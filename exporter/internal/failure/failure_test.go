@@ -0,0 +1,126 @@
+package failure
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []Rule
+		log      string
+		expected *Result
+	}{
+		{
+			name: "no match",
+			log:  "$ make test\nok\n",
+		},
+		{
+			name: "out of memory",
+			log:  "$ make test\nfatal error: runtime: out of memory\nERROR: Job failed: exit code 2\n",
+			expected: &Result{
+				Category: "out_of_memory",
+				Excerpt:  "fatal error: runtime: out of memory",
+			},
+		},
+		{
+			name: "precedence",
+			log:  "FAIL\tgo.example/pkg\t0.1s\ndial tcp: lookup proxy.golang.org: i/o timeout\n",
+			expected: &Result{
+				Category: "network",
+				Excerpt:  "dial tcp: lookup proxy.golang.org: i/o timeout",
+			},
+		},
+		{
+			name: "first excerpt",
+			log:  "--- FAIL: TestA (0.00s)\n--- FAIL: TestB (0.00s)\n",
+			expected: &Result{
+				Category: "test_failure",
+				Excerpt:  "--- FAIL: TestA (0.00s)",
+			},
+		},
+		{
+			name: "ansi escapes",
+			log:  "\x1b[0KRunning with gitlab-runner\n\x1b[31;1mERROR: Job failed: execution took longer than 1h0m0s seconds\x1b[0;m\n",
+			expected: &Result{
+				Category: "job_timeout",
+				Excerpt:  "ERROR: Job failed: execution took longer than 1h0m0s seconds",
+			},
+		},
+		{
+			name: "docker rate limit",
+			log:  "ERROR: failed to pull image: toomanyrequests: You have reached your pull rate limit.\n",
+			expected: &Result{
+				Category: "docker_rate_limit",
+				Excerpt:  "ERROR: failed to pull image: toomanyrequests: You have reached your pull rate limit.",
+			},
+		},
+		{
+			name: "line filter",
+			rules: []Rule{
+				{Category: "lint", LineFilter: `|= "golangci-lint" |~ "issues?"`},
+			},
+			log: "$ golangci-lint run\n3 issues found by golangci-lint\n",
+			expected: &Result{
+				Category: "lint",
+				Excerpt:  "3 issues found by golangci-lint",
+			},
+		},
+		{
+			name: "excerpt size",
+			rules: []Rule{
+				{Category: "long", Regexp: `^x+$`},
+			},
+			log: strings.Repeat("x", 2*maxExcerptSize),
+			expected: &Result{
+				Category: "long",
+				Excerpt:  strings.Repeat("x", maxExcerptSize),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rules := test.rules
+			if rules == nil {
+				rules = DefaultRules
+			}
+			c, err := NewClassifier(rules)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := c.Matcher()
+			for line := range strings.Lines(test.log) {
+				m.Add([]byte(strings.TrimSuffix(line, "\n")))
+			}
+
+			var result *Result
+			if r, ok := m.Result(); ok {
+				result = &r
+			}
+			if diff := cmp.Diff(test.expected, result); diff != "" {
+				t.Errorf("Mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewClassifier_Invalid(t *testing.T) {
+	tests := [][]Rule{
+		{{Regexp: `error`}},
+		{{Category: "none"}},
+		{{Category: "both", Regexp: `error`, LineFilter: `|= "error"`}},
+		{{Category: "regexp", Regexp: `(error`}},
+		{{Category: "filter", LineFilter: `|=`}},
+	}
+
+	for _, rules := range tests {
+		if _, err := NewClassifier(rules); err == nil {
+			t.Errorf("Expected error for %+v, got nil", rules)
+		}
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/prometheus/client_golang/prometheus"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"golang.org/x/sync/semaphore"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/graphql"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/version"
 )
@@ -22,7 +24,8 @@ type Client struct {
 	GraphQL *graphql.Client
 	HTTP    *HTTPClient
 
-	sem *semaphore.Weighted
+	sem     *semaphore.Weighted
+	limiter *ratelimit.Limiter
 }
 
 type ClientConfig struct {
@@ -31,7 +34,10 @@ type ClientConfig struct {
 
 	Auth AuthConfig
 
+	// Maximum number of requests per second, shared by all API clients
 	RateLimit float64
+	// Maximum GraphQL query complexity points per minute
+	ComplexityLimit float64
 
 	MaxWorkers int
 }
@@ -54,14 +60,19 @@ type BasicAuthConfig struct {
 }
 
 func NewGitLabClient(cfg ClientConfig) (*Client, error) {
-	restClient, err := rest.NewClient(cfg.URL, cfg.Token, cfg.RateLimit)
+	limiter := ratelimit.New(ratelimit.Config{
+		Limit:      cfg.RateLimit,
+		Complexity: cfg.ComplexityLimit,
+	})
+
+	restClient, err := rest.NewClient(cfg.URL, cfg.Token, limiter)
 	if err != nil {
 		return nil, err
 	}
 
-	graphqlClient := graphql.NewClient(cfg.URL, cfg.Token)
+	graphqlClient := graphql.NewClient(cfg.URL, cfg.Token, limiter)
 
-	httpClient, err := NewHTTPClient(cfg.URL, cfg.Auth)
+	httpClient, err := NewHTTPClient(cfg.URL, cfg.Auth, limiter)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +89,8 @@ func NewGitLabClient(cfg ClientConfig) (*Client, error) {
 		GraphQL: graphqlClient,
 		HTTP:    httpClient,
 
-		sem: semaphore.NewWeighted(n),
+		sem:     semaphore.NewWeighted(n),
+		limiter: limiter,
 	}, nil
 }
 
@@ -90,6 +102,11 @@ func (c *Client) Release(n int64) {
 	c.sem.Release(n)
 }
 
+// MetricsCollector returns a collector for the rate limiter metrics.
+func (c *Client) MetricsCollector() prometheus.Collector {
+	return c.limiter.MetricsCollector()
+}
+
 func (c *Client) CheckReadiness(ctx context.Context) error {
 	const readinessEndpoint string = "version"
 
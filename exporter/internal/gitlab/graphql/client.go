@@ -1,11 +1,13 @@
 package graphql
 
 import (
+	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/Khan/genqlient/graphql"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/version"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/httpclient"
 )
@@ -17,7 +19,7 @@ type Client struct {
 	Version version.GitLabVersion
 }
 
-func NewClient(url string, token string) *Client {
+func NewClient(url string, token string, limiter *ratelimit.Limiter) *Client {
 	if !strings.HasSuffix(url, "/") {
 		url += "/"
 	}
@@ -25,7 +27,7 @@ func NewClient(url string, token string) *Client {
 		url += apiPath
 	}
 
-	doer := newAuthedClient(token)
+	doer := newAuthedClient(token, limiter)
 
 	return &Client{
 		client: graphql.NewClientUsingGet(url, doer),
@@ -34,13 +36,18 @@ func NewClient(url string, token string) *Client {
 
 type authedClient struct {
 	*http.Client
-	token string
+	token   string
+	limiter *ratelimit.Limiter
 }
 
-func newAuthedClient(token string) *authedClient {
+func newAuthedClient(token string, limiter *ratelimit.Limiter) *authedClient {
+	client := httpclient.New()
+	client.HTTPClient.Transport = limiter.Transport(client.HTTPClient.Transport, ratelimit.ClientGraphQL)
+
 	return &authedClient{
-		Client: httpclient.New().StandardClient(),
-		token:  token,
+		Client:  client.StandardClient(),
+		token:   token,
+		limiter: limiter,
 	}
 }
 
@@ -49,5 +56,29 @@ func (c *authedClient) Do(req *http.Request) (*http.Response, error) {
 		req.Header.Set("Authorization", "Bearer "+c.token)
 	}
 
-	return c.Client.Do(req)
+	if err := c.limiter.WaitComplexity(req.Context()); err != nil {
+		return nil, fmt.Errorf("wait for complexity budget: %w", err)
+	}
+
+	// queries are sent as GET requests with the query in the url
+	query := req.URL.Query()
+	if q := query.Get("query"); q != "" {
+		query.Set("query", injectQueryComplexity(q))
+		req.URL.RawQuery = query.Encode()
+	}
+
+	resp, err := c.Client.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	qc, ok, err := readQueryComplexity(resp)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if ok {
+		c.limiter.ObserveComplexity(qc.Score, qc.Limit)
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// queryStartRegexp matches the start of the selection set of a query
+// operation.
+var queryStartRegexp = regexp.MustCompile(`^\s*query\s+\w+[^{]*\{`)
+
+// injectQueryComplexity adds the `queryComplexity` field to the top level
+// selection set of the query, so GitLab reports the complexity of the query
+// along with the result. Other operations are returned unchanged.
+func injectQueryComplexity(query string) string {
+	loc := queryStartRegexp.FindStringIndex(query)
+	if loc == nil {
+		return query
+	}
+	return query[:loc[1]] + "\n\tqueryComplexity { score limit }" + query[loc[1]:]
+}
+
+type queryComplexity struct {
+	Score int64 `json:"score"`
+	Limit int64 `json:"limit"`
+}
+
+// readQueryComplexity returns the query complexity reported in the response.
+// The response body is buffered, so it can still be read by the caller.
+func readQueryComplexity(resp *http.Response) (queryComplexity, bool, error) {
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return queryComplexity{}, false, err
+	}
+
+	var data struct {
+		Data struct {
+			QueryComplexity *queryComplexity `json:"queryComplexity"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		// leave it to the caller to handle invalid responses
+		return queryComplexity{}, false, nil
+	}
+	if data.Data.QueryComplexity == nil {
+		return queryComplexity{}, false, nil
+	}
+	return *data.Data.QueryComplexity, true, nil
+}
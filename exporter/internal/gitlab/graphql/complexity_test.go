@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
+)
+
+func TestInjectQueryComplexity(t *testing.T) {
+	query := `
+query getProjects ($ids: [ID!], $_core: Boolean = false) {
+	projects(ids: $ids) {
+		nodes {
+			... ProjectFields
+		}
+	}
+}
+fragment ProjectFields on Project {
+	id
+}
+`
+	got := injectQueryComplexity(query)
+
+	want := "query getProjects ($ids: [ID!], $_core: Boolean = false) {\n\tqueryComplexity { score limit }\n\tprojects(ids: $ids) {"
+	if !strings.Contains(got, want) {
+		t.Errorf("queryComplexity not injected into the query selection set:\n%s", got)
+	}
+	if n := strings.Count(got, "queryComplexity"); n != 1 {
+		t.Errorf("want queryComplexity injected once, got %d times", n)
+	}
+
+	mutation := `mutation doSomething { something { id } }`
+	if got := injectQueryComplexity(mutation); got != mutation {
+		t.Errorf("want mutation unchanged, got %s", got)
+	}
+}
+
+func TestClient_QueryComplexity(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Query().Get("query"), "queryComplexity { score limit }") {
+			t.Errorf("queryComplexity not requested: %s", r.URL.Query().Get("query"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"queryComplexity":{"score":42,"limit":250},"currentUser":null}}`))
+	}))
+	defer srv.Close()
+
+	limiter := ratelimit.New(ratelimit.Config{})
+	client := newAuthedClient("token", limiter)
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+"?query="+`query+getUser+%7B+currentUser+%7B+id+%7D+%7D`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	// the response can still be read
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(body), `"currentUser":null`) {
+		t.Errorf("unexpected response body: %s", body)
+	}
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(limiter.MetricsCollector())
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() == "gitlab_exporter_gitlab_graphql_complexity_total" {
+			if v := mf.GetMetric()[0].GetCounter().GetValue(); v != 42 {
+				t.Errorf("want complexity of 42, got %v", v)
+			}
+		}
+	}
+}
@@ -437,14 +437,25 @@ var AllIssueType = []IssueType{
 
 // JobArtifactFieldsCore includes the GraphQL fields of CiJobArtifact requested by the fragment JobArtifactFieldsCore.
 type JobArtifactFieldsCore struct {
+	// ID of the artifact.
+	Id string `json:"id"`
 	// File type of the artifact.
 	FileType *JobArtifactFileType `json:"fileType"`
 	// File name of the artifact.
 	Name *string `json:"name"`
 	// URL for downloading the artifact's file.
 	DownloadPath *string `json:"downloadPath"`
+	// Size of the artifact in bytes.
+	Size json.Number `json:"size"`
+	// Expiry date of the artifact.
+	ExpireAt *time.Time `json:"expireAt"`
+	// Expired state of the artifact.
+	Expired bool `json:"expired"`
 }
 
+// GetId returns JobArtifactFieldsCore.Id, and is useful for accessing the field via an interface.
+func (v *JobArtifactFieldsCore) GetId() string { return v.Id }
+
 // GetFileType returns JobArtifactFieldsCore.FileType, and is useful for accessing the field via an interface.
 func (v *JobArtifactFieldsCore) GetFileType() *JobArtifactFileType { return v.FileType }
 
@@ -454,6 +465,15 @@ func (v *JobArtifactFieldsCore) GetName() *string { return v.Name }
 // GetDownloadPath returns JobArtifactFieldsCore.DownloadPath, and is useful for accessing the field via an interface.
 func (v *JobArtifactFieldsCore) GetDownloadPath() *string { return v.DownloadPath }
 
+// GetSize returns JobArtifactFieldsCore.Size, and is useful for accessing the field via an interface.
+func (v *JobArtifactFieldsCore) GetSize() json.Number { return v.Size }
+
+// GetExpireAt returns JobArtifactFieldsCore.ExpireAt, and is useful for accessing the field via an interface.
+func (v *JobArtifactFieldsCore) GetExpireAt() *time.Time { return v.ExpireAt }
+
+// GetExpired returns JobArtifactFieldsCore.Expired, and is useful for accessing the field via an interface.
+func (v *JobArtifactFieldsCore) GetExpired() bool { return v.Expired }
+
 type JobArtifactFileType string
 
 const (
@@ -3518,6 +3538,18 @@ func (v *__getProjectIssuesInput) GetUpdatedBefore() *time.Time { return v.Updat
 // GetEndCursor returns __getProjectIssuesInput.EndCursor, and is useful for accessing the field via an interface.
 func (v *__getProjectIssuesInput) GetEndCursor() *string { return v.EndCursor }
 
+// __getProjectJobsArtifactsInput is used internally by genqlient
+type __getProjectJobsArtifactsInput struct {
+	ProjectPath string  `json:"projectPath"`
+	EndCursor   *string `json:"endCursor"`
+}
+
+// GetProjectPath returns __getProjectJobsArtifactsInput.ProjectPath, and is useful for accessing the field via an interface.
+func (v *__getProjectJobsArtifactsInput) GetProjectPath() string { return v.ProjectPath }
+
+// GetEndCursor returns __getProjectJobsArtifactsInput.EndCursor, and is useful for accessing the field via an interface.
+func (v *__getProjectJobsArtifactsInput) GetEndCursor() *string { return v.EndCursor }
+
 // __getProjectMergeRequestCommitsInput is used internally by genqlient
 type __getProjectMergeRequestCommitsInput struct {
 	ProjectPath     string  `json:"projectPath"`
@@ -4317,6 +4349,604 @@ type getProjectIssuesResponse struct {
 // GetProject returns getProjectIssuesResponse.Project, and is useful for accessing the field via an interface.
 func (v *getProjectIssuesResponse) GetProject() *getProjectIssuesProject { return v.Project }
 
+// getProjectJobsArtifactsProject includes the requested fields of the GraphQL type Project.
+type getProjectJobsArtifactsProject struct {
+	ProjectReferenceFields `json:"-"`
+	// Jobs of a project. This field can only be resolved for one project in any single request.
+	Jobs *getProjectJobsArtifactsProjectJobsCiJobConnection `json:"jobs"`
+}
+
+// GetJobs returns getProjectJobsArtifactsProject.Jobs, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProject) GetJobs() *getProjectJobsArtifactsProjectJobsCiJobConnection {
+	return v.Jobs
+}
+
+// GetId returns getProjectJobsArtifactsProject.Id, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProject) GetId() string { return v.ProjectReferenceFields.Id }
+
+// GetFullPath returns getProjectJobsArtifactsProject.FullPath, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProject) GetFullPath() string {
+	return v.ProjectReferenceFields.FullPath
+}
+
+func (v *getProjectJobsArtifactsProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectJobsArtifactsProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectJobsArtifactsProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectReferenceFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectJobsArtifactsProject struct {
+	Jobs *getProjectJobsArtifactsProjectJobsCiJobConnection `json:"jobs"`
+
+	Id string `json:"id"`
+
+	FullPath string `json:"fullPath"`
+}
+
+func (v *getProjectJobsArtifactsProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectJobsArtifactsProject) __premarshalJSON() (*__premarshalgetProjectJobsArtifactsProject, error) {
+	var retval __premarshalgetProjectJobsArtifactsProject
+
+	retval.Jobs = v.Jobs
+	retval.Id = v.ProjectReferenceFields.Id
+	retval.FullPath = v.ProjectReferenceFields.FullPath
+	return &retval, nil
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnection includes the requested fields of the GraphQL type CiJobConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CiJob.
+type getProjectJobsArtifactsProjectJobsCiJobConnection struct {
+	// A list of nodes.
+	Nodes []*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob `json:"nodes"`
+	// Information to aid in pagination.
+	PageInfo getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns getProjectJobsArtifactsProjectJobsCiJobConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnection) GetNodes() []*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob {
+	return v.Nodes
+}
+
+// GetPageInfo returns getProjectJobsArtifactsProjectJobsCiJobConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnection) GetPageInfo() getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob includes the requested fields of the GraphQL type CiJob.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob struct {
+	JobReferenceFields `json:"-"`
+	// Name of the job.
+	Name *string `json:"name"`
+	// When a job has finished running.
+	FinishedAt *time.Time `json:"finishedAt"`
+	// Pipeline the job belongs to.
+	Pipeline *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface `json:"-"`
+	// Artifacts generated by the job.
+	Artifacts *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
+}
+
+// GetName returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Name, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) GetName() *string {
+	return v.Name
+}
+
+// GetFinishedAt returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.FinishedAt, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) GetFinishedAt() *time.Time {
+	return v.FinishedAt
+}
+
+// GetPipeline returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Pipeline, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) GetPipeline() *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface {
+	return v.Pipeline
+}
+
+// GetArtifacts returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Artifacts, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) GetArtifacts() *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection {
+	return v.Artifacts
+}
+
+// GetId returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Id, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) GetId() *string {
+	return v.JobReferenceFields.Id
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob
+		Pipeline json.RawMessage `json:"pipeline"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.JobReferenceFields)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Pipeline
+		src := firstPass.Pipeline
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface)
+			err = __unmarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Pipeline: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob struct {
+	Name *string `json:"name"`
+
+	FinishedAt *time.Time `json:"finishedAt"`
+
+	Pipeline json.RawMessage `json:"pipeline"`
+
+	Artifacts *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
+
+	Id *string `json:"id"`
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob) __premarshalJSON() (*__premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob, error) {
+	var retval __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob
+
+	retval.Name = v.Name
+	retval.FinishedAt = v.FinishedAt
+	{
+
+		dst := &retval.Pipeline
+		src := v.Pipeline
+		if src != nil {
+			var err error
+			*dst, err = __marshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJob.Pipeline: %w", err)
+			}
+		}
+	}
+	retval.Artifacts = v.Artifacts
+	retval.Id = v.JobReferenceFields.Id
+	return &retval, nil
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection includes the requested fields of the GraphQL type CiJobArtifactConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for CiJobArtifact.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection struct {
+	// A list of nodes.
+	Nodes []*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact `json:"nodes"`
+	// Information to aid in pagination.
+	PageInfo getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetNodes returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection) GetNodes() []*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact {
+	return v.Nodes
+}
+
+// GetPageInfo returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection) GetPageInfo() getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact includes the requested fields of the GraphQL type CiJobArtifact.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact struct {
+	JobArtifactFieldsCore `json:"-"`
+}
+
+// GetId returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Id, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetId() string {
+	return v.JobArtifactFieldsCore.Id
+}
+
+// GetFileType returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.FileType, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetFileType() *JobArtifactFileType {
+	return v.JobArtifactFieldsCore.FileType
+}
+
+// GetName returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Name, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetName() *string {
+	return v.JobArtifactFieldsCore.Name
+}
+
+// GetDownloadPath returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.DownloadPath, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetDownloadPath() *string {
+	return v.JobArtifactFieldsCore.DownloadPath
+}
+
+// GetSize returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Size, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetSize() json.Number {
+	return v.JobArtifactFieldsCore.Size
+}
+
+// GetExpireAt returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.ExpireAt, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpireAt() *time.Time {
+	return v.JobArtifactFieldsCore.ExpireAt
+}
+
+// GetExpired returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Expired, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpired() bool {
+	return v.JobArtifactFieldsCore.Expired
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.JobArtifactFieldsCore)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact struct {
+	Id string `json:"id"`
+
+	FileType *JobArtifactFileType `json:"fileType"`
+
+	Name *string `json:"name"`
+
+	DownloadPath *string `json:"downloadPath"`
+
+	Size json.Number `json:"size"`
+
+	ExpireAt *time.Time `json:"expireAt"`
+
+	Expired bool `json:"expired"`
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) __premarshalJSON() (*__premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact, error) {
+	var retval __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact
+
+	retval.Id = v.JobArtifactFieldsCore.Id
+	retval.FileType = v.JobArtifactFieldsCore.FileType
+	retval.Name = v.JobArtifactFieldsCore.Name
+	retval.DownloadPath = v.JobArtifactFieldsCore.DownloadPath
+	retval.Size = v.JobArtifactFieldsCore.Size
+	retval.ExpireAt = v.JobArtifactFieldsCore.ExpireAt
+	retval.Expired = v.JobArtifactFieldsCore.Expired
+	return &retval, nil
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetHasNextPage returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo) GetHasNextPage() bool {
+	return v.pageFields.HasNextPage
+}
+
+// GetEndCursor returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo) GetEndCursor() *string {
+	return v.pageFields.EndCursor
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor *string `json:"endCursor"`
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo) __premarshalJSON() (*__premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo, error) {
+	var retval __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionPageInfo
+
+	retval.HasNextPage = v.pageFields.HasNextPage
+	retval.EndCursor = v.pageFields.EndCursor
+	return &retval, nil
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline includes the requested fields of the GraphQL type Pipeline.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline struct {
+	Typename *string `json:"__typename"`
+	Id       *string `json:"id"`
+	Iid      *string `json:"iid"`
+}
+
+// GetTypename returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline) GetTypename() *string {
+	return v.Typename
+}
+
+// GetId returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline.Id, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline) GetId() *string {
+	return v.Id
+}
+
+// GetIid returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline.Iid, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline) GetIid() *string {
+	return v.Iid
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface includes the requested fields of the GraphQL interface PipelineInterface.
+//
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface is implemented by the following types:
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface interface {
+	implementsGraphQLInterfacegetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() *string
+	// GetIid returns the interface-field "iid" from its implementation.
+	GetIid() *string
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline) implementsGraphQLInterfacegetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface() {
+}
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess) implementsGraphQLInterfacegetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface() {
+}
+
+func __unmarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface(b []byte, v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Pipeline":
+		*v = new(getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline)
+		return json.Unmarshal(b, *v)
+	case "PipelineMinimalAccess":
+		*v = new(getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PipelineInterface.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface(v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline:
+		typename = "Pipeline"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipeline
+		}{typename, v}
+		return json.Marshal(result)
+	case *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess:
+		typename = "PipelineMinimalAccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineInterface: "%T"`, v)
+	}
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess includes the requested fields of the GraphQL type PipelineMinimalAccess.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess struct {
+	Typename *string `json:"__typename"`
+	Id       *string `json:"id"`
+	Iid      *string `json:"iid"`
+}
+
+// GetTypename returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess.Typename, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess) GetTypename() *string {
+	return v.Typename
+}
+
+// GetId returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess.Id, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess) GetId() *string {
+	return v.Id
+}
+
+// GetIid returns getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess.Iid, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionNodesCiJobPipelinePipelineMinimalAccess) GetIid() *string {
+	return v.Iid
+}
+
+// getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo struct {
+	pageFields `json:"-"`
+}
+
+// GetHasNextPage returns getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo) GetHasNextPage() bool {
+	return v.pageFields.HasNextPage
+}
+
+// GetEndCursor returns getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo) GetEndCursor() *string {
+	return v.pageFields.EndCursor
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.pageFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo struct {
+	HasNextPage bool `json:"hasNextPage"`
+
+	EndCursor *string `json:"endCursor"`
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo) __premarshalJSON() (*__premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo, error) {
+	var retval __premarshalgetProjectJobsArtifactsProjectJobsCiJobConnectionPageInfo
+
+	retval.HasNextPage = v.pageFields.HasNextPage
+	retval.EndCursor = v.pageFields.EndCursor
+	return &retval, nil
+}
+
+// getProjectJobsArtifactsResponse is returned by getProjectJobsArtifacts on success.
+type getProjectJobsArtifactsResponse struct {
+	// Find a project.
+	Project *getProjectJobsArtifactsProject `json:"project"`
+}
+
+// GetProject returns getProjectJobsArtifactsResponse.Project, and is useful for accessing the field via an interface.
+func (v *getProjectJobsArtifactsResponse) GetProject() *getProjectJobsArtifactsProject {
+	return v.Project
+}
+
 // getProjectMergeRequestCommitsProject includes the requested fields of the GraphQL type Project.
 type getProjectMergeRequestCommitsProject struct {
 	ProjectReferenceFields `json:"-"`
@@ -7343,12 +7973,17 @@ func (v *getProjectPipelineJobArtifactsProjectPipeline) __premarshalJSON() (*__p
 // getProjectPipelineJobArtifactsProjectPipelineJobCiJob includes the requested fields of the GraphQL type CiJob.
 type getProjectPipelineJobArtifactsProjectPipelineJobCiJob struct {
 	JobReferenceFields `json:"-"`
+	// Name of the job.
+	Name *string `json:"name"`
 	// When a job has finished running.
 	FinishedAt *time.Time `json:"finishedAt"`
 	// Artifacts generated by the job.
 	Artifacts *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
 }
 
+// GetName returns getProjectPipelineJobArtifactsProjectPipelineJobCiJob.Name, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJob) GetName() *string { return v.Name }
+
 // GetFinishedAt returns getProjectPipelineJobArtifactsProjectPipelineJobCiJob.FinishedAt, and is useful for accessing the field via an interface.
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJob) GetFinishedAt() *time.Time {
 	return v.FinishedAt
@@ -7390,6 +8025,8 @@ func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJob) UnmarshalJSON(b
 }
 
 type __premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJob struct {
+	Name *string `json:"name"`
+
 	FinishedAt *time.Time `json:"finishedAt"`
 
 	Artifacts *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
@@ -7408,6 +8045,7 @@ func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJob) MarshalJSON() ([
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJob) __premarshalJSON() (*__premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJob, error) {
 	var retval __premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJob
 
+	retval.Name = v.Name
 	retval.FinishedAt = v.FinishedAt
 	retval.Artifacts = v.Artifacts
 	retval.Id = v.JobReferenceFields.Id
@@ -7440,6 +8078,11 @@ type getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifact
 	JobArtifactFieldsCore `json:"-"`
 }
 
+// GetId returns getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Id, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetId() string {
+	return v.JobArtifactFieldsCore.Id
+}
+
 // GetFileType returns getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.FileType, and is useful for accessing the field via an interface.
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetFileType() *JobArtifactFileType {
 	return v.JobArtifactFieldsCore.FileType
@@ -7455,6 +8098,21 @@ func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArti
 	return v.JobArtifactFieldsCore.DownloadPath
 }
 
+// GetSize returns getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Size, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetSize() json.Number {
+	return v.JobArtifactFieldsCore.Size
+}
+
+// GetExpireAt returns getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.ExpireAt, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpireAt() *time.Time {
+	return v.JobArtifactFieldsCore.ExpireAt
+}
+
+// GetExpired returns getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Expired, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpired() bool {
+	return v.JobArtifactFieldsCore.Expired
+}
+
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7481,11 +8139,19 @@ func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArti
 }
 
 type __premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact struct {
+	Id string `json:"id"`
+
 	FileType *JobArtifactFileType `json:"fileType"`
 
 	Name *string `json:"name"`
 
 	DownloadPath *string `json:"downloadPath"`
+
+	Size json.Number `json:"size"`
+
+	ExpireAt *time.Time `json:"expireAt"`
+
+	Expired bool `json:"expired"`
 }
 
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) MarshalJSON() ([]byte, error) {
@@ -7499,9 +8165,13 @@ func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArti
 func (v *getProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) __premarshalJSON() (*__premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact, error) {
 	var retval __premarshalgetProjectPipelineJobArtifactsProjectPipelineJobCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact
 
+	retval.Id = v.JobArtifactFieldsCore.Id
 	retval.FileType = v.JobArtifactFieldsCore.FileType
 	retval.Name = v.JobArtifactFieldsCore.Name
 	retval.DownloadPath = v.JobArtifactFieldsCore.DownloadPath
+	retval.Size = v.JobArtifactFieldsCore.Size
+	retval.ExpireAt = v.JobArtifactFieldsCore.ExpireAt
+	retval.Expired = v.JobArtifactFieldsCore.Expired
 	return &retval, nil
 }
 
@@ -7747,12 +8417,19 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnection) GetP
 // getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob includes the requested fields of the GraphQL type CiJob.
 type getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob struct {
 	JobReferenceFields `json:"-"`
+	// Name of the job.
+	Name *string `json:"name"`
 	// When a job has finished running.
 	FinishedAt *time.Time `json:"finishedAt"`
 	// Artifacts generated by the job.
 	Artifacts *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
 }
 
+// GetName returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob.Name, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob) GetName() *string {
+	return v.Name
+}
+
 // GetFinishedAt returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob.FinishedAt, and is useful for accessing the field via an interface.
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob) GetFinishedAt() *time.Time {
 	return v.FinishedAt
@@ -7794,6 +8471,8 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesC
 }
 
 type __premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob struct {
+	Name *string `json:"name"`
+
 	FinishedAt *time.Time `json:"finishedAt"`
 
 	Artifacts *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnection `json:"artifacts"`
@@ -7812,6 +8491,7 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesC
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob) __premarshalJSON() (*__premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob, error) {
 	var retval __premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob
 
+	retval.Name = v.Name
 	retval.FinishedAt = v.FinishedAt
 	retval.Artifacts = v.Artifacts
 	retval.Id = v.JobReferenceFields.Id
@@ -7844,6 +8524,11 @@ type getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJob
 	JobArtifactFieldsCore `json:"-"`
 }
 
+// GetId returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Id, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetId() string {
+	return v.JobArtifactFieldsCore.Id
+}
+
 // GetFileType returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.FileType, and is useful for accessing the field via an interface.
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetFileType() *JobArtifactFileType {
 	return v.JobArtifactFieldsCore.FileType
@@ -7859,6 +8544,21 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesC
 	return v.JobArtifactFieldsCore.DownloadPath
 }
 
+// GetSize returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Size, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetSize() json.Number {
+	return v.JobArtifactFieldsCore.Size
+}
+
+// GetExpireAt returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.ExpireAt, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpireAt() *time.Time {
+	return v.JobArtifactFieldsCore.ExpireAt
+}
+
+// GetExpired returns getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact.Expired, and is useful for accessing the field via an interface.
+func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) GetExpired() bool {
+	return v.JobArtifactFieldsCore.Expired
+}
+
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7885,11 +8585,19 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesC
 }
 
 type __premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact struct {
+	Id string `json:"id"`
+
 	FileType *JobArtifactFileType `json:"fileType"`
 
 	Name *string `json:"name"`
 
 	DownloadPath *string `json:"downloadPath"`
+
+	Size json.Number `json:"size"`
+
+	ExpireAt *time.Time `json:"expireAt"`
+
+	Expired bool `json:"expired"`
 }
 
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) MarshalJSON() ([]byte, error) {
@@ -7903,9 +8611,13 @@ func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesC
 func (v *getProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact) __premarshalJSON() (*__premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact, error) {
 	var retval __premarshalgetProjectPipelineJobsArtifactsProjectPipelineJobsCiJobConnectionNodesCiJobArtifactsCiJobArtifactConnectionNodesCiJobArtifact
 
+	retval.Id = v.JobArtifactFieldsCore.Id
 	retval.FileType = v.JobArtifactFieldsCore.FileType
 	retval.Name = v.JobArtifactFieldsCore.Name
 	retval.DownloadPath = v.JobArtifactFieldsCore.DownloadPath
+	retval.Size = v.JobArtifactFieldsCore.Size
+	retval.ExpireAt = v.JobArtifactFieldsCore.ExpireAt
+	retval.Expired = v.JobArtifactFieldsCore.Expired
 	return &retval, nil
 }
 
@@ -14738,6 +15450,85 @@ func getProjectIssues(
 	return data_, err_
 }
 
+// The query executed by getProjectJobsArtifacts.
+const getProjectJobsArtifacts_Operation = `
+query getProjectJobsArtifacts ($projectPath: ID!, $endCursor: String) {
+	project(fullPath: $projectPath) {
+		... ProjectReferenceFields
+		jobs(withArtifacts: true, after: $endCursor) {
+			nodes {
+				... JobReferenceFields
+				name
+				finishedAt
+				pipeline {
+					__typename
+					id
+					iid
+				}
+				artifacts {
+					nodes {
+						... JobArtifactFieldsCore
+					}
+					pageInfo {
+						... pageFields
+					}
+				}
+			}
+			pageInfo {
+				... pageFields
+			}
+		}
+	}
+}
+fragment ProjectReferenceFields on Project {
+	id
+	fullPath
+}
+fragment JobReferenceFields on CiJob {
+	id
+}
+fragment JobArtifactFieldsCore on CiJobArtifact {
+	id
+	fileType
+	name
+	downloadPath
+	size
+	expireAt
+	expired
+}
+fragment pageFields on PageInfo {
+	hasNextPage
+	endCursor
+}
+`
+
+func getProjectJobsArtifacts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	projectPath string,
+	endCursor *string,
+) (data_ *getProjectJobsArtifactsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "getProjectJobsArtifacts",
+		Query:  getProjectJobsArtifacts_Operation,
+		Variables: &__getProjectJobsArtifactsInput{
+			ProjectPath: projectPath,
+			EndCursor:   endCursor,
+		},
+	}
+
+	data_ = &getProjectJobsArtifactsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by getProjectMergeRequest.
 const getProjectMergeRequest_Operation = `
 query getProjectMergeRequest ($projectPath: ID!, $mergeRequestIid: String!, $_core: Boolean = false, $_extra: Boolean = false, $_participants: Boolean = false, $_commits: Boolean = false) {
@@ -15513,6 +16304,7 @@ query getProjectPipelineJobArtifacts ($projectPath: ID!, $pipelineIid: ID!, $job
 			... PipelineReferenceFields
 			job(id: $jobId) {
 				... JobReferenceFields
+				name
 				finishedAt
 				artifacts(after: $endCursor) {
 					nodes {
@@ -15538,9 +16330,13 @@ fragment JobReferenceFields on CiJob {
 	id
 }
 fragment JobArtifactFieldsCore on CiJobArtifact {
+	id
 	fileType
 	name
 	downloadPath
+	size
+	expireAt
+	expired
 }
 fragment pageFields on PageInfo {
 	hasNextPage
@@ -15699,6 +16495,7 @@ query getProjectPipelineJobsArtifacts ($projectPath: ID!, $pipelineIid: ID!, $en
 			jobs(after: $endCursor) {
 				nodes {
 					... JobReferenceFields
+					name
 					finishedAt
 					artifacts {
 						nodes {
@@ -15728,9 +16525,13 @@ fragment JobReferenceFields on CiJob {
 	id
 }
 fragment JobArtifactFieldsCore on CiJobArtifact {
+	id
 	fileType
 	name
 	downloadPath
+	size
+	expireAt
+	expired
 }
 fragment pageFields on PageInfo {
 	hasNextPage
@@ -20,6 +20,7 @@ type TimeRangeOptions struct {
 const (
 	GlobalIdPrefix             = "gid://gitlab/"
 	GlobalIdCommitPrefix       = GlobalIdPrefix + "Commit/"
+	GlobalIdJobArtifactPrefix  = GlobalIdPrefix + "Ci::JobArtifact/"
 	GlobalIdMergeRequestPrefix = GlobalIdPrefix + "MergeRequest/"
 	GlobalIdMilestonePrefix    = GlobalIdPrefix + "Milestone/"
 	GlobalIdNotePrefix         = GlobalIdPrefix + "Note/"
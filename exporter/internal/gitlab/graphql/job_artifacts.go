@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
@@ -16,43 +17,43 @@ type JobArtifactFields struct {
 
 	JobArtifactFieldsCore
 
+	JobName *string
 	// to set report_created_at on testcases
 	JobFinishedAt *time.Time
 }
 
 func ConvertJobArtifact(jaf JobArtifactFields) (types.JobArtifact, error) {
 	var (
-		jobId, pipelineId, pipelineIid, projectId int64
-		err                                       error
+		id   int64
+		size int64
+		err  error
 	)
-	if jobId, err = parseJobId(valOrZero(jaf.Job.Id)); err != nil {
-		return types.JobArtifact{}, fmt.Errorf("parse job id: %w", err)
-	}
-	if pipelineId, err = ParseId(jaf.Pipeline.Id, GlobalIdPipelinePrefix); err != nil {
-		return types.JobArtifact{}, fmt.Errorf("parse pipeline id: %w", err)
+	if id, err = ParseId(jaf.Id, GlobalIdJobArtifactPrefix); err != nil {
+		return types.JobArtifact{}, fmt.Errorf("parse id: %w", err)
 	}
-	if pipelineIid, err = ParseId(jaf.Pipeline.Iid, ""); err != nil {
-		return types.JobArtifact{}, fmt.Errorf("parse pipeline iid: %w", err)
+	if size, err = jaf.Size.Int64(); err != nil {
+		return types.JobArtifact{}, fmt.Errorf("parse size: %w", err)
 	}
-	if projectId, err = ParseId(jaf.Project.Id, GlobalIdProjectPrefix); err != nil {
-		return types.JobArtifact{}, fmt.Errorf("parse project id: %w", err)
+
+	jobRef, err := ConvertJobReference(jaf.Job, jaf.Pipeline, jaf.Project)
+	if err != nil {
+		return types.JobArtifact{}, err
 	}
+	jobRef.Name = valOrZero(jaf.JobName)
 
 	return types.JobArtifact{
-		Job: types.JobReference{
-			Id:   jobId,
-			Name: valOrZero(jaf.Name),
-			Pipeline: types.PipelineReference{
-				Id:  pipelineId,
-				Iid: pipelineIid,
-				Project: types.ProjectReference{
-					Id:       projectId,
-					FullPath: jaf.Project.FullPath,
-				},
-			},
-		},
+		Id:            id,
+		Job:           jobRef,
 		JobFinishedAt: jaf.JobFinishedAt,
-		// TODO: add missing job artifact fields core... ?!
+
+		FileType:     strings.ToLower(string(valOrZero(jaf.FileType))),
+		Name:         valOrZero(jaf.Name),
+		DownloadPath: valOrZero(jaf.DownloadPath),
+		Size:         size,
+
+		ExpireAt: jaf.ExpireAt,
+		// GitLab removes expired artifacts unless they are locked
+		Locked: jaf.Expired,
 	}, nil
 }
 
@@ -113,6 +114,7 @@ func (c *Client) getProjectPipelineJobsArtifacts(ctx context.Context, projectPat
 
 					JobArtifactFieldsCore: artifact_.JobArtifactFieldsCore,
 
+					JobName:       job_.Name,
 					JobFinishedAt: job_.FinishedAt,
 				}
 
@@ -143,6 +145,85 @@ func (c *Client) getProjectPipelineJobsArtifacts(ctx context.Context, projectPat
 	return jobArtifacts, err
 }
 
+// ListProjectJobsArtifacts yields the artifacts of the jobs of a project that
+// have artifacts page by page, regardless of when their pipelines were updated.
+func (c *Client) ListProjectJobsArtifacts(ctx context.Context, projectPath string, yield func([]JobArtifactFields) bool) error {
+	var endCursor *string
+
+	for {
+		data, err := getProjectJobsArtifacts(
+			ctx,
+			c.client,
+			projectPath,
+			endCursor,
+		)
+		err = handleError(err, "getProjectJobsArtifacts",
+			slog.String("projectPath", projectPath),
+		)
+		if err != nil {
+			return err
+		}
+
+		project_ := data.Project
+		if project_ == nil {
+			return fmt.Errorf("project not found: %v", projectPath)
+		}
+
+		if project_.Jobs == nil {
+			break
+		}
+
+		var jobArtifacts []JobArtifactFields
+		for _, job_ := range project_.Jobs.Nodes {
+			if job_.Artifacts == nil || job_.Pipeline == nil {
+				continue
+			}
+
+			pipeline_ := *job_.Pipeline
+			pipelineRef := PipelineReferenceFields{
+				Id:  valOrZero(pipeline_.GetId()),
+				Iid: valOrZero(pipeline_.GetIid()),
+			}
+
+			for _, artifact_ := range job_.Artifacts.Nodes {
+				jobArtifact := JobArtifactFields{
+					Job:      job_.JobReferenceFields,
+					Pipeline: pipelineRef,
+					Project:  project_.ProjectReferenceFields,
+
+					JobArtifactFieldsCore: artifact_.JobArtifactFieldsCore,
+
+					JobName:       job_.Name,
+					JobFinishedAt: job_.FinishedAt,
+				}
+
+				jobArtifacts = append(jobArtifacts, jobArtifact)
+			}
+
+			if job_.Artifacts.PageInfo.HasNextPage && job_.Id != nil {
+				endCursor_ := job_.Artifacts.PageInfo.EndCursor
+				jobArtifacts_, err := c.getProjectPipelineJobArtifacts(ctx, projectPath, pipelineRef.Iid, *job_.Id, endCursor_)
+				if err != nil {
+					return err
+				}
+				jobArtifacts = append(jobArtifacts, jobArtifacts_...)
+			}
+		}
+
+		if !yield(jobArtifacts) {
+			break
+		}
+
+		if !project_.Jobs.PageInfo.HasNextPage {
+			break
+		}
+
+		endCursor = project_.Jobs.PageInfo.EndCursor
+	}
+
+	return nil
+}
+
 func (c *Client) getProjectPipelineJobArtifacts(ctx context.Context, projectPath string, pipelineIid string, jobId string, endCursor *string) ([]JobArtifactFields, error) {
 	var (
 		jobArtifacts []JobArtifactFields
@@ -197,6 +278,7 @@ func (c *Client) getProjectPipelineJobArtifacts(ctx context.Context, projectPath
 
 				JobArtifactFieldsCore: artifact_.JobArtifactFieldsCore,
 
+				JobName:       job_.Name,
 				JobFinishedAt: job_.FinishedAt,
 			}
 
@@ -0,0 +1,80 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestConvertJobArtifact(t *testing.T) {
+	expireAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	finishedAt := expireAt.Add(-24 * time.Hour)
+	fileType := JobArtifactFileTypeJunit
+
+	input := JobArtifactFields{
+		Job: JobReferenceFields{
+			Id: ptr("gid://gitlab/Ci::Build/42"),
+		},
+		Pipeline: PipelineReferenceFields{
+			Id:  "gid://gitlab/Ci::Pipeline/7",
+			Iid: "3",
+		},
+		Project: ProjectReferenceFields{
+			Id:       "gid://gitlab/Project/1",
+			FullPath: "group/project",
+		},
+		JobArtifactFieldsCore: JobArtifactFieldsCore{
+			Id:           "gid://gitlab/Ci::JobArtifact/99",
+			FileType:     &fileType,
+			Name:         ptr("junit.xml.gz"),
+			DownloadPath: ptr("/group/project/-/jobs/42/artifacts/download?file_type=junit"),
+			Size:         json.Number("4294967296"),
+			ExpireAt:     &expireAt,
+			Expired:      true,
+		},
+		JobName:       ptr("test"),
+		JobFinishedAt: &finishedAt,
+	}
+
+	expected := types.JobArtifact{
+		Id: 99,
+		Job: types.JobReference{
+			Id:   42,
+			Name: "test",
+			Pipeline: types.PipelineReference{
+				Id:  7,
+				Iid: 3,
+				Project: types.ProjectReference{
+					Id:       1,
+					FullPath: "group/project",
+				},
+			},
+		},
+		JobFinishedAt: &finishedAt,
+
+		FileType:     "junit",
+		Name:         "junit.xml.gz",
+		DownloadPath: "/group/project/-/jobs/42/artifacts/download?file_type=junit",
+		Size:         4294967296,
+
+		ExpireAt: &expireAt,
+		Locked:   true,
+	}
+
+	got, err := ConvertJobArtifact(input)
+	if err != nil {
+		t.Fatalf("ConvertJobArtifact() error = %v", err)
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("Mismatch (-want +got):\n%s", diff)
+	}
+
+	input.Size = json.Number("")
+	if _, err := ConvertJobArtifact(input); err == nil {
+		t.Error("Expected error for invalid size, got nil")
+	}
+}
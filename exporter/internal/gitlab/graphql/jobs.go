@@ -188,6 +188,41 @@ func (c *Client) GetProjectsPipelinesJobs(ctx context.Context, ids []string, opt
 	return jobs, nil
 }
 
+func (c *Client) GetProjectPipelineJobs(ctx context.Context, projectPath string, pipelineIid string) ([]JobFields, error) {
+	jfs, err := c.getProjectPipelineJobs(ctx, projectPath, pipelineIid, getPipelinesJobsOptions{
+		core: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jfsExtra, err := c.getProjectPipelineJobs(ctx, projectPath, pipelineIid, getPipelinesJobsOptions{
+		extra: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	extra := make(map[string]JobFieldsExtra, len(jfsExtra))
+	for _, j := range jfsExtra {
+		if j.Id == nil {
+			continue
+		}
+		extra[*j.Id] = j.JobFieldsExtra
+	}
+
+	jobs := make([]JobFields, 0, len(jfs))
+	for _, j := range jfs {
+		if j.Id == nil {
+			continue
+		}
+		j.JobFieldsExtra = extra[*j.Id]
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
 func (c *Client) getProjectsPipelinesJobs(ctx context.Context, ids []string, opts getPipelinesOptions) ([]JobFields, error) {
 	jobs_ := make(map[string]JobFields)
 
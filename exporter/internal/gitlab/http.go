@@ -14,6 +14,7 @@ import (
 	"sync"
 	"time"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/httpclient"
 	"golang.org/x/net/html"
 )
@@ -27,13 +28,16 @@ type HTTPClient struct {
 	url  string
 }
 
-func NewHTTPClient(baseUrl string, cfg AuthConfig) (*HTTPClient, error) {
+func NewHTTPClient(baseUrl string, cfg AuthConfig, limiter *ratelimit.Limiter) (*HTTPClient, error) {
 	switch cfg.AuthType {
 	case SessionAuth:
-		return newSessionAuthedHTTPClient(baseUrl, cfg.Basic)
+		return newSessionAuthedHTTPClient(baseUrl, cfg.Basic, limiter)
 	default:
+		client := httpclient.New()
+		client.HTTPClient.Transport = limiter.Transport(client.HTTPClient.Transport, ratelimit.ClientHTTP)
+
 		return &HTTPClient{
-			doer: httpclient.New(),
+			doer: client,
 			url:  baseUrl,
 		}, nil
 	}
@@ -117,8 +121,8 @@ func (c *HTTPClient) GetProjectJobArtifactsFile(ctx context.Context, projectPath
 	return bytes.NewReader(buf.Bytes()), err
 }
 
-func newSessionAuthedHTTPClient(baseUrl string, cfg BasicAuthConfig) (*HTTPClient, error) {
-	session := newSessionAuthedClient(baseUrl, cfg.Username, cfg.Password)
+func newSessionAuthedHTTPClient(baseUrl string, cfg BasicAuthConfig, limiter *ratelimit.Limiter) (*HTTPClient, error) {
+	session := newSessionAuthedClient(baseUrl, cfg.Username, cfg.Password, limiter)
 
 	// https://gitlab.com/gitlab-org/gitlab/-/issues/395038
 	if err := session.signIn(); err != nil {
@@ -142,12 +146,15 @@ type sessionAuthedClient struct {
 	signedInAt time.Time
 }
 
-func newSessionAuthedClient(baseURL string, username string, password string) *sessionAuthedClient {
+func newSessionAuthedClient(baseURL string, username string, password string, limiter *ratelimit.Limiter) *sessionAuthedClient {
 	if !strings.HasSuffix(baseURL, "/") {
 		baseURL += "/"
 	}
 
-	client := httpclient.New().StandardClient()
+	retryClient := httpclient.New()
+	retryClient.HTTPClient.Transport = limiter.Transport(retryClient.HTTPClient.Transport, ratelimit.ClientHTTP)
+
+	client := retryClient.StandardClient()
 	jar, _ := cookiejar.New(nil)
 	client.Jar = jar
 
@@ -0,0 +1,345 @@
+// Package ratelimit provides the rate limiter shared by the REST, GraphQL and
+// HTTP clients of the GitLab API.
+//
+// Besides a static request rate, the limiter adapts to the `RateLimit-*`
+// response headers: once the remaining requests of the current window run
+// low or GitLab answers with 429 Too Many Requests, all clients pause until
+// the window resets. GraphQL requests additionally draw from a budget of
+// query complexity points.
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+)
+
+const (
+	// reserveRatio is the share of the rate limit window that is kept in
+	// reserve, the clients pause when fewer requests remain.
+	reserveRatio = 0.05
+
+	minBackoff = 1 * time.Second
+	maxBackoff = 1 * time.Minute
+)
+
+// Client names the API client a request is made by.
+const (
+	ClientREST    = "rest"
+	ClientGraphQL = "graphql"
+	ClientHTTP    = "http"
+)
+
+type Config struct {
+	// Maximum number of requests per second, 0 disables the limit
+	Limit float64
+	// Maximum GraphQL query complexity points per minute, 0 disables the
+	// budget
+	Complexity float64
+}
+
+type Limiter struct {
+	requests   *rate.Limiter
+	complexity *rate.Limiter
+
+	mu         sync.Mutex
+	pauseUntil time.Time
+	throttles  int // consecutive throttled responses
+
+	state   state
+	clients map[string]*clientStats
+	counts  map[requestKey]uint64
+
+	metrics *metrics
+}
+
+// state holds the last observed rate limit state.
+type state struct {
+	limit     int64
+	remaining int64
+	reset     time.Time
+
+	complexityLimit int64
+	complexityTotal int64
+	waited          time.Duration
+}
+
+type clientStats struct {
+	throttled uint64
+}
+
+// requestKey identifies the requests counted by the metrics.
+type requestKey struct {
+	client   string
+	endpoint string
+	status   string
+}
+
+func New(cfg Config) *Limiter {
+	l := &Limiter{
+		requests:   rate.NewLimiter(rate.Inf, 0),
+		complexity: rate.NewLimiter(rate.Inf, 0),
+		clients:    make(map[string]*clientStats),
+		counts:     make(map[requestKey]uint64),
+	}
+
+	if cfg.Limit > 0 {
+		// allow a third of the requests of a second in bursts
+		limit := rate.Limit(cfg.Limit * 0.66)
+		burst := max(1, int(cfg.Limit*0.33))
+		l.requests = rate.NewLimiter(limit, burst)
+	}
+
+	if cfg.Complexity > 0 {
+		limit := rate.Limit(cfg.Complexity / 60)
+		burst := max(1, int(cfg.Complexity))
+		l.complexity = rate.NewLimiter(limit, burst)
+	}
+
+	l.metrics = newMetrics(l)
+	return l
+}
+
+// complexityEnabled reports whether GraphQL requests draw from a complexity
+// budget.
+func (l *Limiter) complexityEnabled() bool {
+	return l.complexity.Limit() != rate.Inf
+}
+
+// Wait blocks until a request may be made.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := l.waitPause(ctx); err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err := l.requests.Wait(ctx)
+	l.addWaited(time.Since(start))
+	return err
+}
+
+// WaitComplexity blocks until the complexity budget spent by previous GraphQL
+// queries has recovered.
+func (l *Limiter) WaitComplexity(ctx context.Context) error {
+	start := time.Now()
+	err := l.complexity.WaitN(ctx, 0)
+	l.addWaited(time.Since(start))
+	return err
+}
+
+// ObserveComplexity records the complexity of a GraphQL query, which is
+// deducted from the complexity budget.
+func (l *Limiter) ObserveComplexity(score int64, limit int64) {
+	// the budget cannot go into debt by more than its burst
+	n := min(int(score), l.complexity.Burst())
+	if l.complexityEnabled() && n > 0 {
+		l.complexity.ReserveN(time.Now(), n)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.state.complexityTotal += score
+	if limit > 0 {
+		l.state.complexityLimit = limit
+	}
+}
+
+// Observe records the rate limit state of a response and pauses all requests
+// if the rate limit is (about to be) exceeded.
+func (l *Limiter) Observe(client string, resp *http.Response) {
+	now := time.Now()
+
+	limit, hasLimit := parseInt(resp.Header.Get(headerRateLimit))
+	remaining, hasRemaining := parseInt(resp.Header.Get(headerRateRemaining))
+	var reset time.Time
+	if v, ok := parseInt(resp.Header.Get(headerRateReset)); ok && v > 0 {
+		reset = time.Unix(v, 0)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if hasLimit {
+		l.state.limit = limit
+	}
+	if hasRemaining {
+		l.state.remaining = remaining
+	}
+	if !reset.IsZero() {
+		l.state.reset = reset
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		stats, ok := l.clients[client]
+		if !ok {
+			stats = &clientStats{}
+			l.clients[client] = stats
+		}
+		stats.throttled++
+		l.throttles++
+
+		until := reset
+		if d, ok := parseRetryAfter(resp.Header.Get(headerRetryAfter)); ok {
+			until = now.Add(d)
+		}
+		if !until.After(now) {
+			// back off exponentially without any hint of the server
+			shift := min(l.throttles-1, 6)
+			until = now.Add(min(minBackoff<<shift, maxBackoff))
+		}
+		l.pause(until, "throttled", client)
+		return
+	}
+	l.throttles = 0
+
+	if hasLimit && hasRemaining && !reset.IsZero() && float64(remaining) <= float64(limit)*reserveRatio {
+		l.pause(reset, "rate limit exhausted", client)
+	}
+}
+
+// pause pauses all requests until the given time. Must be called with the
+// lock held.
+func (l *Limiter) pause(until time.Time, reason string, client string) {
+	if until.After(l.pauseUntil) {
+		slog.Warn("Pausing GitLab API requests", "reason", reason, "client", client, "until", until.Format(time.RFC3339))
+		l.pauseUntil = until
+	}
+}
+
+// waitPause blocks while requests are paused.
+func (l *Limiter) waitPause(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		d := time.Until(l.pauseUntil)
+		l.mu.Unlock()
+
+		if d <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+			l.addWaited(d)
+		}
+	}
+}
+
+// countRequest counts a request made by the given client.
+func (l *Limiter) countRequest(client string, endpoint string, status string) {
+	l.mu.Lock()
+	l.counts[requestKey{client, endpoint, status}]++
+	l.mu.Unlock()
+}
+
+func (l *Limiter) addWaited(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	l.mu.Lock()
+	l.state.waited += d
+	l.mu.Unlock()
+}
+
+// Transport returns a round tripper that waits for the limiter before each
+// request and observes each response.
+func (l *Limiter) Transport(next http.RoundTripper, client string) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &transport{
+		next:    next,
+		limiter: l,
+		client:  client,
+	}
+}
+
+type transport struct {
+	next    http.RoundTripper
+	limiter *Limiter
+	client  string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.limiter.countRequest(t.client, endpoint(req.URL), "error")
+		return nil, err
+	}
+
+	t.limiter.countRequest(t.client, endpoint(req.URL), strconv.Itoa(resp.StatusCode))
+	t.limiter.Observe(t.client, resp)
+	return resp, nil
+}
+
+// endpoint returns the path of the given URL with ids and project paths
+// replaced by placeholders, to keep the number of distinct endpoints low.
+//
+//	/api/v4/projects/group%2Fproject/jobs/42/trace -> /api/v4/projects/:id/jobs/:id/trace
+//	/group/project/-/jobs/42/raw                   -> /:project/-/jobs/:id/raw
+func endpoint(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.EscapedPath(), "/"), "/")
+
+	// paths of the web UI start with the full path of the project
+	for i, s := range segments {
+		if s == "-" && i > 0 {
+			segments = append([]string{":project"}, segments[i:]...)
+			break
+		}
+	}
+
+	for i, s := range segments {
+		if _, err := strconv.ParseUint(s, 10, 64); err == nil || strings.Contains(strings.ToUpper(s), "%2F") {
+			segments[i] = ":id"
+		}
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+func parseInt(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// parseRetryAfter parses the Retry-After header, given either in seconds or
+// as HTTP date.
+func parseRetryAfter(s string) (time.Duration, bool) {
+	if s == "" {
+		return 0, false
+	}
+	if v, ok := parseInt(s); ok && v >= 0 {
+		return time.Duration(v) * time.Second, true
+	}
+	if t, err := http.ParseTime(s); err == nil {
+		return time.Until(t), true
+	}
+	return 0, false
+}
@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func response(code int, headers map[string]string) *http.Response {
+	resp := &http.Response{StatusCode: code, Header: make(http.Header)}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestLimiter_Observe(t *testing.T) {
+	l := New(Config{})
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	l.Observe(ClientREST, response(http.StatusOK, map[string]string{
+		headerRateLimit:     "2000",
+		headerRateRemaining: "1500",
+		headerRateReset:     strconv.FormatInt(reset.Unix(), 10),
+	}))
+
+	if !l.pauseUntil.IsZero() {
+		t.Errorf("want no pause, got pause until %v", l.pauseUntil)
+	}
+	if l.state.limit != 2000 || l.state.remaining != 1500 || !l.state.reset.Equal(reset) {
+		t.Errorf("unexpected state: %+v", l.state)
+	}
+
+	// pause when the remaining requests run low
+	l.Observe(ClientREST, response(http.StatusOK, map[string]string{
+		headerRateLimit:     "2000",
+		headerRateRemaining: "10",
+		headerRateReset:     strconv.FormatInt(reset.Unix(), 10),
+	}))
+	if !l.pauseUntil.Equal(reset) {
+		t.Errorf("want pause until %v, got %v", reset, l.pauseUntil)
+	}
+}
+
+func TestLimiter_ObserveThrottled(t *testing.T) {
+	l := New(Config{})
+
+	before := time.Now()
+	l.Observe(ClientGraphQL, response(http.StatusTooManyRequests, map[string]string{
+		headerRetryAfter: "30",
+	}))
+	if d := l.pauseUntil.Sub(before); d < 30*time.Second || d > 31*time.Second {
+		t.Errorf("want pause of 30s, got %v", d)
+	}
+
+	// back off exponentially without any hints
+	l = New(Config{})
+	for range 3 {
+		l.Observe(ClientHTTP, response(http.StatusTooManyRequests, nil))
+	}
+	if d := time.Until(l.pauseUntil); d < 3*time.Second || d > 4*time.Second {
+		t.Errorf("want pause of 4s, got %v", d)
+	}
+	if n := l.clients[ClientHTTP].throttled; n != 3 {
+		t.Errorf("want 3 throttled requests, got %d", n)
+	}
+}
+
+func TestLimiter_WaitPaused(t *testing.T) {
+	l := New(Config{})
+	l.pauseUntil = time.Now().Add(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err == nil {
+		t.Error("want error while paused")
+	}
+
+	l.pauseUntil = time.Now().Add(10 * time.Millisecond)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if time.Now().Before(l.pauseUntil) {
+		t.Error("want wait until the pause ends")
+	}
+}
+
+func TestLimiter_Complexity(t *testing.T) {
+	l := New(Config{Complexity: 60})
+
+	// the first query spends the full budget, the second one goes into debt
+	l.ObserveComplexity(100, 250)
+	l.ObserveComplexity(100, 250)
+	if l.state.complexityTotal != 200 || l.state.complexityLimit != 250 {
+		t.Errorf("unexpected state: %+v", l.state)
+	}
+
+	// the debt is paid off at one point per second
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.WaitComplexity(ctx); err == nil {
+		t.Error("want error while the complexity budget is spent")
+	}
+
+	// without a budget there is nothing to wait for
+	l = New(Config{})
+	l.ObserveComplexity(100, 250)
+	if err := l.WaitComplexity(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLimiter_Transport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimit, "100")
+		w.Header().Set(headerRateRemaining, "99")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	l := New(Config{})
+	client := &http.Client{Transport: l.Transport(nil, ClientHTTP)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(l.MetricsCollector())
+
+	if n := value(t, reg, "gitlab_exporter_gitlab_requests_total"); n != 1 {
+		t.Errorf("want 1 request, got %v", n)
+	}
+	if n := value(t, reg, "gitlab_exporter_gitlab_rate_limit_remaining"); n != 99 {
+		t.Errorf("want 99 remaining requests, got %v", n)
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"https://gitlab.example.com/api/v4/projects/42/jobs/1337/trace":        "/api/v4/projects/:id/jobs/:id/trace",
+		"https://gitlab.example.com/api/v4/projects/group%2Fproject/pipelines": "/api/v4/projects/:id/pipelines",
+		"https://gitlab.example.com/api/graphql?query=foo":                     "/api/graphql",
+		"https://gitlab.example.com/group/sub/project/-/jobs/42/raw":           "/:project/-/jobs/:id/raw",
+		"https://gitlab.example.com/users/sign_in":                             "/users/sign_in",
+	}
+
+	for raw, want := range tests {
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := endpoint(u); got != want {
+			t.Errorf("endpoint(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+// value returns the value of the first gathered metric with the given name.
+func value(t *testing.T, reg *prometheus.Registry, name string) float64 {
+	t.Helper()
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() != name {
+			continue
+		}
+		m := mf.GetMetric()[0]
+		if m.GetCounter() != nil {
+			return m.GetCounter().GetValue()
+		}
+		return m.GetGauge().GetValue()
+	}
+	t.Fatalf("metric %s not found", name)
+	return 0
+}
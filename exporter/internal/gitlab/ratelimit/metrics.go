@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	limiter *Limiter
+
+	requestsDesc        *prometheus.Desc
+	throttledDesc       *prometheus.Desc
+	limitDesc           *prometheus.Desc
+	remainingDesc       *prometheus.Desc
+	resetDesc           *prometheus.Desc
+	pausedDesc          *prometheus.Desc
+	waitedDesc          *prometheus.Desc
+	complexityDesc      *prometheus.Desc
+	complexityLimitDesc *prometheus.Desc
+}
+
+func newMetrics(l *Limiter) *metrics {
+	return &metrics{
+		limiter: l,
+
+		requestsDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_requests_total",
+			"Total number of requests made to the GitLab API by endpoint and response status.",
+			[]string{"client", "endpoint", "status"}, nil,
+		),
+		throttledDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_throttled_requests_total",
+			"Total number of requests rejected by the GitLab API with 429 Too Many Requests.",
+			[]string{"client"}, nil,
+		),
+		limitDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_rate_limit",
+			"Number of requests allowed in the current rate limit window, as reported by GitLab.",
+			nil, nil,
+		),
+		remainingDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_rate_limit_remaining",
+			"Number of requests remaining in the current rate limit window, as reported by GitLab.",
+			nil, nil,
+		),
+		resetDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_rate_limit_reset_timestamp_seconds",
+			"Time the current rate limit window resets, as reported by GitLab.",
+			nil, nil,
+		),
+		pausedDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_rate_limit_paused_seconds",
+			"Remaining time all GitLab API requests are paused for.",
+			nil, nil,
+		),
+		waitedDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_rate_limit_wait_seconds_total",
+			"Total time requests waited for the rate limiter.",
+			nil, nil,
+		),
+		complexityDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_graphql_complexity_total",
+			"Total complexity of the GraphQL queries, as reported by GitLab.",
+			nil, nil,
+		),
+		complexityLimitDesc: prometheus.NewDesc(
+			"gitlab_exporter_gitlab_graphql_complexity_limit",
+			"Maximum complexity of a single GraphQL query, as reported by GitLab.",
+			nil, nil,
+		),
+	}
+}
+
+// MetricsCollector returns a collector for the rate limiter metrics.
+func (l *Limiter) MetricsCollector() prometheus.Collector {
+	return l.metrics
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.requestsDesc
+	ch <- m.throttledDesc
+	ch <- m.limitDesc
+	ch <- m.remainingDesc
+	ch <- m.resetDesc
+	ch <- m.pausedDesc
+	ch <- m.waitedDesc
+	ch <- m.complexityDesc
+	ch <- m.complexityLimitDesc
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.limiter.mu.Lock()
+	var (
+		s      = m.limiter.state
+		paused = max(0, time.Until(m.limiter.pauseUntil))
+	)
+	clients := make(map[string]clientStats, len(m.limiter.clients))
+	for name, stats := range m.limiter.clients {
+		clients[name] = *stats
+	}
+	requests := make(map[requestKey]uint64, len(m.limiter.counts))
+	for key, n := range m.limiter.counts {
+		requests[key] = n
+	}
+	m.limiter.mu.Unlock()
+
+	for key, n := range requests {
+		ch <- prometheus.MustNewConstMetric(m.requestsDesc, prometheus.CounterValue, float64(n), key.client, key.endpoint, key.status)
+	}
+	for name, stats := range clients {
+		ch <- prometheus.MustNewConstMetric(m.throttledDesc, prometheus.CounterValue, float64(stats.throttled), name)
+	}
+
+	ch <- prometheus.MustNewConstMetric(m.limitDesc, prometheus.GaugeValue, float64(s.limit))
+	ch <- prometheus.MustNewConstMetric(m.remainingDesc, prometheus.GaugeValue, float64(s.remaining))
+	var reset float64
+	if !s.reset.IsZero() {
+		reset = float64(s.reset.Unix())
+	}
+	ch <- prometheus.MustNewConstMetric(m.resetDesc, prometheus.GaugeValue, reset)
+	ch <- prometheus.MustNewConstMetric(m.pausedDesc, prometheus.GaugeValue, paused.Seconds())
+	ch <- prometheus.MustNewConstMetric(m.waitedDesc, prometheus.CounterValue, s.waited.Seconds())
+	ch <- prometheus.MustNewConstMetric(m.complexityDesc, prometheus.CounterValue, float64(s.complexityTotal))
+	ch <- prometheus.MustNewConstMetric(m.complexityLimitDesc, prometheus.GaugeValue, float64(s.complexityLimit))
+}
@@ -2,19 +2,27 @@ package rest
 
 import (
 	"strings"
+	"sync/atomic"
 
+	"github.com/hashicorp/go-cleanhttp"
 	"golang.org/x/time/rate"
 
 	"gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
 )
 
 const apiPath string = "api/v4/"
 
 type Client struct {
 	client *gitlab.Client
+	token  string
+
+	jobLogBytes      atomic.Uint64
+	jobLogsTruncated atomic.Uint64
 }
 
-func NewClient(url string, token string, rateLimit float64) (*Client, error) {
+func NewClient(url string, token string, limiter *ratelimit.Limiter) (*Client, error) {
 	if !strings.HasSuffix(url, "/") {
 		url += "/"
 	}
@@ -22,16 +30,14 @@ func NewClient(url string, token string, rateLimit float64) (*Client, error) {
 		url += apiPath
 	}
 
+	httpClient := cleanhttp.DefaultPooledClient()
+	httpClient.Transport = limiter.Transport(httpClient.Transport, ratelimit.ClientREST)
+
 	opts := []gitlab.ClientOptionFunc{
 		gitlab.WithBaseURL(url),
-	}
-
-	if rateLimit > 0 {
-		limit := rate.Limit(rateLimit * 0.66)
-		burst := rateLimit * 0.33
-		limiter := rate.NewLimiter(limit, int(burst))
-
-		opts = append(opts, gitlab.WithCustomLimiter(limiter))
+		gitlab.WithHTTPClient(httpClient),
+		// requests, including retries, are limited by the transport
+		gitlab.WithCustomLimiter(rate.NewLimiter(rate.Inf, 0)),
 	}
 
 	client, err := gitlab.NewOAuthClient(token, opts...)
@@ -41,9 +47,21 @@ func NewClient(url string, token string, rateLimit float64) (*Client, error) {
 
 	return &Client{
 		client: client,
+		token:  token,
 	}, nil
 }
 
+// JobLogBytes returns the total number of job log bytes downloaded.
+func (c *Client) JobLogBytes() uint64 {
+	return c.jobLogBytes.Load()
+}
+
+// JobLogsTruncated returns the total number of job logs that were not parsed
+// completely because they exceed the maximum size.
+func (c *Client) JobLogsTruncated() uint64 {
+	return c.jobLogsTruncated.Load()
+}
+
 func (c *Client) Client() *gitlab.Client {
 	return c.client
 }
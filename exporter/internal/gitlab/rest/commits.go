@@ -0,0 +1,157 @@
+package rest
+
+import (
+	"context"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/git/trailerparser"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func ConvertCommit(projectId int64, ref string, commit *gitlab.Commit) types.Commit {
+	c := types.Commit{
+		Sha:       commit.ID,
+		ShortSha:  commit.ShortID,
+		ParentIds: commit.ParentIDs,
+		Project: types.ProjectReference{
+			Id: projectId,
+		},
+
+		Ref: ref,
+
+		AuthoredDate: commit.AuthoredDate,
+		AuthorName:   commit.AuthorName,
+		AuthorEmail:  commit.AuthorEmail,
+
+		CommittedDate:  commit.CommittedDate,
+		CommitterName:  commit.CommitterName,
+		CommitterEmail: commit.CommitterEmail,
+
+		CreatedAt: commit.CreatedAt,
+
+		Title:   commit.Title,
+		Message: commit.Message,
+		// Trailers: nil,
+
+		// Stats: nil,
+
+		WebUrl: commit.WebURL,
+	}
+
+	// Trailers
+	for _, line := range trailerparser.ExtractPotentialTrailerLines([]byte(commit.Message)) {
+		for _, trailer := range trailerparser.Parse(line) {
+			c.Trailers = append(c.Trailers, types.CommitTrailer{
+				Key:   string(trailer.Key),
+				Value: string(trailer.Value),
+			})
+		}
+	}
+
+	// Stats
+	if commit.Stats != nil {
+		c.Stats = &types.CommitStats{
+			Additions: int64(commit.Stats.Additions),
+			Deletions: int64(commit.Stats.Deletions),
+			Total:     int64(commit.Stats.Total),
+		}
+	}
+
+	// Status
+	if commit.Status != nil {
+		c.Status = string(*commit.Status)
+	}
+
+	return c
+}
+
+type GetProjectCommitsOptions struct {
+	// If empty, the commits of the default branch are returned. A range like
+	// `<sha>..<branch>` returns the commits of the branch that are not
+	// reachable from the given commit.
+	RefName string
+
+	// Commits are filtered by their committed date
+	Since *time.Time
+	Until *time.Time
+}
+
+func (c *Client) GetProjectCommits(ctx context.Context, projectId int64, opt GetProjectCommitsOptions) ([]*gitlab.Commit, error) {
+	var commits []*gitlab.Commit
+
+	opts := &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+
+		Since:     opt.Since,
+		Until:     opt.Until,
+		WithStats: gitlab.Ptr(true),
+	}
+	if opt.RefName != "" {
+		opts.RefName = gitlab.Ptr(opt.RefName)
+	}
+
+	for {
+		cs, resp, err := c.client.Commits.ListCommits(int(projectId), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		commits = append(commits, cs...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return commits, nil
+}
+
+// GetProjectBranchHead returns the id of the commit a branch of a project
+// points to.
+func (c *Client) GetProjectBranchHead(ctx context.Context, projectId int64, branch string) (string, error) {
+	b, _, err := c.client.Branches.GetBranch(int(projectId), branch, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	if b.Commit == nil {
+		return "", nil
+	}
+	return b.Commit.ID, nil
+}
+
+// GetProjectProtectedBranches returns the names of the existing branches of a
+// project that are protected.
+func (c *Client) GetProjectProtectedBranches(ctx context.Context, projectId int64) ([]string, error) {
+	var branches []string
+
+	opts := &gitlab.ListBranchesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		bs, resp, err := c.client.Branches.ListBranches(int(projectId), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range bs {
+			if b.Protected {
+				branches = append(branches, b.Name)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return branches, nil
+}
@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestConvertCommit(t *testing.T) {
+	status := gitlab.Success
+	commit := &gitlab.Commit{
+		ID:        "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+		ShortID:   "61049424",
+		ParentIDs: []string{"ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"},
+		Title:     "Fix flaky deployment",
+		Message:   "Fix flaky deployment\n\nRetry the rollout.\n\nChangelog: fixed\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: John Doe <john@example.com>\n",
+		Stats: &gitlab.CommitStats{
+			Additions: 3,
+			Deletions: 1,
+			Total:     4,
+		},
+		Status: &status,
+	}
+
+	got := ConvertCommit(123, "main", commit)
+
+	want := types.Commit{
+		Sha:       "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+		ShortSha:  "61049424",
+		ParentIds: []string{"ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"},
+		Project:   types.ProjectReference{Id: 123},
+		Ref:       "main",
+		Title:     "Fix flaky deployment",
+		Message:   commit.Message,
+		Trailers: []types.CommitTrailer{
+			{Key: "Changelog", Value: "fixed"},
+			{Key: "Co-authored-by", Value: "Jane Doe <jane@example.com>"},
+			{Key: "Co-authored-by", Value: "John Doe <john@example.com>"},
+		},
+		Stats: &types.CommitStats{
+			Additions: 3,
+			Deletions: 1,
+			Total:     4,
+		},
+		Status: "success",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -14,17 +14,6 @@ import (
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
 )
 
-type GitLabEnvironment struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
-	Tier string `json:"tier"`
-
-	Project struct {
-		ID                int    `json:"id"`
-		NameWithNamespace string `json:"name_with_namespace"`
-	} `json:"project"`
-}
-
 type GitLabDeployment struct {
 	ID        int        `json:"id"`
 	IID       int        `json:"iid"`
@@ -68,7 +57,7 @@ func (d *GitLabDeployment) getEnvironment() *GitLabEnvironment {
 		Tier: "",
 	}
 	env.Project.ID = d.Deployable.Pipeline.ProjectID
-	env.Project.NameWithNamespace = d.deployableProjectFullPath()
+	env.Project.PathWithNamespace = d.deployableProjectFullPath()
 
 	return env
 }
@@ -92,7 +81,10 @@ func ConvertDeployment(deployment *GitLabDeployment) types.Deployment {
 
 	project := types.ProjectReference{
 		Id:       int64(env.Project.ID),
-		FullPath: env.Project.NameWithNamespace,
+		FullPath: env.Project.PathWithNamespace,
+	}
+	if project.FullPath == "" {
+		project.FullPath = deployment.deployableProjectFullPath()
 	}
 
 	return types.Deployment{
@@ -197,61 +189,6 @@ func (c *Client) GetProjectDeployments(ctx context.Context, projectId int64, opt
 	return deployments, nil
 }
 
-func getProjectEnvironments(c *Client, ctx context.Context, projectId int64) ([]*GitLabEnvironment, error) {
-	var environments []*GitLabEnvironment
-
-	opts := &gitlab.ListEnvironmentsOptions{
-		ListOptions: gitlab.ListOptions{
-			Pagination: "keyset",
-			PerPage:    100,
-		},
-	}
-
-	options := []gitlab.RequestOptionFunc{
-		gitlab.WithContext(ctx),
-	}
-
-	for {
-		envs, resp, err := listEnvironments(c.client, int(projectId), opts, options...)
-		if err != nil {
-			return nil, err
-		}
-
-		environments = append(environments, envs...)
-
-		if resp.NextLink == "" {
-			break
-		}
-
-		options = []gitlab.RequestOptionFunc{
-			gitlab.WithContext(ctx),
-			gitlab.WithKeysetPaginationParameters(resp.NextLink),
-		}
-	}
-	return environments, nil
-}
-
-func listEnvironments(client *gitlab.Client, pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*GitLabEnvironment, *gitlab.Response, error) {
-	project, err := parseID(pid)
-	if err != nil {
-		return nil, nil, err
-	}
-	u := fmt.Sprintf("projects/%s/environments", gitlab.PathEscape(project))
-
-	req, err := client.NewRequest(http.MethodGet, u, opts, options)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	var envs []*GitLabEnvironment
-	resp, err := client.Do(req, &envs)
-	if err != nil {
-		return nil, resp, err
-	}
-
-	return envs, resp, nil
-}
-
 func listProjectDeployments(client *gitlab.Client, pid interface{}, opts *gitlab.ListProjectDeploymentsOptions, options ...gitlab.RequestOptionFunc) ([]*GitLabDeployment, *gitlab.Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
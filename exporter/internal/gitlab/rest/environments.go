@@ -0,0 +1,165 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+type GitLabEnvironment struct {
+	ID          int        `json:"id"`
+	Name        string     `json:"name"`
+	Slug        string     `json:"slug"`
+	Description string     `json:"description"`
+	ExternalURL string     `json:"external_url"`
+	State       string     `json:"state"`
+	Tier        string     `json:"tier"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	AutoStopAt  *time.Time `json:"auto_stop_at"`
+
+	Project struct {
+		ID                int    `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+
+	LastDeployment *GitLabDeployment `json:"last_deployment"`
+}
+
+func ConvertEnvironment(env *GitLabEnvironment) types.Environment {
+	environment := types.Environment{
+		Id: int64(env.ID),
+		Project: types.ProjectReference{
+			Id:       int64(env.Project.ID),
+			FullPath: env.Project.PathWithNamespace,
+		},
+
+		CreatedAt:  env.CreatedAt,
+		UpdatedAt:  env.UpdatedAt,
+		AutoStopAt: env.AutoStopAt,
+
+		Name:        env.Name,
+		Slug:        env.Slug,
+		Description: env.Description,
+		ExternalUrl: env.ExternalURL,
+
+		State: env.State,
+		Tier:  env.Tier,
+	}
+
+	if env.LastDeployment != nil {
+		env.LastDeployment.environment = env
+		deployment := ConvertDeployment(env.LastDeployment)
+		environment.LastDeployment = &deployment
+	}
+
+	return environment
+}
+
+// GetProjectEnvironments returns the environments of a project. The last
+// deployment is only fetched for environments that are not stopped, as it
+// requires a request per environment.
+func (c *Client) GetProjectEnvironments(ctx context.Context, projectId int64) ([]*GitLabEnvironment, error) {
+	environments, err := getProjectEnvironments(c, ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, env := range environments {
+		if env.Project.ID == 0 {
+			env.Project.ID = int(projectId)
+		}
+		if env.LastDeployment != nil || env.State == "stopped" {
+			continue
+		}
+
+		e, _, err := getEnvironment(c.client, int(projectId), env.ID, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("get environment %d: %w", env.ID, err)
+		}
+		env.LastDeployment = e.LastDeployment
+	}
+
+	return environments, nil
+}
+
+func getProjectEnvironments(c *Client, ctx context.Context, projectId int64) ([]*GitLabEnvironment, error) {
+	var environments []*GitLabEnvironment
+
+	opts := &gitlab.ListEnvironmentsOptions{
+		ListOptions: gitlab.ListOptions{
+			Pagination: "keyset",
+			PerPage:    100,
+		},
+	}
+
+	options := []gitlab.RequestOptionFunc{
+		gitlab.WithContext(ctx),
+	}
+
+	for {
+		envs, resp, err := listEnvironments(c.client, int(projectId), opts, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		environments = append(environments, envs...)
+
+		if resp.NextLink == "" {
+			break
+		}
+
+		options = []gitlab.RequestOptionFunc{
+			gitlab.WithContext(ctx),
+			gitlab.WithKeysetPaginationParameters(resp.NextLink),
+		}
+	}
+	return environments, nil
+}
+
+func listEnvironments(client *gitlab.Client, pid interface{}, opts *gitlab.ListEnvironmentsOptions, options ...gitlab.RequestOptionFunc) ([]*GitLabEnvironment, *gitlab.Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments", gitlab.PathEscape(project))
+
+	req, err := client.NewRequest(http.MethodGet, u, opts, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var envs []*GitLabEnvironment
+	resp, err := client.Do(req, &envs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return envs, resp, nil
+}
+
+func getEnvironment(client *gitlab.Client, pid interface{}, environmentId int, options ...gitlab.RequestOptionFunc) (*GitLabEnvironment, *gitlab.Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/environments/%d", gitlab.PathEscape(project), environmentId)
+
+	req, err := client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var env GitLabEnvironment
+	resp, err := client.Do(req, &env)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &env, resp, nil
+}
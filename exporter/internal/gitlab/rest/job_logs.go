@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -18,6 +19,9 @@ type JobLogData struct {
 	Sections   []SectionData  `json:"sections"`
 	Metrics    []MetricData   `json:"metrics"`
 	Properties []PropertyData `json:"properties"`
+
+	// Whether parsing stopped at the maximum log size
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 type MetricData struct {
@@ -32,118 +36,226 @@ type PropertyData struct {
 	Value string `json:"value"`
 }
 
-func (c *Client) GetJobLogData(ctx context.Context, projectId int64, jobId int64) (JobLogData, error) {
-	log, err := c.GetJobLog(ctx, projectId, jobId)
+type JobLogOptions struct {
+	// Maximum number of bytes of the log that are parsed, the rest of the log
+	// is skipped. 0 means unlimited.
+	MaxSize int64
+	// Number of bytes at the end of the log to fetch with a range request,
+	// instead of the whole log. 0 means the whole log.
+	TailSize int64
+	// Called for each line of the log, e.g. to evaluate log queries.
+	LineFunc func(line []byte)
+}
+
+// GetJobLogData fetches the log of a job and parses it while it is being
+// downloaded, so that the log is never held in memory as a whole. Jobs
+// without a log yield no data.
+func (c *Client) GetJobLogData(ctx context.Context, projectId int64, jobId int64, opts JobLogOptions) (JobLogData, error) {
+	parser := NewJobLogParser(opts.MaxSize)
+	parser.LineFunc = opts.LineFunc
+
+	req, err := c.client.NewRequest(http.MethodGet, fmt.Sprintf("projects/%d/jobs/%d/trace", projectId, jobId), nil, []_gitlab.RequestOptionFunc{_gitlab.WithContext(ctx)})
 	if err != nil {
-		return JobLogData{}, err
+		return JobLogData{}, fmt.Errorf("create trace file request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if opts.TailSize > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=-%d", opts.TailSize))
 	}
 
-	data, err := ParseJobLog(log)
+	// The body is read by the parser directly, the client's Do method would
+	// buffer it and does not accept partial content.
+	resp, err := c.client.HTTPClient().Do(req.Request)
 	if err != nil {
-		return JobLogData{}, fmt.Errorf("parse job log: %w", err)
+		return JobLogData{}, fmt.Errorf("get trace file: %w", err)
 	}
+	defer resp.Body.Close()
 
-	return data, nil
-}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusPartialContent:
+		// the range most likely starts within a line
+		parser.skipLine = true
+	case http.StatusNotFound, http.StatusRequestedRangeNotSatisfiable:
+		// Some jobs may not have a log, like the special `pages:deploy`
+		// that is inserted into the pipeline but runs in the background
+		// without a runner.
+		// We don't treat this as an error.
+		return JobLogData{}, nil
+	default:
+		return JobLogData{}, fmt.Errorf("get trace file: %w", _gitlab.CheckResponse(resp))
+	}
 
-func (c *Client) GetJobLog(ctx context.Context, projectId int64, jobId int64) (*bytes.Reader, error) {
-	log, resp, err := c.client.Jobs.GetTraceFile(int(projectId), int(jobId), _gitlab.WithContext(ctx))
-	if err != nil {
-		if resp != nil && resp.StatusCode == http.StatusNotFound {
-			// Some jobs may not have a log, like the special `pages:deploy`
-			// that is inserted into the pipeline but runs in the background
-			// without a runner.
-			// We don't treat this as an error.
-			resp.Body.Close()
-			return nil, nil
-		}
-		return nil, fmt.Errorf("get trace file: %w", err)
+	n, err := io.Copy(parser, resp.Body)
+	c.jobLogBytes.Add(uint64(n))
+	if errors.Is(err, ErrJobLogTruncated) {
+		c.jobLogsTruncated.Add(1)
+	} else if err != nil {
+		return JobLogData{}, fmt.Errorf("read trace file: %w", err)
 	}
-	defer resp.Body.Close()
 
-	return log, nil
+	return parser.Finish(), nil
 }
 
-func ParseJobLog(trace *bytes.Reader) (JobLogData, error) {
+// ParseJobLog parses a whole job log.
+func ParseJobLog(trace io.Reader) (JobLogData, error) {
 	if trace == nil {
 		return JobLogData{}, nil
 	}
 
-	var (
-		data           JobLogData
-		sections       sectionStack
-		parser         expfmt.TextParser
-		propertyParser jobLogPropertyParser
-	)
+	parser := NewJobLogParser(0)
+	if _, err := io.Copy(parser, trace); err != nil {
+		return JobLogData{}, err
+	}
+
+	return parser.Finish(), nil
+}
+
+// ErrJobLogTruncated is returned when writing more than the maximum size to
+// a JobLogParser.
+var ErrJobLogTruncated = errors.New("job log exceeds maximum size")
+
+// JobLogParser extracts sections, metrics and properties from a job log that
+// is written to it, one line at a time. Lines can be of any length.
+type JobLogParser struct {
+	// Called for each line of the log.
+	LineFunc func(line []byte)
+
+	maxSize  int64
+	size     int64
+	line     []byte // incomplete line
+	skipLine bool   // skip the first line
+
+	data           JobLogData
+	sections       sectionStack
+	parser         expfmt.TextParser
+	propertyParser jobLogPropertyParser
+}
+
+// NewJobLogParser returns a parser that parses at most maxSize bytes of a
+// log, or any number if maxSize is not positive.
+func NewJobLogParser(maxSize int64) *JobLogParser {
+	return &JobLogParser{
+		maxSize: maxSize,
+	}
+}
+
+// Write parses the complete lines in b and keeps the rest until the line is
+// completed by subsequent writes. Once the maximum size is reached, the data
+// is marked as truncated and ErrJobLogTruncated is returned.
+func (p *JobLogParser) Write(b []byte) (int, error) {
+	var err error
+	if p.maxSize > 0 && p.size+int64(len(b)) > p.maxSize {
+		b = b[:p.maxSize-p.size]
+		p.data.Truncated = true
+		err = ErrJobLogTruncated
+	}
+	p.size += int64(len(b))
+
+	n := len(b)
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n')
+		if i < 0 {
+			p.line = append(p.line, b...)
+			break
+		}
+
+		line := b[:i]
+		if len(p.line) > 0 {
+			p.line = append(p.line, line...)
+			line = p.line
+		}
+		p.parseLine(line)
+
+		p.line = p.line[:0]
+		b = b[i+1:]
+	}
+
+	return n, err
+}
+
+// Finish parses the last line of the log, unless it was cut off at the
+// maximum size, and returns the parsed data.
+func (p *JobLogParser) Finish() JobLogData {
+	if len(p.line) > 0 && !p.data.Truncated {
+		p.parseLine(p.line)
+	}
+	p.line = nil
 
+	// add all unfinished sections (e.g. due to job interruption) open-ended
+	// to give caller a chance to set end timestamp
+	for p.sections.Size() > 0 {
+		p.data.Sections = append(p.data.Sections, p.sections.Pop())
+	}
+
+	return p.data
+}
+
+func (p *JobLogParser) parseLine(line []byte) {
 	const (
 		METRIC_MARKER   = `METRIC_`
 		PROPERTY_MARKER = `PROPERTY_`
 		SECTION_MARKER  = `section_`
 	)
 
-	scanner := bufio.NewScanner(trace)
-outer:
-	for scanner.Scan() {
-		line := scanner.Bytes()
-
-		if j := bytes.Index(line, []byte(METRIC_MARKER)); j >= 0 {
-			offset := j + len(METRIC_MARKER)
-			metric, err := parser.LineToMetric(line[offset:])
-			if err != nil || metric == nil {
-				// we ignore parsing errors here
-				// TODO: should we handle them somehow?
-				continue outer
-			}
+	if p.skipLine {
+		p.skipLine = false
+		return
+	}
 
-			data.Metrics = append(data.Metrics, MetricData{
-				Name:      metric.Name,
-				Labels:    convertMetricLabels(metric.Labels),
-				Value:     metric.Value,
-				Timestamp: metric.TimestampMs,
-			})
+	line = bytes.TrimSuffix(line, []byte{'\r'})
+	if p.LineFunc != nil {
+		p.LineFunc(line)
+	}
+
+	if j := bytes.Index(line, []byte(METRIC_MARKER)); j >= 0 {
+		offset := j + len(METRIC_MARKER)
+		metric, err := p.parser.LineToMetric(line[offset:])
+		if err != nil || metric == nil {
+			// we ignore parsing errors here
+			// TODO: should we handle them somehow?
+			return
 		}
 
-		if j := bytes.Index(line, []byte(PROPERTY_MARKER)); j >= 0 {
-			offset := j + len(PROPERTY_MARKER)
-			property, err := propertyParser.LineToProperty(line[offset:])
-			if err != nil || property == nil {
-				// we ignore parsing errors here
-				// TODO: should we handle them somehow?
-				continue
-			}
+		p.data.Metrics = append(p.data.Metrics, MetricData{
+			Name:      metric.Name,
+			Labels:    convertMetricLabels(metric.Labels),
+			Value:     metric.Value,
+			Timestamp: metric.TimestampMs,
+		})
+	}
 
-			data.Properties = append(data.Properties, *property)
+	if j := bytes.Index(line, []byte(PROPERTY_MARKER)); j >= 0 {
+		offset := j + len(PROPERTY_MARKER)
+		property, err := p.propertyParser.LineToProperty(line[offset:])
+		if err != nil || property == nil {
+			// we ignore parsing errors here
+			// TODO: should we handle them somehow?
+			return
 		}
 
-		var i, j int
-		sep := []byte(SECTION_MARKER)
-		for {
-			j = bytes.Index(line[i:], sep)
-			if j < 0 {
-				break
-			}
+		p.data.Properties = append(p.data.Properties, *property)
+	}
 
-			marker, ts, name, err := parseSection(line[i:])
-			if err != nil {
-				// TODO: what?
-			} else if marker == string(sectionMarkerStart) {
-				sections.Start(ts, name)
-			} else if marker == string(sectionMarkerEnd) {
-				data.Sections = append(data.Sections, sections.End(ts, name)...)
-			}
+	var i, j int
+	sep := []byte(SECTION_MARKER)
+	for {
+		j = bytes.Index(line[i:], sep)
+		if j < 0 {
+			break
+		}
 
-			i = i + j + 1
+		marker, ts, name, err := parseSection(line[i:])
+		if err != nil {
+			// TODO: what?
+		} else if marker == string(sectionMarkerStart) {
+			p.sections.Start(ts, name)
+		} else if marker == string(sectionMarkerEnd) {
+			p.data.Sections = append(p.data.Sections, p.sections.End(ts, name)...)
 		}
-	}
 
-	// add all unfinished sections (e.g. due to job interruption) open-ended
-	// to give caller a chance to set end timestamp
-	for sections.Size() > 0 {
-		data.Sections = append(data.Sections, sections.Pop())
+		i = i + j + 1
 	}
-
-	return data, nil
 }
 
 func convertMetricLabels(pairs []expfmt.MetricLabelPair) map[string]string {
@@ -2,10 +2,18 @@ package rest_test
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/ratelimit"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
 )
 
@@ -348,3 +356,126 @@ func TestParseJobLog_WithTimestamps(t *testing.T) {
 		t.Errorf("Result mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestJobLogParser_LongLines(t *testing.T) {
+	trace := "section_start:1700000000:build\r\n" +
+		strings.Repeat("x", 200*1024) + "\n" +
+		"METRIC_build_success 1\n" +
+		"PROPERTY_result=\"ok\"\n" +
+		"section_end:1700000042:build\n" +
+		"METRIC_unterminated_last_line 2"
+
+	// write in small chunks to split lines and markers across writes
+	parser := rest.NewJobLogParser(0)
+	for chunk := range slices.Chunk([]byte(trace), 1000) {
+		if _, err := parser.Write(chunk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data := parser.Finish()
+
+	expected := rest.JobLogData{
+		Sections: []rest.SectionData{
+			{Name: "build", Start: 1700000000, End: 1700000042},
+		},
+		Metrics: []rest.MetricData{
+			{Name: "build_success", Value: 1},
+			{Name: "unterminated_last_line", Value: 2},
+		},
+		Properties: []rest.PropertyData{
+			{Name: "result", Value: "ok"},
+		},
+	}
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestJobLogParser_MaxSize(t *testing.T) {
+	trace := "METRIC_first 1\nMETRIC_second 2\nMETRIC_third 3\n"
+
+	parser := rest.NewJobLogParser(20)
+	n, err := parser.Write([]byte(trace))
+	if !errors.Is(err, rest.ErrJobLogTruncated) {
+		t.Errorf("Expected ErrJobLogTruncated, got %v", err)
+	}
+	if n != 20 {
+		t.Errorf("Expected 20 bytes written, got %d", n)
+	}
+	data := parser.Finish()
+
+	// the partial line `METRIC_se` is dropped
+	expected := rest.JobLogData{
+		Metrics: []rest.MetricData{
+			{Name: "first", Value: 1},
+		},
+		Truncated: true,
+	}
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestClient_GetJobLogData(t *testing.T) {
+	trace := "section_start:1700000000:build\nPROPERTY_a=\"1\"\nsection_end:1700000042:build\nPROPERTY_b=\"2\"\n"
+
+	var ranges []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/projects/1/jobs/404/trace" {
+			http.NotFound(w, r)
+			return
+		}
+		ranges = append(ranges, r.Header.Get("Range"))
+		http.ServeContent(w, r, "trace", time.Time{}, strings.NewReader(trace))
+	}))
+	defer srv.Close()
+
+	client, err := rest.NewClient(srv.URL, "token", ratelimit.New(ratelimit.Config{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	data, err := client.GetJobLogData(ctx, 1, 2, rest.JobLogOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Sections) != 1 || len(data.Properties) != 2 {
+		t.Errorf("Expected 1 section and 2 properties, got %+v", data)
+	}
+
+	// the tail starts within the `section_end` line, which is skipped
+	data, err = client.GetJobLogData(ctx, 1, 2, rest.JobLogOptions{TailSize: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := rest.JobLogData{
+		Properties: []rest.PropertyData{{Name: "b", Value: "2"}},
+	}
+	if diff := cmp.Diff(expected, data); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	data, err = client.GetJobLogData(ctx, 1, 2, rest.JobLogOptions{MaxSize: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !data.Truncated || len(data.Properties) != 1 {
+		t.Errorf("Expected truncated data with 1 property, got %+v", data)
+	}
+	if n := client.JobLogsTruncated(); n != 1 {
+		t.Errorf("Expected 1 truncated log, got %d", n)
+	}
+
+	data, err = client.GetJobLogData(ctx, 1, 404, rest.JobLogOptions{})
+	if err != nil {
+		t.Errorf("Expected no error for missing log, got %v", err)
+	}
+	if diff := cmp.Diff(rest.JobLogData{}, data); diff != "" {
+		t.Errorf("Result mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"", "bytes=-20", ""}, ranges); diff != "" {
+		t.Errorf("Range header mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,156 @@
+package rest
+
+import (
+	"context"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func ConvertMergeRequestStateEvent(mr types.MergeRequestReference, event *gitlab.StateEvent) types.MergeRequestEvent {
+	return types.MergeRequestEvent{
+		Id:           int64(event.ID),
+		MergeRequest: mr,
+		Kind:         "state",
+
+		CreatedAt: event.CreatedAt,
+		User:      convertBasicUser(event.User),
+
+		Action: string(event.State),
+	}
+}
+
+func ConvertMergeRequestLabelEvent(mr types.MergeRequestReference, event *gitlab.LabelEvent) types.MergeRequestEvent {
+	return types.MergeRequestEvent{
+		Id:           int64(event.ID),
+		MergeRequest: mr,
+		Kind:         "label",
+
+		CreatedAt: event.CreatedAt,
+		User: types.UserReference{
+			Id:       int64(event.User.ID),
+			Username: event.User.Username,
+			Name:     event.User.Name,
+		},
+
+		Action: event.Action,
+
+		Label: event.Label.Name,
+	}
+}
+
+func ConvertMergeRequestMilestoneEvent(mr types.MergeRequestReference, event *gitlab.MilestoneEvent) types.MergeRequestEvent {
+	e := types.MergeRequestEvent{
+		Id:           int64(event.ID),
+		MergeRequest: mr,
+		Kind:         "milestone",
+
+		CreatedAt: event.CreatedAt,
+		User:      convertBasicUser(event.User),
+
+		Action: event.Action,
+	}
+
+	if event.Milestone != nil {
+		e.Milestone = &types.MilestoneReference{
+			Id:  int64(event.Milestone.ID),
+			Iid: int64(event.Milestone.IID),
+			Project: types.ProjectReference{
+				Id: int64(event.Milestone.ProjectID),
+			},
+		}
+	}
+
+	return e
+}
+
+// users of deleted accounts are not included in events
+func convertBasicUser(user *gitlab.BasicUser) types.UserReference {
+	if user == nil {
+		return types.UserReference{}
+	}
+	return types.UserReference{
+		Id:       int64(user.ID),
+		Username: user.Username,
+		Name:     user.Name,
+	}
+}
+
+func (c *Client) GetMergeRequestStateEvents(ctx context.Context, projectId int64, iid int64) ([]*gitlab.StateEvent, error) {
+	var events []*gitlab.StateEvent
+
+	opts := &gitlab.ListStateEventsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		es, resp, err := c.client.ResourceStateEvents.ListMergeStateEvents(int(projectId), int(iid), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, es...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetMergeRequestLabelEvents(ctx context.Context, projectId int64, iid int64) ([]*gitlab.LabelEvent, error) {
+	var events []*gitlab.LabelEvent
+
+	opts := &gitlab.ListLabelEventsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		es, resp, err := c.client.ResourceLabelEvents.ListMergeRequestsLabelEvents(int(projectId), int(iid), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, es...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return events, nil
+}
+
+func (c *Client) GetMergeRequestMilestoneEvents(ctx context.Context, projectId int64, iid int64) ([]*gitlab.MilestoneEvent, error) {
+	var events []*gitlab.MilestoneEvent
+
+	opts := &gitlab.ListMilestoneEventsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		es, resp, err := c.client.ResourceMilestoneEvents.ListMergeMilestoneEvents(int(projectId), int(iid), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, es...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return events, nil
+}
@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"context"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func ConvertTag(projectId int64, tag *gitlab.Tag) types.Tag {
+	t := types.Tag{
+		Name: tag.Name,
+		Project: types.ProjectReference{
+			Id: projectId,
+		},
+
+		Target:    tag.Target,
+		Message:   tag.Message,
+		Protected: tag.Protected,
+
+		CreatedAt: tag.CreatedAt,
+	}
+
+	if tag.Commit != nil {
+		t.CommitSha = tag.Commit.ID
+		t.CommittedAt = tag.Commit.CommittedDate
+	}
+
+	return t
+}
+
+func ConvertRelease(projectId int64, release *gitlab.Release) types.Release {
+	project := types.ProjectReference{
+		Id: projectId,
+	}
+
+	r := types.Release{
+		TagName: release.TagName,
+		Project: project,
+
+		Name:        release.Name,
+		Description: release.Description,
+
+		CreatedAt:       release.CreatedAt,
+		ReleasedAt:      release.ReleasedAt,
+		UpcomingRelease: release.UpcomingRelease,
+
+		Author: types.UserReference{
+			Id:       int64(release.Author.ID),
+			Username: release.Author.Username,
+			Name:     release.Author.Name,
+		},
+		CommitSha: release.Commit.ID,
+	}
+
+	for _, m := range release.Milestones {
+		r.Milestones = append(r.Milestones, types.ReleaseMilestone{
+			Id:  int64(m.ID),
+			Iid: int64(m.IID),
+			Project: types.ProjectReference{
+				Id: int64(m.ProjectID),
+			},
+
+			Title: m.Title,
+			State: m.State,
+		})
+	}
+
+	for _, e := range release.Evidences {
+		r.Evidences = append(r.Evidences, types.ReleaseEvidence{
+			Sha:         e.SHA,
+			Filepath:    e.Filepath,
+			CollectedAt: e.CollectedAt,
+		})
+	}
+
+	return r
+}
+
+func (c *Client) GetProjectTags(ctx context.Context, projectId int64) ([]*gitlab.Tag, error) {
+	var tags []*gitlab.Tag
+
+	opts := &gitlab.ListTagsOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+	}
+
+	for {
+		ts, resp, err := c.client.Tags.ListTags(int(projectId), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, ts...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return tags, nil
+}
+
+type GetProjectReleasesOptions struct {
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// GetProjectReleases returns the releases of a project that were created in
+// the given time range.
+func (c *Client) GetProjectReleases(ctx context.Context, projectId int64, opt GetProjectReleasesOptions) ([]*gitlab.Release, error) {
+	var releases []*gitlab.Release
+
+	opts := &gitlab.ListReleasesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 100,
+		},
+
+		OrderBy: gitlab.Ptr("created_at"),
+		Sort:    gitlab.Ptr("desc"),
+	}
+
+	for {
+		rs, resp, err := c.client.Releases.ListReleases(int(projectId), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rs {
+			if r.CreatedAt == nil {
+				continue
+			}
+			if opt.CreatedAfter != nil && r.CreatedAt.Before(*opt.CreatedAfter) {
+				// releases are sorted by creation time, the rest is older
+				return releases, nil
+			}
+			if opt.CreatedBefore != nil && !r.CreatedAt.Before(*opt.CreatedBefore) {
+				continue
+			}
+			releases = append(releases, r)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return releases, nil
+}
+
+// GetProjectCommitPipeline returns the latest pipeline that ran for the given
+// commit, preferring pipelines for the given ref, or nil if there is none.
+func (c *Client) GetProjectCommitPipeline(ctx context.Context, projectId int64, sha string, ref string) (*gitlab.PipelineInfo, error) {
+	opts := &gitlab.ListProjectPipelinesOptions{
+		ListOptions: gitlab.ListOptions{
+			PerPage: 1,
+		},
+
+		SHA:     gitlab.Ptr(sha),
+		Ref:     gitlab.Ptr(ref),
+		OrderBy: gitlab.Ptr("id"),
+		Sort:    gitlab.Ptr("desc"),
+	}
+
+	for _, ref := range []*string{opts.Ref, nil} {
+		opts.Ref = ref
+		ps, _, err := c.client.Pipelines.ListProjectPipelines(int(projectId), opts, gitlab.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		if len(ps) > 0 {
+			return ps[0], nil
+		}
+	}
+
+	return nil, nil
+}
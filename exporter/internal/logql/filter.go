@@ -39,6 +39,7 @@ func (e filterExpression) Match(line []byte) bool {
 				return false
 			}
 		}
+		return true
 	case "|~":
 		for _, p := range e.Patterns {
 			matched, _ := regexp.Match(p, line)
@@ -163,6 +164,13 @@ const (
 	TOKEN_FILTER_OPERATOR = "OPERATOR"
 	TOKEN_FILTER_STRING   = "STRING"
 	TOKEN_FILTER_OR       = "OR"
+
+	TOKEN_PIPE   = "PIPE"
+	TOKEN_IDENT  = "IDENT"
+	TOKEN_LPAREN = "LPAREN"
+	TOKEN_RPAREN = "RPAREN"
+	TOKEN_COMMA  = "COMMA"
+	TOKEN_EQ     = "EQ"
 )
 
 type token struct {
@@ -189,6 +197,14 @@ func isStringLiteral(ch rune) bool {
 	return ch == '"' || ch == '\'' || ch == '`'
 }
 
+func isIdentStart(ch rune) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_'
+}
+
+func isIdentContinuation(ch rune) bool {
+	return isIdentStart(ch) || (ch >= '0' && ch <= '9')
+}
+
 // ========
 
 type lexer struct {
@@ -212,15 +228,28 @@ func (l *lexer) NextToken() token {
 			l.read()
 			return newToken(TOKEN_FILTER_OPERATOR, lit)
 		}
-	case 'o':
-		if lit := string(ch) + string(l.peek()); lit == "or" {
-			l.read()
-			return newToken(TOKEN_FILTER_OR, lit)
+		if ch == '|' {
+			return newToken(TOKEN_PIPE, "|")
 		}
+	case '(':
+		return newToken(TOKEN_LPAREN, "(")
+	case ')':
+		return newToken(TOKEN_RPAREN, ")")
+	case ',':
+		return newToken(TOKEN_COMMA, ",")
+	case '=':
+		return newToken(TOKEN_EQ, "=")
 	default:
 		if isStringLiteral(ch) {
 			return newToken(TOKEN_FILTER_STRING, l.readString(ch))
 		}
+		if isIdentStart(ch) {
+			lit := l.readIdent(ch)
+			if lit == "or" {
+				return newToken(TOKEN_FILTER_OR, lit)
+			}
+			return newToken(TOKEN_IDENT, lit)
+		}
 	}
 
 	return token{
@@ -259,24 +288,38 @@ func (l *lexer) peek() rune {
 	return rune(bs[0])
 }
 
+func (l *lexer) readIdent(first rune) string {
+	var buf bytes.Buffer
+
+	_, _ = buf.WriteRune(first)
+	for isIdentContinuation(l.peek()) {
+		_, _ = buf.WriteRune(l.read())
+	}
+
+	return buf.String()
+}
+
+// readString reads a string literal up to the closing quote. Within
+// backquotes, backslashes have no special meaning, like in Go raw strings, so
+// that regular expressions can be written as is. Otherwise a backslash
+// escapes the quote or another backslash and is kept as is before any other
+// character.
 func (l *lexer) readString(lit rune) string {
 	var buf bytes.Buffer
 
-	for buf.Len() < 255 {
+	for buf.Len() < 1024 {
 		ch := l.read()
 		if ch == eol || ch == lit {
 			break
 		}
 
-		if ch == '\\' {
+		if ch == '\\' && lit != '`' {
 			bs, err := l.r.Peek(1)
 			if err != nil {
 				break
 			}
 			if bs[0] == byte(lit) || bs[0] == '\\' {
 				ch = l.read()
-			} else {
-				break
 			}
 		}
 
@@ -2,28 +2,69 @@ package logql
 
 import (
 	"bufio"
-	"bytes"
+	"errors"
+	"io"
 )
 
 type MetricQuery struct {
-	Name       string
-	LineFilter LineFilter
-	LabelAdd   map[string]string
+	Name     string
+	Query    *Query
+	LabelAdd map[string]string
 }
 
-func Count(log *bytes.Reader, filters []LineFilter) ([]int, error) {
-	counts := make([]int, len(filters))
+// Counter counts the lines matching each of a list of filters.
+type Counter struct {
+	filters []LineFilter
+	counts  []int
+}
 
-	scanner := bufio.NewScanner(log)
-	for scanner.Scan() {
-		line := scanner.Bytes()
+func NewCounter(filters []LineFilter) *Counter {
+	return &Counter{
+		filters: filters,
+		counts:  make([]int, len(filters)),
+	}
+}
 
-		for i, filter := range filters {
-			if filter.Match(line) {
-				counts[i] = counts[i] + 1
-			}
+// Add counts the line for each filter it matches.
+func (c *Counter) Add(line []byte) {
+	for i, filter := range c.filters {
+		if filter.Match(line) {
+			c.counts[i] = c.counts[i] + 1
 		}
 	}
+}
 
-	return counts, nil
+// Counts returns the number of matching lines per filter.
+func (c *Counter) Counts() []int {
+	return c.counts
+}
+
+// Count counts the lines of the log matching each filter.
+func Count(log io.Reader, filters []LineFilter) ([]int, error) {
+	counter := NewCounter(filters)
+
+	reader := bufio.NewReader(log)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			counter.Add(trimEOL(line))
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	return counter.Counts(), nil
+}
+
+func trimEOL(line []byte) []byte {
+	if n := len(line); n > 0 && line[n-1] == '\n' {
+		line = line[:n-1]
+	}
+	if n := len(line); n > 0 && line[n-1] == '\r' {
+		line = line[:n-1]
+	}
+	return line
 }
@@ -0,0 +1,553 @@
+package logql
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Query turns the lines of a log into metric samples, similar to a LogQL
+// metric query. A query is a pipeline of stages, optionally wrapped in an
+// aggregation:
+//
+//	sum by (unit) (|= "Compiled" | regexp `(?P<files>\d+) files in (?P<duration>\S+)` | unwrap duration_seconds(duration))
+//
+// Stages are applied to each line in order:
+//
+//   - line filters `|=`, `!=`, `|~` and `!~` keep or drop lines
+//   - `| regexp "<re>"` extracts the named groups of a regular expression as
+//     labels
+//   - `| pattern "<pattern>"` extracts labels with a pattern like
+//     `<_> Compiled <files> files in <duration>`, where `<_>` matches anything
+//     and the pattern is matched from the start of the line
+//   - `| label_format dst=src, dst2="{{.a}}-{{.b}}"` renames labels or sets
+//     them from a template
+//   - `| unwrap <label>` takes the value of a label as the value of the
+//     line, `| unwrap duration_seconds(<label>)` and `| unwrap bytes(<label>)`
+//     convert durations like `1m3s` and sizes like `12MB` first
+//
+// Lines that do not match a parser, or whose unwrapped label is not a number,
+// are dropped. Without unwrap, the value of each line is 1.
+//
+// The aggregations `sum`, `avg`, `min`, `max` and `count` combine the values
+// of all lines of a log, grouped by the labels given with `by (...)`. A query
+// without aggregation sums the values, so a query of only line filters counts
+// the matching lines.
+type Query struct {
+	aggregation string
+	by          []string
+
+	stages []stage
+	unwrap *unwrapExpr
+}
+
+// ParseQuery parses a query. A line filter is a valid query, too.
+func ParseQuery(s string) (*Query, error) {
+	var p parser
+	p.l = newLexer(strings.NewReader(s))
+	p.nextToken()
+
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, fmt.Errorf("parse query %q: %w", s, err)
+	}
+	return q, nil
+}
+
+// Sample is a metric value computed by a query.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Evaluator computes the samples of a query over the lines of one log.
+type Evaluator struct {
+	query *Query
+
+	labels map[string]string // labels of the current line
+	groups map[string]*group
+	order  []string // group keys in order of appearance
+}
+
+type group struct {
+	labels map[string]string
+
+	count    int
+	sum      float64
+	min, max float64
+}
+
+// Evaluator returns a new evaluator of the query.
+func (q *Query) Evaluator() *Evaluator {
+	return &Evaluator{
+		query:  q,
+		labels: make(map[string]string),
+		groups: make(map[string]*group),
+	}
+}
+
+// Add passes a line through the query.
+func (e *Evaluator) Add(line []byte) {
+	clear(e.labels)
+	for _, s := range e.query.stages {
+		if !s.process(line, e.labels) {
+			return
+		}
+	}
+
+	value := 1.0
+	if u := e.query.unwrap; u != nil {
+		v, ok := u.value(e.labels)
+		if !ok {
+			return
+		}
+		value = v
+	}
+
+	var key strings.Builder
+	for _, name := range e.query.by {
+		key.WriteString(e.labels[name])
+		key.WriteByte(0xff)
+	}
+	g, ok := e.groups[key.String()]
+	if !ok {
+		g = &group{min: value, max: value}
+		if len(e.query.by) > 0 {
+			g.labels = make(map[string]string, len(e.query.by))
+			for _, name := range e.query.by {
+				if v, ok := e.labels[name]; ok {
+					g.labels[name] = v
+				}
+			}
+		}
+		e.groups[key.String()] = g
+		e.order = append(e.order, key.String())
+	}
+
+	g.count++
+	g.sum += value
+	g.min = min(g.min, value)
+	g.max = max(g.max, value)
+}
+
+// Samples returns a sample for each group of lines that passed the query,
+// none if no line did.
+func (e *Evaluator) Samples() []Sample {
+	samples := make([]Sample, 0, len(e.order))
+	for _, key := range e.order {
+		g := e.groups[key]
+
+		var value float64
+		switch e.query.aggregation {
+		case "avg":
+			value = g.sum / float64(g.count)
+		case "min":
+			value = g.min
+		case "max":
+			value = g.max
+		case "count":
+			value = float64(g.count)
+		default:
+			value = g.sum
+		}
+
+		samples = append(samples, Sample{
+			Labels: g.labels,
+			Value:  value,
+		})
+	}
+	return samples
+}
+
+// ===========================================================================
+
+type stage interface {
+	// process returns whether to keep the line, labels can be modified.
+	process(line []byte, labels map[string]string) bool
+}
+
+type filterStage struct {
+	expr filterExpression
+}
+
+func (s filterStage) process(line []byte, _ map[string]string) bool {
+	return s.expr.Match(line)
+}
+
+type regexpStage struct {
+	re *regexp.Regexp
+}
+
+func (s regexpStage) process(line []byte, labels map[string]string) bool {
+	match := s.re.FindSubmatch(line)
+	if match == nil {
+		return false
+	}
+	for i, name := range s.re.SubexpNames() {
+		if name != "" && name != "_" {
+			labels[name] = string(match[i])
+		}
+	}
+	return true
+}
+
+type labelFormat struct {
+	dst  string
+	src  string             // rename
+	tmpl *template.Template // or template
+}
+
+type labelFormatStage struct {
+	formats []labelFormat
+}
+
+func (s labelFormatStage) process(_ []byte, labels map[string]string) bool {
+	for _, f := range s.formats {
+		if f.tmpl == nil {
+			v, ok := labels[f.src]
+			if !ok {
+				continue
+			}
+			delete(labels, f.src)
+			labels[f.dst] = v
+			continue
+		}
+
+		var b strings.Builder
+		if err := f.tmpl.Execute(&b, labels); err != nil {
+			continue
+		}
+		labels[f.dst] = b.String()
+	}
+	return true
+}
+
+type unwrapExpr struct {
+	label string
+	conv  string // "", "duration_seconds" or "bytes"
+}
+
+func (u *unwrapExpr) value(labels map[string]string) (float64, bool) {
+	s, ok := labels[u.label]
+	if !ok {
+		return 0, false
+	}
+	s = strings.TrimSpace(s)
+
+	switch u.conv {
+	case "duration_seconds":
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, false
+		}
+		return d.Seconds(), true
+	case "bytes":
+		return parseBytes(s)
+	default:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	}
+}
+
+var byteUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// parseBytes parses a size like `12MB` or `1.5 GiB` as number of bytes.
+func parseBytes(s string) (float64, bool) {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r == '.')
+	})
+	if i < 0 {
+		i = len(s)
+	}
+	v, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil {
+		return 0, false
+	}
+	unit, ok := byteUnits[strings.ToLower(strings.TrimSpace(s[i:]))]
+	if !ok {
+		return 0, false
+	}
+	return v * unit, true
+}
+
+// compilePattern converts a pattern like `<_> took <duration>` into a
+// regular expression anchored at the start of the line.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	var (
+		b        strings.Builder
+		captures int
+	)
+	b.WriteString("^")
+
+	rest := pattern
+	for len(rest) > 0 {
+		i := strings.IndexByte(rest, '<')
+		if i < 0 {
+			b.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+		j := strings.IndexByte(rest[i:], '>')
+		if j < 0 {
+			b.WriteString(regexp.QuoteMeta(rest))
+			break
+		}
+
+		name := rest[i+1 : i+j]
+		if name != "_" && !isLabelName(name) {
+			// not a capture, match `<` literally
+			b.WriteString(regexp.QuoteMeta(rest[:i+1]))
+			rest = rest[i+1:]
+			continue
+		}
+
+		b.WriteString(regexp.QuoteMeta(rest[:i]))
+		rest = rest[i+j+1:]
+
+		expr := ".*?"
+		if len(rest) == 0 {
+			expr = ".*"
+		}
+		if name == "_" {
+			b.WriteString("(?:" + expr + ")")
+		} else {
+			b.WriteString("(?P<" + name + ">" + expr + ")")
+			captures++
+		}
+	}
+
+	if captures == 0 {
+		return nil, fmt.Errorf("pattern has no named captures: %q", pattern)
+	}
+	return regexp.Compile(b.String())
+}
+
+func isLabelName(s string) bool {
+	if s == "" || !isIdentStart(rune(s[0])) {
+		return false
+	}
+	for _, ch := range s {
+		if !isIdentContinuation(ch) {
+			return false
+		}
+	}
+	return true
+}
+
+// ===========================================================================
+
+var aggregations = []string{"sum", "avg", "min", "max", "count"}
+
+func (p *parser) parseQuery() (*Query, error) {
+	q := &Query{}
+
+	if p.peekTokenIs(TOKEN_IDENT) && slices.Contains(aggregations, p.peekToken.Literal) {
+		p.nextToken()
+		q.aggregation = p.currToken.Literal
+
+		if p.peekTokenIs(TOKEN_IDENT) && p.peekToken.Literal == "by" {
+			p.nextToken()
+			by, err := p.parseLabelList()
+			if err != nil {
+				return nil, err
+			}
+			q.by = by
+		}
+
+		if err := p.expect(TOKEN_LPAREN); err != nil {
+			return nil, err
+		}
+		if err := p.parsePipeline(q); err != nil {
+			return nil, err
+		}
+		if err := p.expect(TOKEN_RPAREN); err != nil {
+			return nil, err
+		}
+
+		if q.unwrap == nil && q.aggregation != "sum" && q.aggregation != "count" {
+			return nil, fmt.Errorf("%s aggregation requires unwrap", q.aggregation)
+		}
+	} else if err := p.parsePipeline(q); err != nil {
+		return nil, err
+	}
+
+	if !p.peekTokenIs(TOKEN_EOL) {
+		return nil, fmt.Errorf("unexpected token %v %q", p.peekToken.Type, p.peekToken.Literal)
+	}
+	return q, nil
+}
+
+func (p *parser) parseLabelList() ([]string, error) {
+	if err := p.expect(TOKEN_LPAREN); err != nil {
+		return nil, err
+	}
+
+	var labels []string
+	for {
+		if err := p.expect(TOKEN_IDENT); err != nil {
+			return nil, err
+		}
+		labels = append(labels, p.currToken.Literal)
+
+		if !p.peekTokenIs(TOKEN_COMMA) {
+			break
+		}
+		p.nextToken()
+	}
+
+	if err := p.expect(TOKEN_RPAREN); err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+func (p *parser) parsePipeline(q *Query) error {
+	for q.unwrap == nil {
+		switch {
+		case p.peekTokenIs(TOKEN_FILTER_OPERATOR):
+			expr, err := p.parseFilterExpression()
+			if err != nil {
+				return err
+			}
+			if err := validateFilterExpression(expr); err != nil {
+				return err
+			}
+			q.stages = append(q.stages, filterStage{expr: expr})
+		case p.peekTokenIs(TOKEN_PIPE):
+			p.nextToken()
+			if err := p.expect(TOKEN_IDENT); err != nil {
+				return err
+			}
+			if err := p.parseStage(q); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseStage(q *Query) error {
+	switch name := p.currToken.Literal; name {
+	case "regexp", "pattern":
+		if err := p.expect(TOKEN_FILTER_STRING); err != nil {
+			return err
+		}
+		var (
+			re  *regexp.Regexp
+			err error
+		)
+		if name == "regexp" {
+			re, err = regexp.Compile(p.currToken.Literal)
+		} else {
+			re, err = compilePattern(p.currToken.Literal)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		q.stages = append(q.stages, regexpStage{re: re})
+	case "label_format":
+		formats, err := p.parseLabelFormats()
+		if err != nil {
+			return fmt.Errorf("label_format: %w", err)
+		}
+		q.stages = append(q.stages, labelFormatStage{formats: formats})
+	case "unwrap":
+		u, err := p.parseUnwrap()
+		if err != nil {
+			return fmt.Errorf("unwrap: %w", err)
+		}
+		q.unwrap = u
+	default:
+		return fmt.Errorf("unknown stage %q", name)
+	}
+	return nil
+}
+
+func (p *parser) parseLabelFormats() ([]labelFormat, error) {
+	var formats []labelFormat
+	for {
+		if err := p.expect(TOKEN_IDENT); err != nil {
+			return nil, err
+		}
+		f := labelFormat{dst: p.currToken.Literal}
+		if err := p.expect(TOKEN_EQ); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case p.peekTokenIs(TOKEN_IDENT):
+			p.nextToken()
+			f.src = p.currToken.Literal
+		case p.peekTokenIs(TOKEN_FILTER_STRING):
+			p.nextToken()
+			tmpl, err := template.New(f.dst).Option("missingkey=zero").Parse(p.currToken.Literal)
+			if err != nil {
+				return nil, err
+			}
+			f.tmpl = tmpl
+		default:
+			return nil, fmt.Errorf("expected label name or template, got %v", p.peekToken.Type)
+		}
+		formats = append(formats, f)
+
+		if !p.peekTokenIs(TOKEN_COMMA) {
+			return formats, nil
+		}
+		p.nextToken()
+	}
+}
+
+func (p *parser) parseUnwrap() (*unwrapExpr, error) {
+	if err := p.expect(TOKEN_IDENT); err != nil {
+		return nil, err
+	}
+	u := &unwrapExpr{label: p.currToken.Literal}
+
+	if !p.peekTokenIs(TOKEN_LPAREN) {
+		return u, nil
+	}
+	if u.label != "duration_seconds" && u.label != "bytes" {
+		return nil, fmt.Errorf("unknown conversion function %q", u.label)
+	}
+	u.conv = u.label
+	p.nextToken()
+	if err := p.expect(TOKEN_IDENT); err != nil {
+		return nil, err
+	}
+	u.label = p.currToken.Literal
+	if err := p.expect(TOKEN_RPAREN); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func validateFilterExpression(expr filterExpression) error {
+	if expr.Operator != "|~" && expr.Operator != "!~" {
+		return nil
+	}
+	for _, pattern := range expr.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
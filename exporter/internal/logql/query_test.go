@@ -0,0 +1,122 @@
+package logql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/logql"
+)
+
+func TestQuery(t *testing.T) {
+	log := `
+[core] Compiled 523 files in 34.2s
+[core] Compiled 17 files in 1m3s
+[web] Compiled 40 files in 2s
+[web] Compiled many files in 1s
+Uploaded artifact of 12MB
+Uploaded artifact of 1.5 KiB
+WARNING: something happened
+`
+
+	tests := []struct {
+		name  string
+		query string
+		want  []logql.Sample
+	}{
+		{
+			name:  "line filter",
+			query: `|= "Compiled" != "many"`,
+			want:  []logql.Sample{{Value: 3}},
+		},
+		{
+			name:  "no match",
+			query: `|= "ERROR"`,
+			want:  []logql.Sample{},
+		},
+		{
+			name:  "regexp unwrap",
+			query: "sum(| regexp `Compiled (?P<files>\\d+) files` | unwrap files)",
+			want:  []logql.Sample{{Value: 580}},
+		},
+		{
+			name:  "sum by",
+			query: "sum by (module) (| regexp `\\[(?P<module>\\w+)\\] Compiled (?P<files>\\d+) files` | unwrap files)",
+			want: []logql.Sample{
+				{Labels: map[string]string{"module": "core"}, Value: 540},
+				{Labels: map[string]string{"module": "web"}, Value: 40},
+			},
+		},
+		{
+			name:  "pattern max duration",
+			query: `max by (module) (| pattern "[<module>] Compiled <_> files in <duration>" | unwrap duration_seconds(duration))`,
+			want: []logql.Sample{
+				{Labels: map[string]string{"module": "core"}, Value: 63},
+				{Labels: map[string]string{"module": "web"}, Value: 2},
+			},
+		},
+		{
+			name:  "avg",
+			query: "avg(| regexp `Compiled (?P<files>\\d+) files` | unwrap files)",
+			want:  []logql.Sample{{Value: 580.0 / 3}},
+		},
+		{
+			name:  "count by",
+			query: `count by (module) (|= "Compiled" | pattern "[<module>] <_>")`,
+			want: []logql.Sample{
+				{Labels: map[string]string{"module": "core"}, Value: 2},
+				{Labels: map[string]string{"module": "web"}, Value: 2},
+			},
+		},
+		{
+			name:  "bytes",
+			query: `sum(| pattern "Uploaded artifact of <size>" | unwrap bytes(size))`,
+			want:  []logql.Sample{{Value: 12e6 + 1536}},
+		},
+		{
+			name:  "label_format",
+			query: `sum by (component, level) (| regexp "^(?P<lvl>[A-Z]+): (?P<what>\\w+)" | label_format level=lvl, component="{{.what}}-x")`,
+			want: []logql.Sample{
+				{Labels: map[string]string{"component": "something-x", "level": "WARNING"}, Value: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := logql.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := q.Evaluator()
+			for line := range strings.Lines(log) {
+				e.Add([]byte(strings.TrimSuffix(line, "\n")))
+			}
+
+			if diff := cmp.Diff(tt.want, e.Samples()); diff != "" {
+				t.Errorf("Samples mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseQuery_Invalid(t *testing.T) {
+	for _, query := range []string{
+		`|= "a" |`,
+		`| regexp "("`,
+		`| pattern "no captures"`,
+		`| unknown "a"`,
+		`max(|= "a")`,
+		`sum by () (|= "a")`,
+		`sum(|= "a"`,
+		`| unwrap foo(bar)`,
+		`| unwrap a |= "b"`,
+		`|~ "("`,
+	} {
+		if _, err := logql.ParseQuery(query); err == nil {
+			t.Errorf("Expected error for query %q", query)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package otlp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	traceIdSize = 16
+	spanIdSize  = 8
+)
+
+// normalizeIds returns a copy of the given resource spans with trace and span
+// ids converted to the sizes required by OTLP.
+//
+// The exporter identifies spans by the decimal GitLab ids of pipelines, jobs
+// and sections. These are encoded as big endian integers, so that the same
+// GitLab id always maps to the same OTLP id and links between traces resolve.
+func normalizeIds(data []*tracepb.ResourceSpans) []*tracepb.ResourceSpans {
+	result := make([]*tracepb.ResourceSpans, 0, len(data))
+	for _, rs := range data {
+		rs = proto.Clone(rs).(*tracepb.ResourceSpans)
+		for _, ss := range rs.GetScopeSpans() {
+			for _, span := range ss.GetSpans() {
+				span.TraceId = normalizeId(span.TraceId, traceIdSize)
+				span.SpanId = normalizeId(span.SpanId, spanIdSize)
+				span.ParentSpanId = normalizeId(span.ParentSpanId, spanIdSize)
+				for _, link := range span.GetLinks() {
+					link.TraceId = normalizeId(link.TraceId, traceIdSize)
+					link.SpanId = normalizeId(link.SpanId, spanIdSize)
+				}
+			}
+		}
+		result = append(result, rs)
+	}
+	return result
+}
+
+func normalizeId(id []byte, size int) []byte {
+	if len(id) == 0 {
+		return id
+	}
+
+	// ids are checked for decimal digits first, since e.g. an eight digit
+	// GitLab id has the size of a span id already.
+	b := make([]byte, size)
+	if n, err := strconv.ParseUint(string(id), 10, 64); err == nil {
+		binary.BigEndian.PutUint64(b[size-8:], n)
+		return b
+	}
+	if len(id) == size {
+		return id
+	}
+
+	// not a GitLab id, derive a stable id from its hash
+	sum := sha256.Sum256(id)
+	copy(b, sum[:])
+	return b
+}
@@ -0,0 +1,194 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Protocol is the OTLP transport protocol.
+type Protocol string
+
+const (
+	ProtocolGRPC Protocol = "grpc"
+	ProtocolHTTP Protocol = "http"
+)
+
+const (
+	// exportMethod is the OTLP/gRPC trace export method.
+	exportMethod = "/opentelemetry.proto.collector.trace.v1.TraceService/Export"
+	// tracesPath is the default OTLP/HTTP trace export path.
+	tracesPath = "/v1/traces"
+
+	defaultTimeout = 10 * time.Second
+)
+
+type Config struct {
+	Protocol Protocol
+	// Endpoint is the host:port of the collector for gRPC, or its URL for
+	// HTTP. HTTP endpoints without a path export to /v1/traces.
+	Endpoint string
+	// Headers are sent with every export request.
+	Headers map[string]string
+	// TLS is used to connect to the collector, if not nil.
+	TLS *tls.Config
+	// Timeout of a single export request.
+	Timeout time.Duration
+}
+
+// Sink exports traces to an OpenTelemetry collector or any other backend
+// accepting OTLP.
+type Sink struct {
+	config Config
+
+	conn       *grpc.ClientConn
+	httpClient *http.Client
+	url        string
+}
+
+func NewSink(cfg Config) (*Sink, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint is required")
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	s := &Sink{
+		config: cfg,
+	}
+
+	switch cfg.Protocol {
+	case ProtocolGRPC, "":
+		creds := insecure.NewCredentials()
+		if cfg.TLS != nil {
+			creds = credentials.NewTLS(cfg.TLS)
+		}
+		conn, err := grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, fmt.Errorf("create grpc client: %w", err)
+		}
+		s.conn = conn
+	case ProtocolHTTP:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("parse endpoint: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("invalid endpoint scheme: %q", u.Scheme)
+		}
+		if u.Path == "" || u.Path == "/" {
+			u.Path = tracesPath
+		}
+		s.url = u.String()
+
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = cfg.TLS
+		s.httpClient = &http.Client{
+			Transport: transport,
+			Timeout:   cfg.Timeout,
+		}
+	default:
+		return nil, fmt.Errorf("invalid protocol: %q", cfg.Protocol)
+	}
+
+	return s, nil
+}
+
+// Target returns the endpoint the sink exports to.
+func (s *Sink) Target() string {
+	return s.config.Endpoint
+}
+
+// ExportTraces sends the given traces. The data is not modified.
+func (s *Sink) ExportTraces(ctx context.Context, data []*tracepb.ResourceSpans) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	// The TracesData message is wire compatible with the OTLP
+	// ExportTraceServiceRequest.
+	req := &tracepb.TracesData{
+		ResourceSpans: normalizeIds(data),
+	}
+
+	if s.conn != nil {
+		return s.exportGRPC(ctx, req)
+	}
+	return s.exportHTTP(ctx, req)
+}
+
+func (s *Sink) exportGRPC(ctx context.Context, req *tracepb.TracesData) error {
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	if len(s.config.Headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(lowerKeys(s.config.Headers)))
+	}
+
+	if err := s.conn.Invoke(ctx, exportMethod, req, &emptypb.Empty{}); err != nil {
+		return fmt.Errorf("export traces: %w", err)
+	}
+	return nil
+}
+
+func (s *Sink) exportHTTP(ctx context.Context, req *tracepb.TracesData) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal traces: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range s.config.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("export traces: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("export traces: unexpected status %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
+
+// Close releases the resources of the sink.
+func (s *Sink) Close() error {
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	s.httpClient.CloseIdleConnections()
+	return nil
+}
+
+func lowerKeys(m map[string]string) map[string]string {
+	l := make(map[string]string, len(m))
+	for k, v := range m {
+		l[strings.ToLower(k)] = v
+	}
+	return l
+}
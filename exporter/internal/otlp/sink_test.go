@@ -0,0 +1,190 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func testResourceSpans() []*tracepb.ResourceSpans {
+	return []*tracepb.ResourceSpans{
+		{
+			ScopeSpans: []*tracepb.ScopeSpans{
+				{
+					Spans: []*tracepb.Span{
+						{
+							TraceId:      []byte("12345678"),
+							SpanId:       []byte("87654321"),
+							ParentSpanId: []byte("12345678"),
+							Name:         "job",
+							Links: []*tracepb.Span_Link{
+								{TraceId: []byte("42"), SpanId: []byte("42")},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func checkIds(t *testing.T, data *tracepb.TracesData) {
+	t.Helper()
+
+	span := data.GetResourceSpans()[0].GetScopeSpans()[0].GetSpans()[0]
+	want := func(size int, n byte) []byte {
+		b := make([]byte, size)
+		b[size-1] = n
+		return b
+	}
+
+	if got := span.GetTraceId(); len(got) != traceIdSize || !bytes.Equal(got[12:], []byte{0x00, 0xbc, 0x61, 0x4e}) {
+		t.Errorf("unexpected trace id: %x", got)
+	}
+	if got := span.GetSpanId(); len(got) != spanIdSize || !bytes.Equal(got[4:], []byte{0x05, 0x39, 0x7f, 0xb1}) {
+		t.Errorf("unexpected span id: %x", got)
+	}
+	if got := span.GetParentSpanId(); len(got) != spanIdSize || !bytes.Equal(got[4:], []byte{0x00, 0xbc, 0x61, 0x4e}) {
+		t.Errorf("unexpected parent span id: %x", got)
+	}
+	link := span.GetLinks()[0]
+	if !bytes.Equal(link.GetTraceId(), want(traceIdSize, 42)) || !bytes.Equal(link.GetSpanId(), want(spanIdSize, 42)) {
+		t.Errorf("unexpected link ids: %x %x", link.GetTraceId(), link.GetSpanId())
+	}
+}
+
+func TestSink_HTTP(t *testing.T) {
+	var (
+		path, contentType, token string
+		received                 tracepb.TracesData
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		contentType = r.Header.Get("Content-Type")
+		token = r.Header.Get("Authorization")
+
+		body, _ := io.ReadAll(r.Body)
+		if err := proto.Unmarshal(body, &received); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}))
+	defer srv.Close()
+
+	sink, err := NewSink(Config{
+		Protocol: ProtocolHTTP,
+		Endpoint: srv.URL,
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	data := testResourceSpans()
+	if err := sink.ExportTraces(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+
+	if path != tracesPath {
+		t.Errorf("want path %q, got %q", tracesPath, path)
+	}
+	if contentType != "application/x-protobuf" {
+		t.Errorf("unexpected content type: %q", contentType)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected authorization header: %q", token)
+	}
+	checkIds(t, &received)
+
+	// the exported data is not modified
+	if !proto.Equal(data[0], testResourceSpans()[0]) {
+		t.Error("expected data to be unchanged")
+	}
+}
+
+func TestSink_HTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	sink, err := NewSink(Config{Protocol: ProtocolHTTP, Endpoint: srv.URL + "/custom/traces"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	if err := sink.ExportTraces(context.Background(), testResourceSpans()); err == nil {
+		t.Error("expected export error")
+	}
+}
+
+func TestSink_GRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		method   string
+		token    []string
+		received tracepb.TracesData
+	)
+	srv := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		method, _ = grpc.MethodFromServerStream(stream)
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		token = md.Get("authorization")
+
+		if err := stream.RecvMsg(&received); err != nil {
+			return err
+		}
+		return stream.SendMsg(&emptypb.Empty{})
+	}))
+	go func() { _ = srv.Serve(lis) }()
+	defer srv.Stop()
+
+	sink, err := NewSink(Config{
+		Protocol: ProtocolGRPC,
+		Endpoint: lis.Addr().String(),
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	if err := sink.ExportTraces(context.Background(), testResourceSpans()); err != nil {
+		t.Fatal(err)
+	}
+
+	if method != exportMethod {
+		t.Errorf("want method %q, got %q", exportMethod, method)
+	}
+	if len(token) != 1 || token[0] != "Bearer token" {
+		t.Errorf("unexpected authorization metadata: %v", token)
+	}
+	checkIds(t, &received)
+}
+
+func TestNewSink_InvalidConfig(t *testing.T) {
+	for _, cfg := range []Config{
+		{Protocol: ProtocolGRPC},
+		{Protocol: ProtocolHTTP, Endpoint: "localhost:4318"},
+		{Protocol: "thrift", Endpoint: "localhost:4317"},
+	} {
+		if _, err := NewSink(cfg); err == nil {
+			t.Errorf("expected error for config %+v", cfg)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package schedule
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron runs a task according to a cron expression with the five fields
+// minute, hour, day of month, month and day of week. Fields support `*`,
+// lists, ranges and steps, e.g. `0,30 8-18/2 * * 1-5`. The descriptors
+// `@hourly`, `@daily`, `@weekly`, `@monthly` and `@yearly` are supported as
+// well. As with cron, if both day of month and day of week are restricted,
+// a day matches if either matches.
+type Cron struct {
+	spec string
+
+	minute, hour, dom, month, dow uint64
+}
+
+var cronDescriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+type cronBounds struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteBounds = cronBounds{"minute", 0, 59}
+	hourBounds   = cronBounds{"hour", 0, 23}
+	domBounds    = cronBounds{"day of month", 1, 31}
+	monthBounds  = cronBounds{"month", 1, 12}
+	dowBounds    = cronBounds{"day of week", 0, 7}
+)
+
+// ParseCron parses a cron expression.
+func ParseCron(spec string) (*Cron, error) {
+	expr := spec
+	if d, ok := cronDescriptors[spec]; ok {
+		expr = d
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields: %q", spec)
+	}
+
+	c := &Cron{spec: spec}
+	for i, f := range []struct {
+		bits   *uint64
+		bounds cronBounds
+	}{
+		{&c.minute, minuteBounds},
+		{&c.hour, hourBounds},
+		{&c.dom, domBounds},
+		{&c.month, monthBounds},
+		{&c.dow, dowBounds},
+	} {
+		b, err := parseCronField(fields[i], f.bounds)
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", spec, err)
+		}
+		*f.bits = b
+	}
+
+	// both 0 and 7 are sunday
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+
+	if c.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("cron expression never matches: %q", spec)
+	}
+
+	return c, nil
+}
+
+func parseCronField(field string, bounds cronBounds) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid %s step: %q", bounds.name, part)
+			}
+		}
+
+		lo, hi := bounds.min, bounds.max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+
+			var err error
+			lo, err = strconv.Atoi(loStr)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s: %q", bounds.name, part)
+			}
+			hi = lo
+			if isRange {
+				hi, err = strconv.Atoi(hiStr)
+				if err != nil {
+					return 0, fmt.Errorf("invalid %s: %q", bounds.name, part)
+				}
+			} else if hasStep {
+				hi = bounds.max
+			}
+		}
+		if lo < bounds.min || hi > bounds.max || lo > hi {
+			return 0, fmt.Errorf("%s out of range [%d, %d]: %q", bounds.name, bounds.min, bounds.max, part)
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func (c *Cron) String() string {
+	return c.spec
+}
+
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// give up if there is no match within five years, e.g. for February 30
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (c *Cron) matchesDay(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+
+	domRestricted := bits.OnesCount64(c.dom) < domBounds.max-domBounds.min+1
+	dowRestricted := bits.OnesCount64(c.dow) < 7
+	if domRestricted && dowRestricted {
+		return domMatch || dowMatch
+	}
+	return domMatch && dowMatch
+}
@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// Schedule determines when a recurring task runs.
+type Schedule interface {
+	// Next returns the first time after t at which the task runs.
+	Next(t time.Time) time.Time
+	// String returns the specification the schedule was parsed from.
+	String() string
+}
+
+// Parse parses a schedule specification, which is either a duration like
+// `5m`, or a cron expression like `*/15 * * * *`.
+func Parse(spec string) (Schedule, error) {
+	if d, err := time.ParseDuration(spec); err == nil {
+		if d <= 0 {
+			return nil, fmt.Errorf("interval must be positive: %q", spec)
+		}
+		return Every(d), nil
+	}
+
+	c, err := ParseCron(spec)
+	if err != nil {
+		return nil, fmt.Errorf("neither a duration nor a cron expression: %w", err)
+	}
+	return c, nil
+}
+
+// Every runs a task at a fixed interval.
+type Every time.Duration
+
+func (e Every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+func (e Every) String() string {
+	return time.Duration(e).String()
+}
+
+// Period returns the time between the first two runs after t.
+func Period(s Schedule, t time.Time) time.Duration {
+	next := s.Next(t)
+	return s.Next(next).Sub(next)
+}
+
+// Jitter returns a random duration in [0, max), or zero if max is not
+// positive.
+func Jitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return rand.N(max)
+}
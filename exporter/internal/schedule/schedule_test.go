@@ -0,0 +1,113 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec string
+		want []time.Time
+	}{
+		{
+			spec: "5m0s",
+			want: []time.Time{
+				time.Date(2024, time.January, 31, 10, 12, 30, 0, time.UTC),
+				time.Date(2024, time.January, 31, 10, 17, 30, 0, time.UTC),
+			},
+		},
+		{
+			spec: "*/15 * * * *",
+			want: []time.Time{
+				time.Date(2024, time.January, 31, 10, 15, 0, 0, time.UTC),
+				time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 8-18/4 * * 1-5", // wednesday
+			want: []time.Time{
+				time.Date(2024, time.January, 31, 12, 0, 0, 0, time.UTC),
+				time.Date(2024, time.January, 31, 16, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 1, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "30 2 * * 0",
+			want: []time.Time{
+				time.Date(2024, time.February, 4, 2, 30, 0, 0, time.UTC),
+				time.Date(2024, time.February, 11, 2, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 0 29 2 *",
+			want: []time.Time{
+				time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "0 0 1 * 1", // first of month or monday
+			want: []time.Time{
+				time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			spec: "@daily",
+			want: []time.Time{
+				time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			s, err := Parse(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.String() != tt.spec {
+				t.Errorf("want spec %q, got %q", tt.spec, s.String())
+			}
+
+			next := start
+			for _, want := range tt.want {
+				next = s.Next(next)
+				if !next.Equal(want) {
+					t.Fatalf("want next run at %s, got %s", want, next)
+				}
+			}
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"-5m",
+		"* * * *",
+		"60 * * * *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"0 0 30 2 *",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("want error parsing %q", spec)
+		}
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	s, err := Parse("0 */6 * * *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Period(s, time.Now()); got != 6*time.Hour {
+		t.Errorf("want period 6h, got %s", got)
+	}
+}
@@ -0,0 +1,96 @@
+package spool
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type metrics struct {
+	spool *Spool
+
+	spooled  atomic.Uint64
+	replayed atomic.Uint64
+	dropped  atomic.Uint64
+
+	recordsDesc  *prometheus.Desc
+	sizeDesc     *prometheus.Desc
+	segmentsDesc *prometheus.Desc
+	spooledDesc  *prometheus.Desc
+	replayedDesc *prometheus.Desc
+	droppedDesc  *prometheus.Desc
+}
+
+func newMetrics(s *Spool, name string) *metrics {
+	labels := prometheus.Labels{"spool": name}
+
+	return &metrics{
+		spool: s,
+
+		recordsDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_records",
+			"Number of requests currently held in the spool.",
+			nil, labels,
+		),
+		sizeDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_size_bytes",
+			"Total size of the spool segment files in bytes.",
+			nil, labels,
+		),
+		segmentsDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_segments",
+			"Number of spool segment files.",
+			nil, labels,
+		),
+		spooledDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_spooled_records_total",
+			"Total number of requests written to the spool.",
+			nil, labels,
+		),
+		replayedDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_replayed_records_total",
+			"Total number of spooled requests delivered to the recorder.",
+			nil, labels,
+		),
+		droppedDesc: prometheus.NewDesc(
+			"gitlab_exporter_spool_dropped_records_total",
+			"Total number of spooled requests dropped due to size or age limits.",
+			nil, labels,
+		),
+	}
+}
+
+// MetricsCollector returns a collector for the spool depth metrics.
+func (s *Spool) MetricsCollector() prometheus.Collector {
+	return s.metrics
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.recordsDesc
+	ch <- m.sizeDesc
+	ch <- m.segmentsDesc
+	ch <- m.spooledDesc
+	ch <- m.replayedDesc
+	ch <- m.droppedDesc
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.spool.mu.Lock()
+	var (
+		records  int
+		size     int64
+		segments = len(m.spool.segments)
+	)
+	for _, seg := range m.spool.segments {
+		records += seg.records
+		size += seg.size
+	}
+	m.spool.mu.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(m.recordsDesc, prometheus.GaugeValue, float64(records))
+	ch <- prometheus.MustNewConstMetric(m.sizeDesc, prometheus.GaugeValue, float64(size))
+	ch <- prometheus.MustNewConstMetric(m.segmentsDesc, prometheus.GaugeValue, float64(segments))
+	ch <- prometheus.MustNewConstMetric(m.spooledDesc, prometheus.CounterValue, float64(m.spooled.Load()))
+	ch <- prometheus.MustNewConstMetric(m.replayedDesc, prometheus.CounterValue, float64(m.replayed.Load()))
+	ch <- prometheus.MustNewConstMetric(m.droppedDesc, prometheus.CounterValue, float64(m.dropped.Load()))
+}
@@ -0,0 +1,178 @@
+package spool
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+const replayInterval time.Duration = 10 * time.Second
+
+type replayKey struct{}
+
+// UnaryClientInterceptor returns an interceptor that writes record requests
+// to the spool instead of failing them when the recorder is unavailable.
+//
+// As long as the spool is not empty, all requests are appended to it so that
+// they are delivered in order once the recorder is available again.
+func (s *Spool) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	prefix := "/" + servicepb.GitLabExporter_ServiceDesc.ServiceName + "/Record"
+
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		msg, ok := req.(proto.Message)
+		if !ok || !strings.HasPrefix(method, prefix) || ctx.Value(replayKey{}) != nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if s.Len() == 0 {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if status.Code(err) != codes.Unavailable {
+				return err
+			}
+			slog.Debug("Recorder unavailable, spooling request", "dir", s.dir, "method", method, "error", err)
+		}
+
+		if err := s.Append(method, msg); err != nil {
+			return fmt.Errorf("spool request: %w", err)
+		}
+		return nil
+	}
+}
+
+// StreamClientInterceptor returns an interceptor that refuses to open record
+// streams as long as the spool is not empty, so that the exporter falls back
+// to unary requests, which are appended to the spool in order.
+func (s *Spool) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if method == servicepb.GitLabExporter_RecordStream_FullMethodName && s.Len() > 0 {
+			return nil, status.Error(codes.Unavailable, "spool is not empty")
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+// Run replays the spooled requests over the given connection whenever the
+// recorder reports to be serving, until the context is canceled.
+func (s *Spool) Run(ctx context.Context, conn grpc.ClientConnInterface) error {
+	health := healthpb.NewHealthClient(conn)
+
+	ticker := time.NewTicker(replayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case now := <-ticker.C:
+			s.mu.Lock()
+			s.enforceLimits(now)
+			s.mu.Unlock()
+		}
+
+		if s.Len() == 0 {
+			continue
+		}
+
+		resp, err := health.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil || resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			continue
+		}
+
+		slog.Info("Replaying spooled requests...", "dir", s.dir, "count", s.Len())
+		if err := s.replay(ctx, conn); err != nil {
+			slog.Warn("error replaying spooled requests", "dir", s.dir, "error", err)
+			continue
+		}
+		slog.Info("Replaying spooled requests... done", "dir", s.dir)
+	}
+}
+
+// replay sends the spooled requests segment by segment. A segment that could
+// not be replayed completely is kept and replayed again from its start, since
+// recorders handle duplicate data.
+func (s *Spool) replay(ctx context.Context, conn grpc.ClientConnInterface) error {
+	ctx = context.WithValue(ctx, replayKey{}, true)
+
+	for {
+		seg, ok := s.head()
+		if !ok {
+			return nil
+		}
+
+		err := readSegment(s.segmentPath(seg.seq), func(method string, data []byte) error {
+			req, reply, err := newMessages(method)
+			if err != nil {
+				slog.Warn("Dropping spooled request", "dir", s.dir, "method", method, "error", err)
+				s.metrics.dropped.Add(1)
+				return nil
+			}
+			if err := proto.Unmarshal(data, req); err != nil {
+				slog.Warn("Dropping spooled request", "dir", s.dir, "method", method, "error", err)
+				s.metrics.dropped.Add(1)
+				return nil
+			}
+
+			if err := conn.Invoke(ctx, method, req, reply); err != nil {
+				if status.Code(err) == codes.Unimplemented {
+					// the recorder does not accept this kind of data
+					slog.Warn("Dropping spooled request", "dir", s.dir, "method", method, "error", err)
+					s.metrics.dropped.Add(1)
+					return nil
+				}
+				return err
+			}
+			s.metrics.replayed.Add(1)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		s.remove(seg.seq)
+	}
+}
+
+// newMessages returns new request and response messages for the given
+// gRPC method.
+func newMessages(method string) (proto.Message, proto.Message, error) {
+	service, name, ok := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid method: %q", method)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, nil, fmt.Errorf("find service: %w", err)
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a service: %q", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(name))
+	if md == nil {
+		return nil, nil, fmt.Errorf("unknown method: %q", method)
+	}
+
+	in, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("find request type: %w", err)
+	}
+	out, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, nil, fmt.Errorf("find response type: %w", err)
+	}
+
+	return in.New().Interface(), out.New().Interface(), nil
+}
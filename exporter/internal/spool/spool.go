@@ -0,0 +1,385 @@
+package spool
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	segmentExt = ".seg"
+
+	defaultSegmentSize int64 = 16 << 20 // 16 MiB
+
+	// Each record is prefixed by the length and checksum of its payload.
+	recordHeaderSize = 8
+	// Records are batches of at most a few MiB, anything larger is corrupt.
+	maxRecordSize = 64 << 20
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+var errCorruptRecord = errors.New("corrupt record")
+
+type Options struct {
+	// MaxSize is the maximum total size of the spool in bytes.
+	// The oldest segments are dropped when it is exceeded. Zero means no limit.
+	MaxSize int64
+	// MaxAge is the maximum age of spooled requests.
+	// Older segments are dropped. Zero means no limit.
+	MaxAge time.Duration
+	// SegmentSize is the size at which a new segment file is started.
+	SegmentSize int64
+}
+
+type segment struct {
+	seq     uint64
+	size    int64
+	records int
+	modTime time.Time
+}
+
+// Spool is a write-ahead log of gRPC requests that could not be delivered to
+// a recorder.
+//
+// Requests are appended to segment files as length-prefixed records and are
+// replayed in the order they were written. Segments are deleted once all of
+// their requests have been replayed.
+type Spool struct {
+	dir  string
+	opts Options
+
+	mu       sync.Mutex
+	segments []segment // oldest first
+	active   *os.File  // open for appending to the last segment, nil if sealed
+	nextSeq  uint64
+
+	metrics *metrics
+}
+
+// Open opens the spool in the given directory, creating it if necessary.
+func Open(dir string, opts Options) (*Spool, error) {
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+	if opts.MaxSize > 0 && opts.SegmentSize > opts.MaxSize/2 {
+		// make sure that dropping the oldest segment frees enough space
+		opts.SegmentSize = max(opts.MaxSize/2, 1)
+	}
+
+	s := &Spool{
+		dir:  filepath.Clean(dir),
+		opts: opts,
+	}
+	s.metrics = newMetrics(s, filepath.Base(s.dir))
+
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create spool directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read spool directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("stat segment: %w", err)
+		}
+
+		var records int
+		err = readSegment(s.segmentPath(seq), func(string, []byte) error {
+			records++
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("read segment %s: %w", name, err)
+		}
+
+		s.segments = append(s.segments, segment{
+			seq:     seq,
+			size:    info.Size(),
+			records: records,
+			modTime: info.ModTime(),
+		})
+	}
+
+	slices.SortFunc(s.segments, func(a, b segment) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	if n := len(s.segments); n > 0 {
+		s.nextSeq = s.segments[n-1].seq + 1
+	}
+
+	s.mu.Lock()
+	s.enforceLimits(time.Now())
+	s.mu.Unlock()
+
+	return s, nil
+}
+
+// Len returns the number of spooled requests.
+func (s *Spool) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int
+	for _, seg := range s.segments {
+		n += seg.records
+	}
+	return n
+}
+
+// Append writes the given request for the given gRPC method to the spool.
+func (s *Spool) Append(method string, req proto.Message) error {
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	record := encodeRecord(method, data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.active == nil || s.segments[len(s.segments)-1].size >= s.opts.SegmentSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.active.Write(record); err != nil {
+		return fmt.Errorf("write segment: %w", err)
+	}
+	if err := s.active.Sync(); err != nil {
+		return fmt.Errorf("sync segment: %w", err)
+	}
+
+	now := time.Now()
+	last := &s.segments[len(s.segments)-1]
+	last.size += int64(len(record))
+	last.records++
+	last.modTime = now
+
+	s.metrics.spooled.Add(1)
+	s.enforceLimits(now)
+
+	return nil
+}
+
+// Close closes the segment that is currently written to.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.seal()
+}
+
+func (s *Spool) segmentPath(seq uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", seq, segmentExt))
+}
+
+// rotate must be called with the lock held.
+func (s *Spool) rotate() error {
+	if err := s.seal(); err != nil {
+		return err
+	}
+
+	seq := s.nextSeq
+	f, err := os.OpenFile(s.segmentPath(seq), os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("create segment: %w", err)
+	}
+
+	s.nextSeq++
+	s.active = f
+	s.segments = append(s.segments, segment{seq: seq, modTime: time.Now()})
+
+	return nil
+}
+
+// seal must be called with the lock held.
+func (s *Spool) seal() error {
+	if s.active == nil {
+		return nil
+	}
+
+	err := s.active.Close()
+	s.active = nil
+	if err != nil {
+		return fmt.Errorf("close segment: %w", err)
+	}
+	return nil
+}
+
+// head seals and returns the oldest segment, if any.
+func (s *Spool) head() (segment, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.segments) == 0 {
+		return segment{}, false
+	}
+
+	if len(s.segments) == 1 {
+		// requests spooled from now on go to a new segment
+		if err := s.seal(); err != nil {
+			slog.Warn("error sealing spool segment", "dir", s.dir, "error", err)
+		}
+	}
+
+	return s.segments[0], true
+}
+
+// remove deletes the segment with the given sequence number.
+func (s *Spool) remove(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := slices.IndexFunc(s.segments, func(seg segment) bool { return seg.seq == seq })
+	if i < 0 { // already dropped
+		return
+	}
+	s.deleteSegment(i)
+}
+
+// enforceLimits drops the oldest segments that exceed the configured size
+// and age limits. It must be called with the lock held.
+func (s *Spool) enforceLimits(now time.Time) {
+	var size int64
+	for _, seg := range s.segments {
+		size += seg.size
+	}
+
+	for len(s.segments) > 0 {
+		oldest := s.segments[0]
+
+		tooBig := s.opts.MaxSize > 0 && size > s.opts.MaxSize
+		tooOld := s.opts.MaxAge > 0 && now.Sub(oldest.modTime) > s.opts.MaxAge
+		if !tooBig && !tooOld {
+			break
+		}
+
+		if len(s.segments) == 1 {
+			_ = s.seal()
+		}
+
+		slog.Warn("Dropping spooled requests",
+			slog.String("dir", s.dir),
+			slog.Int("records", oldest.records),
+			slog.Bool("max_size_exceeded", tooBig),
+			slog.Bool("max_age_exceeded", tooOld),
+		)
+		s.metrics.dropped.Add(uint64(oldest.records))
+		size -= oldest.size
+		s.deleteSegment(0)
+	}
+}
+
+// deleteSegment must be called with the lock held.
+func (s *Spool) deleteSegment(i int) {
+	seq := s.segments[i].seq
+	if err := os.Remove(s.segmentPath(seq)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		slog.Warn("error removing spool segment", "dir", s.dir, "seq", seq, "error", err)
+	}
+	s.segments = slices.Delete(s.segments, i, i+1)
+}
+
+// encodeRecord frames the method name and request data as
+//
+//	len(payload) uint32 | crc32(payload) uint32 | len(method) uvarint | method | data
+func encodeRecord(method string, data []byte) []byte {
+	payload := binary.AppendUvarint(nil, uint64(len(method)))
+	payload = append(payload, method...)
+	payload = append(payload, data...)
+
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+	return append(record, payload...)
+}
+
+func decodeRecord(payload []byte) (string, []byte, error) {
+	n, k := binary.Uvarint(payload)
+	if k <= 0 || uint64(len(payload)-k) < n {
+		return "", nil, errCorruptRecord
+	}
+	method := string(payload[k : k+int(n)])
+	return method, payload[k+int(n):], nil
+}
+
+// readSegment calls fn for each record in the segment at the given path.
+// A truncated or corrupt record at the end of the segment, which is left
+// behind when the process crashes while writing, ends the segment.
+func readSegment(path string, fn func(method string, data []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	header := make([]byte, recordHeaderSize)
+	for {
+		if _, err := io.ReadFull(r, header); errors.Is(err, io.EOF) {
+			return nil
+		} else if errors.Is(err, io.ErrUnexpectedEOF) {
+			slog.Warn("Ignoring truncated spool record", "path", path)
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		size := binary.BigEndian.Uint32(header[0:4])
+		if size > maxRecordSize {
+			slog.Warn("Ignoring corrupt spool record", "path", path)
+			return nil
+		}
+
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(r, payload); errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			slog.Warn("Ignoring truncated spool record", "path", path)
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if crc32.Checksum(payload, crcTable) != binary.BigEndian.Uint32(header[4:8]) {
+			slog.Warn("Ignoring corrupt spool record", "path", path)
+			return nil
+		}
+
+		method, data, err := decodeRecord(payload)
+		if err != nil {
+			slog.Warn("Ignoring corrupt spool record", "path", path)
+			return nil
+		}
+
+		if err := fn(method, data); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package spool
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+const (
+	methodRecordPipelines = "/gitlabexporter.protobuf.service.GitLabExporter/RecordPipelines"
+	methodRecordJobs      = "/gitlabexporter.protobuf.service.GitLabExporter/RecordJobs"
+)
+
+type fakeConn struct {
+	err      error
+	invoked  []string
+	requests []proto.Message
+}
+
+func (c *fakeConn) Invoke(_ context.Context, method string, args any, _ any, _ ...grpc.CallOption) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.invoked = append(c.invoked, method)
+	c.requests = append(c.requests, args.(proto.Message))
+	return nil
+}
+
+func (c *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func TestSpool_ReopenAndReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := Open(dir, Options{SegmentSize: 64})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := []struct {
+		method string
+		req    proto.Message
+	}{
+		{methodRecordPipelines, &servicepb.RecordPipelinesRequest{Data: []*typespb.Pipeline{{Id: 1}}}},
+		{methodRecordJobs, &servicepb.RecordJobsRequest{Data: []*typespb.Job{{Id: 2}}}},
+		{methodRecordPipelines, &servicepb.RecordPipelinesRequest{Data: []*typespb.Pipeline{{Id: 3}}}},
+	}
+	for _, r := range reqs {
+		if err := s.Append(r.method, r.req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = Open(dir, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Len(); got != len(reqs) {
+		t.Fatalf("want %d spooled requests after reopening, got %d", len(reqs), got)
+	}
+
+	// failed replays keep the spooled requests
+	conn := &fakeConn{err: status.Error(codes.Unavailable, "unavailable")}
+	if err := s.replay(context.Background(), conn); err == nil {
+		t.Fatal("expected replay error")
+	}
+	if got := s.Len(); got != len(reqs) {
+		t.Fatalf("want %d spooled requests after failed replay, got %d", len(reqs), got)
+	}
+
+	conn.err = nil
+	if err := s.replay(context.Background(), conn); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Len(); got != 0 {
+		t.Errorf("want empty spool after replay, got %d", got)
+	}
+
+	if len(conn.requests) != len(reqs) {
+		t.Fatalf("want %d replayed requests, got %d", len(reqs), len(conn.requests))
+	}
+	for i, r := range reqs {
+		if conn.invoked[i] != r.method {
+			t.Errorf("request %d: want method %s, got %s", i, r.method, conn.invoked[i])
+		}
+		if !proto.Equal(conn.requests[i], r.req) {
+			t.Errorf("request %d: want %v, got %v", i, r.req, conn.requests[i])
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("want no segment files after replay, got %d", len(entries))
+	}
+}
+
+func TestSpool_Interceptor(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := s.UnaryClientInterceptor()
+
+	var invoked int
+	unavailable := true
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		if unavailable {
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		return nil
+	}
+
+	req := &servicepb.RecordPipelinesRequest{Data: []*typespb.Pipeline{{Id: 1}}}
+
+	// unavailable recorder
+	if err := interceptor(context.Background(), methodRecordPipelines, req, nil, nil, invoker); err != nil {
+		t.Fatalf("expected request to be spooled, got error: %v", err)
+	}
+	if s.Len() != 1 {
+		t.Fatalf("want 1 spooled request, got %d", s.Len())
+	}
+
+	// keep order while the spool is not empty
+	unavailable = false
+	if err := interceptor(context.Background(), methodRecordPipelines, req, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if invoked != 1 {
+		t.Errorf("want recorder to be called once, got %d", invoked)
+	}
+	if s.Len() != 2 {
+		t.Errorf("want 2 spooled requests, got %d", s.Len())
+	}
+
+	// other errors are not spooled
+	invoker = func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return status.Error(codes.InvalidArgument, "invalid")
+	}
+	if err := interceptor(context.Background(), "/grpc.health.v1.Health/Check", req, nil, nil, invoker); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want non-recorder methods to pass through, got %v", err)
+	}
+	if err := interceptor(context.Background(), servicepb.GitLabExporter_GetCapabilities_FullMethodName, &servicepb.GetCapabilitiesRequest{}, nil, nil, invoker); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want capability requests to pass through, got %v", err)
+	}
+}
+
+func TestSpool_Limits(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{MaxSize: 256, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &servicepb.RecordPipelinesRequest{Data: []*typespb.Pipeline{{Id: 1, Name: "a pipeline with a name"}}}
+	for range 20 {
+		if err := s.Append(methodRecordPipelines, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s.mu.Lock()
+	var size int64
+	for _, seg := range s.segments {
+		size += seg.size
+	}
+	s.mu.Unlock()
+	if size > 256 {
+		t.Errorf("want spool size <= 256, got %d", size)
+	}
+	if s.metrics.dropped.Load() == 0 {
+		t.Errorf("expected dropped requests")
+	}
+
+	s.mu.Lock()
+	s.enforceLimits(time.Now().Add(2 * time.Hour))
+	s.mu.Unlock()
+	if s.Len() != 0 {
+		t.Errorf("want expired requests to be dropped, got %d", s.Len())
+	}
+}
+
+func TestSpool_StreamInterceptor(t *testing.T) {
+	s, err := Open(t.TempDir(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := s.StreamClientInterceptor()
+
+	var opened int
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		opened++
+		return nil, nil
+	}
+
+	method := servicepb.GitLabExporter_RecordStream_FullMethodName
+	if _, err := interceptor(context.Background(), nil, nil, method, streamer); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Append(methodRecordPipelines, &servicepb.RecordPipelinesRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := interceptor(context.Background(), nil, nil, method, streamer); status.Code(err) != codes.Unavailable {
+		t.Errorf("want streams to be refused while the spool is not empty, got %v", err)
+	}
+	if opened != 1 {
+		t.Errorf("want 1 opened stream, got %d", opened)
+	}
+}
@@ -49,6 +49,11 @@ type LauncherConfig struct {
 	// ConfigPath is the path to the config file to pass to the subprocess
 	ConfigPath string
 
+	// Settings are the recorder specific settings. If ConfigPath is empty,
+	// they are validated against the schema advertised by the recorder and
+	// written to a temporary config file that is passed to the subprocess.
+	Settings map[string]any
+
 	// SocketPath is the Unix socket path for IPC (auto-generated if empty)
 	SocketPath string
 
@@ -73,6 +78,9 @@ type Launcher struct {
 	restartCount  int
 	running       bool
 
+	// temporary config file holding the recorder settings
+	settingsPath string
+
 	// For process monitoring and clean shutdown
 	stopCh chan struct{} // signals monitor to stop
 	doneCh chan struct{} // signals monitor has stopped
@@ -133,14 +141,21 @@ func (l *Launcher) Start(ctx context.Context) error {
 		return fmt.Errorf("cleanup stale socket: %w", err)
 	}
 
+	// Materialize the recorder settings
+	if err := l.writeSettings(ctx); err != nil {
+		return fmt.Errorf("write recorder settings: %w", err)
+	}
+
 	// Start the subprocess
 	if err := l.startProcess(ctx); err != nil {
+		l.cleanupSettings()
 		return fmt.Errorf("start subprocess: %w", err)
 	}
 
 	// Wait for socket to become available
 	if err := l.waitForSocket(ctx); err != nil {
 		l.killProcess()
+		l.cleanupSettings()
 		return fmt.Errorf("wait for socket: %w", err)
 	}
 
@@ -176,8 +191,9 @@ func (l *Launcher) Stop(_ context.Context) error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	// Clean up socket file
+	// Clean up socket and settings files
 	_ = l.cleanupSocket()
+	l.cleanupSettings()
 
 	l.running = false
 	l.cmd = nil
@@ -192,6 +208,17 @@ func (l *Launcher) IsRunning() bool {
 	return l.running
 }
 
+// CheckHealth returns an error unless the subprocess is running
+func (l *Launcher) CheckHealth() error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if !l.running {
+		return fmt.Errorf("%s recorder subprocess not running (restarts: %d)", l.config.RecorderType, l.restartCount)
+	}
+	return nil
+}
+
 // SocketPath returns the Unix socket path
 func (l *Launcher) SocketPath() string {
 	return l.config.SocketPath
@@ -370,6 +397,8 @@ func (l *Launcher) startProcess(ctx context.Context) error {
 
 	if l.config.ConfigPath != "" {
 		args = append(args, "--config", l.config.ConfigPath)
+	} else if l.settingsPath != "" {
+		args = append(args, "--config", l.settingsPath)
 	}
 
 	args = append(args, l.config.Args...)
@@ -860,3 +860,76 @@ func TestLauncher_RestartPreservesSocket(t *testing.T) {
 		t.Errorf("Stop error: %v", err)
 	}
 }
+
+func TestLauncher_Settings(t *testing.T) {
+	binary := buildTestHelper(t)
+	socketPath := filepath.Join(t.TempDir(), "test.sock")
+
+	launcher, err := NewLauncher(LauncherConfig{
+		RecorderType:  "test",
+		Command:       binary,
+		SocketPath:    socketPath,
+		SocketTimeout: 2 * time.Second,
+		Settings: map[string]any{
+			"path":       "test.db",
+			"batch_size": 100,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewLauncher error: %v", err)
+	}
+
+	ctx := context.Background()
+
+	if err := launcher.Start(ctx); err != nil {
+		t.Fatalf("Start error: %v", err)
+	}
+
+	settingsPath := launcher.settingsPath
+	data, err := os.ReadFile(settingsPath)
+	if err != nil {
+		t.Fatalf("read settings file: %v", err)
+	}
+	if !strings.Contains(string(data), "path: test.db") {
+		t.Errorf("settings file does not contain settings:\n%s", data)
+	}
+
+	if err := launcher.Stop(ctx); err != nil {
+		t.Fatalf("Stop error: %v", err)
+	}
+
+	if _, err := os.Stat(settingsPath); !os.IsNotExist(err) {
+		t.Errorf("settings file should be removed after Stop(), got: %v", err)
+	}
+}
+
+func TestLauncher_InvalidSettings(t *testing.T) {
+	binary := buildTestHelper(t)
+	socketPath := filepath.Join(t.TempDir(), "test.sock")
+
+	launcher, err := NewLauncher(LauncherConfig{
+		RecorderType:  "test",
+		Command:       binary,
+		SocketPath:    socketPath,
+		SocketTimeout: 2 * time.Second,
+		Settings: map[string]any{
+			"path":    "test.db",
+			"unknown": true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewLauncher error: %v", err)
+	}
+
+	err = launcher.Start(context.Background())
+	if err == nil {
+		_ = launcher.Stop(context.Background())
+		t.Fatal("expected Start to fail with invalid settings")
+	}
+	if !strings.Contains(err.Error(), `unknown property "unknown"`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if launcher.IsRunning() {
+		t.Error("IsRunning() should be false after failed Start()")
+	}
+}
@@ -0,0 +1,149 @@
+package subprocess
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Schema is the subset of JSON Schema that recorders use to describe their
+// settings.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+}
+
+// ParseSchema parses a JSON encoded schema.
+func ParseSchema(data []byte) (*Schema, error) {
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("unmarshal schema: %w", err)
+	}
+	return &s, nil
+}
+
+// Validate checks the given value, as decoded from YAML, against the schema.
+func (s *Schema) Validate(value any) error {
+	return errors.Join(s.validate("settings", value)...)
+}
+
+func (s *Schema) validate(path string, value any) []error {
+	if s == nil {
+		return nil
+	}
+
+	var errs []error
+	if s.Type != "" && !hasType(value, s.Type) {
+		return []error{fmt.Errorf("%s: expected %s, got %T", path, s.Type, value)}
+	}
+
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return equal(e, value) }) {
+		errs = append(errs, fmt.Errorf("%s: value %v is not one of %v", path, value, s.Enum))
+	}
+
+	if n, ok := toFloat(value); ok {
+		if s.Minimum != nil && n < *s.Minimum {
+			errs = append(errs, fmt.Errorf("%s: value %v is less than %v", path, value, *s.Minimum))
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			errs = append(errs, fmt.Errorf("%s: value %v is greater than %v", path, value, *s.Maximum))
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				errs = append(errs, fmt.Errorf("%s: missing required property %q", path, name))
+			}
+		}
+
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			prop, ok := s.Properties[k]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					errs = append(errs, fmt.Errorf("%s: unknown property %q", path, k))
+				}
+				continue
+			}
+			errs = append(errs, prop.validate(path+"."+k, v[k])...)
+		}
+	case []any:
+		for i, item := range v {
+			errs = append(errs, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+		}
+	}
+
+	return errs
+}
+
+func hasType(value any, typ string) bool {
+	switch strings.ToLower(typ) {
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "integer":
+		n, ok := toFloat(value)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	case "null":
+		return value == nil
+	default:
+		return true
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func equal(a, b any) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	switch b.(type) {
+	case map[string]any, []any:
+		return false
+	}
+	return a == b
+}
@@ -0,0 +1,77 @@
+package subprocess
+
+import (
+	"testing"
+)
+
+func TestSchema_Validate(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{
+		"type": "object",
+		"properties": {
+			"path": {"type": "string"},
+			"wal_mode": {"type": "boolean"},
+			"batch_size": {"type": "integer", "minimum": 1},
+			"mode": {"type": "string", "enum": ["fast", "safe"]},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		},
+		"required": ["path"],
+		"additionalProperties": false
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		settings map[string]any
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			settings: map[string]any{"path": "test.db", "wal_mode": true, "batch_size": 10, "mode": "safe", "tags": []any{"a"}},
+		},
+		{
+			name:     "integral float",
+			settings: map[string]any{"path": "test.db", "batch_size": 10.0},
+		},
+		{
+			name:     "missing required",
+			settings: map[string]any{"wal_mode": true},
+			wantErr:  true,
+		},
+		{
+			name:     "unknown property",
+			settings: map[string]any{"path": "test.db", "foo": "bar"},
+			wantErr:  true,
+		},
+		{
+			name:     "wrong type",
+			settings: map[string]any{"path": 42},
+			wantErr:  true,
+		},
+		{
+			name:     "below minimum",
+			settings: map[string]any{"path": "test.db", "batch_size": 0},
+			wantErr:  true,
+		},
+		{
+			name:     "not in enum",
+			settings: map[string]any{"path": "test.db", "mode": "other"},
+			wantErr:  true,
+		},
+		{
+			name:     "wrong item type",
+			settings: map[string]any{"path": "test.db", "tags": []any{1}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := schema.Validate(tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package subprocess
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// SchemaFlag is the flag that makes a recorder binary print the JSON
+	// schema of its settings to stdout and exit.
+	SchemaFlag = "--config-schema"
+
+	// schemaTimeout is how long to wait for the recorder to print its schema
+	schemaTimeout = 5 * time.Second
+)
+
+// writeSettings validates the recorder settings and writes them to a
+// temporary config file. It must be called with the lock held.
+func (l *Launcher) writeSettings(ctx context.Context) error {
+	if l.config.ConfigPath != "" || l.config.Settings == nil {
+		return nil
+	}
+
+	schema, err := l.fetchSchema(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch settings schema: %w", err)
+	}
+	if schema != nil {
+		if err := schema.Validate(l.config.Settings); err != nil {
+			return fmt.Errorf("invalid %s recorder settings: %w", l.config.RecorderType, err)
+		}
+	}
+
+	data, err := yaml.Marshal(l.config.Settings)
+	if err != nil {
+		return fmt.Errorf("marshal settings: %w", err)
+	}
+
+	// settings may contain credentials, so the file is only readable by us
+	f, err := os.CreateTemp("", fmt.Sprintf("gitlab-exporter-%s-*.yaml", l.config.RecorderType))
+	if err != nil {
+		return fmt.Errorf("create settings file: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("write settings file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return fmt.Errorf("close settings file: %w", err)
+	}
+
+	l.settingsPath = f.Name()
+	return nil
+}
+
+// fetchSchema asks the recorder binary for the schema of its settings.
+// It returns nil if the recorder does not advertise one.
+func (l *Launcher) fetchSchema(ctx context.Context) (*Schema, error) {
+	ctx, cancel := context.WithTimeout(ctx, schemaTimeout)
+	defer cancel()
+
+	var stdout bytes.Buffer
+	cmd := exec.CommandContext(ctx, l.config.Command, SchemaFlag)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		slog.Warn("Recorder does not advertise a settings schema, skipping validation",
+			"recorder", l.config.RecorderType,
+			"error", err,
+		)
+		return nil, nil
+	}
+
+	if len(bytes.TrimSpace(stdout.Bytes())) == 0 {
+		return nil, nil
+	}
+
+	return ParseSchema(stdout.Bytes())
+}
+
+// cleanupSettings removes the temporary settings file, if any.
+func (l *Launcher) cleanupSettings() {
+	if l.settingsPath == "" {
+		return
+	}
+	if err := os.Remove(l.settingsPath); err != nil && !os.IsNotExist(err) {
+		slog.Warn("error removing recorder settings file", "path", l.settingsPath, "error", err)
+	}
+	l.settingsPath = ""
+}
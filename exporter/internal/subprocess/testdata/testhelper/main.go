@@ -4,6 +4,7 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -15,12 +16,25 @@ import (
 func main() {
 	address := flag.String("address", "", "Unix socket address (unix:///path/to/socket)")
 	_ = flag.String("config", "", "Config file path (accepted but ignored)")
+	configSchema := flag.Bool("config-schema", false, "Print the settings schema and exit")
 	delay := flag.Duration("delay", 0, "Delay before creating socket")
 	exitEarly := flag.Bool("exit-early", false, "Exit immediately before creating socket")
 	exitAfter := flag.Duration("exit-after", 0, "Exit after this duration (simulates crash)")
 	ignoreSignals := flag.Bool("ignore-signals", false, "Ignore SIGTERM (for force kill testing)")
 	flag.Parse()
 
+	if *configSchema {
+		fmt.Println(`{
+  "type": "object",
+  "properties": {
+    "path": {"type": "string"},
+    "batch_size": {"type": "integer", "minimum": 1}
+  },
+  "additionalProperties": false
+}`)
+		return
+	}
+
 	if *exitEarly {
 		os.Exit(1)
 	}
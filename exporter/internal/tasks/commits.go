@@ -0,0 +1,181 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"path"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+// ProjectCommits holds the commits fetched of a project and the heads of its
+// branches that they were fetched up to.
+type ProjectCommits struct {
+	Commits []types.Commit
+	Heads   []checkpoint.Head
+}
+
+// FetchProjectsCommits fetches the commits of the given projects concurrently
+// and yields the commits of each project as soon as they are available. The
+// protected branches whose commits are fetched in addition to those of the
+// default branch are given as patterns per project id. See
+// FetchProjectCommits for how lastHead is used.
+func FetchProjectsCommits(ctx context.Context, glab *gitlab.Client, projects []types.Project, protectedBranches map[int64][]string, lastHead func(projectId int64, ref string) (string, bool), committedAfter *time.Time, committedBefore *time.Time) iter.Seq2[ProjectCommits, error] {
+	return fetchEach(ctx, glab, projects, func(ctx context.Context, p types.Project) (ProjectCommits, error) {
+		var projectLastHead func(ref string) (string, bool)
+		if lastHead != nil {
+			projectLastHead = func(ref string) (string, bool) {
+				return lastHead(p.Id, ref)
+			}
+		}
+		return FetchProjectCommits(ctx, glab, p, protectedBranches[p.Id], projectLastHead, committedAfter, committedBefore)
+	})
+}
+
+// FetchProjectCommits fetches the commits of the default branch of a project
+// and of its protected branches that match any of the given patterns. Commits
+// that are on several branches are returned once, preferably for the default
+// branch.
+//
+// If lastHead is nil, the commits that were committed in the given time range
+// are fetched. Otherwise the commits of each branch are fetched from the last
+// head returned by lastHead up to the current head, so that commits are not
+// missed if they were pushed or merged long after they were made. Branches
+// without a last head fall back to the commits committed after
+// committedAfter. The current heads are returned with the commits.
+func FetchProjectCommits(ctx context.Context, glab *gitlab.Client, project types.Project, protectedBranches []string, lastHead func(ref string) (string, bool), committedAfter *time.Time, committedBefore *time.Time) (ProjectCommits, error) {
+	refs, err := commitRefs(ctx, glab, project, protectedBranches)
+	if err != nil {
+		return ProjectCommits{}, err
+	}
+
+	var (
+		result ProjectCommits
+		seen   = make(map[string]bool)
+	)
+	for _, ref := range refs {
+		var cs []types.Commit
+		if lastHead == nil {
+			opt := rest.GetProjectCommitsOptions{
+				RefName: ref,
+				Since:   committedAfter,
+				Until:   committedBefore,
+			}
+			cs, err = fetchBranchCommits(ctx, glab, project, ref, opt)
+		} else {
+			var head string
+			cs, head, err = fetchBranchCommitsSinceHead(ctx, glab, project, ref, lastHead, committedAfter)
+			if err == nil && head != "" {
+				result.Heads = append(result.Heads, checkpoint.Head{
+					ProjectId: project.Id,
+					Ref:       ref,
+					SHA:       head,
+				})
+			}
+		}
+		if err != nil {
+			return ProjectCommits{}, fmt.Errorf("get project %d commits of %s: %w", project.Id, ref, err)
+		}
+
+		for _, c := range cs {
+			if seen[c.Sha] {
+				continue
+			}
+			seen[c.Sha] = true
+
+			result.Commits = append(result.Commits, c)
+		}
+	}
+
+	return result, nil
+}
+
+// fetchBranchCommitsSinceHead returns the commits of a branch from its last
+// head up to its current head, which is returned too. Without a last head, or
+// if it no longer exists, the commits committed after committedAfter are
+// returned.
+func fetchBranchCommitsSinceHead(ctx context.Context, glab *gitlab.Client, project types.Project, ref string, lastHead func(ref string) (string, bool), committedAfter *time.Time) ([]types.Commit, string, error) {
+	head, err := glab.Rest.GetProjectBranchHead(ctx, project.Id, ref)
+	if err != nil {
+		return nil, "", fmt.Errorf("get head: %w", err)
+	}
+	if head == "" {
+		return nil, "", nil
+	}
+
+	last, ok := lastHead(ref)
+	if ok && last == head {
+		return nil, head, nil
+	}
+	if ok {
+		opt := rest.GetProjectCommitsOptions{
+			RefName: last + ".." + head,
+		}
+		cs, err := fetchBranchCommits(ctx, glab, project, ref, opt)
+		if !errors.Is(err, gitlab.ErrNotFound) { // e.g. removed by a force push
+			return cs, head, err
+		}
+	}
+
+	opt := rest.GetProjectCommitsOptions{
+		RefName: head,
+		Since:   committedAfter,
+	}
+	cs, err := fetchBranchCommits(ctx, glab, project, ref, opt)
+	return cs, head, err
+}
+
+func fetchBranchCommits(ctx context.Context, glab *gitlab.Client, project types.Project, ref string, opt rest.GetProjectCommitsOptions) ([]types.Commit, error) {
+	cs, err := glab.Rest.GetProjectCommits(ctx, project.Id, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]types.Commit, 0, len(cs))
+	for _, c := range cs {
+		commit := rest.ConvertCommit(project.Id, ref, c)
+		commit.Project.FullPath = project.FullPath
+		commits = append(commits, commit)
+	}
+	return commits, nil
+}
+
+// commitRefs returns the branches whose commits are fetched, starting with
+// the default branch.
+func commitRefs(ctx context.Context, glab *gitlab.Client, project types.Project, protectedBranches []string) ([]string, error) {
+	var refs []string
+	if project.DefaultBranch != "" { // empty repositories have no default branch
+		refs = append(refs, project.DefaultBranch)
+	}
+	if len(protectedBranches) == 0 {
+		return refs, nil
+	}
+
+	branches, err := glab.Rest.GetProjectProtectedBranches(ctx, project.Id)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d protected branches: %w", project.Id, err)
+	}
+	for _, branch := range branches {
+		if branch == project.DefaultBranch {
+			continue
+		}
+		for _, pattern := range protectedBranches {
+			ok, err := path.Match(pattern, branch)
+			if err != nil {
+				return nil, fmt.Errorf("match protected branch pattern %q: %w", pattern, err)
+			}
+			if ok {
+				refs = append(refs, branch)
+				break
+			}
+		}
+	}
+
+	return refs, nil
+}
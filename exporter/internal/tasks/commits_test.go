@@ -0,0 +1,91 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestFetchProjectCommits_SinceLastHead(t *testing.T) {
+	committedAfter := time.Date(2025, 1, 2, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		lastHead  string
+		wantRef   string
+		wantSince bool
+		wantCalls int
+	}{
+		{"without last head", "", "c3", true, 1},
+		{"with last head", "c1", "c1..c3", false, 1},
+		{"unchanged head", "c3", "", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.EscapedPath() {
+				case "/api/v4/projects/1/repository/branches/main":
+					_ = json.NewEncoder(w).Encode(map[string]any{
+						"name":   "main",
+						"commit": map[string]any{"id": "c3"},
+					})
+				case "/api/v4/projects/1/repository/commits":
+					calls++
+					q := r.URL.Query()
+					if got := q.Get("ref_name"); got != tt.wantRef {
+						t.Errorf("want ref_name %q, got %q", tt.wantRef, got)
+					}
+					if got := q.Has("since"); got != tt.wantSince {
+						t.Errorf("want since set %v, got %v", tt.wantSince, got)
+					}
+					// a commit made before the window and pushed after it
+					_ = json.NewEncoder(w).Encode([]map[string]any{
+						{"id": "c3", "committed_date": "2025-01-02T14:03:00Z"},
+						{"id": "c2", "committed_date": "2025-01-02T10:00:00Z"},
+					})
+				default:
+					t.Errorf("unexpected request: %s", r.URL)
+					http.NotFound(w, r)
+				}
+			}))
+			defer srv.Close()
+
+			client, err := gitlab.NewGitLabClient(gitlab.ClientConfig{
+				URL: srv.URL,
+			})
+			if err != nil {
+				t.Fatalf("failed to create client: %v", err)
+			}
+
+			project := types.Project{Id: 1, DefaultBranch: "main"}
+			lastHead := func(ref string) (string, bool) {
+				return tt.lastHead, tt.lastHead != ""
+			}
+
+			got, err := FetchProjectCommits(context.Background(), client, project, nil, lastHead, &committedAfter, nil)
+			if err != nil {
+				t.Fatalf("FetchProjectCommits failed: %v", err)
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("want %d commit requests, got %d", tt.wantCalls, calls)
+			}
+			if wantCommits := 2 * tt.wantCalls; len(got.Commits) != wantCommits {
+				t.Errorf("want %d commits, got %d", wantCommits, len(got.Commits))
+			}
+			wantHeads := []checkpoint.Head{{ProjectId: 1, Ref: "main", SHA: "c3"}}
+			if len(got.Heads) != 1 || got.Heads[0] != wantHeads[0] {
+				t.Errorf("want heads %v, got %v", wantHeads, got.Heads)
+			}
+		})
+	}
+}
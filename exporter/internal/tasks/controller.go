@@ -7,15 +7,20 @@ import (
 	"log/slog"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter/messages"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/failure"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/graphql"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/logql"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/metaerr"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
 )
 
@@ -77,7 +82,7 @@ func (ps *ProjectsSettings) Delete(id int64) bool {
 	return true
 }
 
-func (ps *ProjectsSettings) GetBatches(size int, filter func(ProjectSettings) bool) [][]ProjectSettings {
+func (ps *ProjectsSettings) List(filter func(ProjectSettings) bool) []ProjectSettings {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
 
@@ -89,13 +94,21 @@ func (ps *ProjectsSettings) GetBatches(size int, filter func(ProjectSettings) bo
 		settings = append(settings, v)
 	}
 
-	var batches [][]ProjectSettings
-	for i := 0; i < len(settings); i += size {
+	return settings
+}
+
+func (ps *ProjectsSettings) GetBatches(size int, filter func(ProjectSettings) bool) [][]ProjectSettings {
+	return makeBatches(ps.List(filter), size)
+}
+
+func makeBatches[T any](items []T, size int) [][]T {
+	var batches [][]T
+	for i := 0; i < len(items); i += size {
 		j := i + size
-		if j > len(settings) {
-			j = len(settings)
+		if j > len(items) {
+			j = len(items)
 		}
-		batches = append(batches, settings[i:j])
+		batches = append(batches, items[i:j])
 	}
 
 	return batches
@@ -117,6 +130,62 @@ func (ps *ProjectsSettings) ExportDeployments(id int64) bool {
 	return cfg.Export.Deployments.Enabled
 }
 
+func (ps *ProjectsSettings) ExportCommits(id int64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return false
+	}
+	return cfg.Export.Commits.Enabled
+}
+
+// CommitsProtectedBranches returns the patterns of the protected branches
+// whose commits are exported in addition to those of the default branch.
+func (ps *ProjectsSettings) CommitsProtectedBranches(id int64) []string {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return nil
+	}
+	return cfg.Export.Commits.ProtectedBranches
+}
+
+func (ps *ProjectsSettings) ExportReleases(id int64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return false
+	}
+
+	if cfg.AccessLevels.Releases == ProjectAccessLevelDisabled {
+		return false
+	}
+
+	return cfg.Export.Releases.Enabled
+}
+
+func (ps *ProjectsSettings) ExportEnvironments(id int64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return false
+	}
+
+	if cfg.AccessLevels.Environment == ProjectAccessLevelDisabled {
+		return false
+	}
+
+	return cfg.Export.Environments.Enabled
+}
+
 func (ps *ProjectsSettings) ExportIssues(id int64) bool {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
@@ -144,6 +213,17 @@ func (ps *ProjectsSettings) ExportTestReports(id int64) bool {
 	return cfg.Export.TestReports.Enabled
 }
 
+func (ps *ProjectsSettings) ExportJobArtifacts(id int64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return false
+	}
+	return cfg.Export.Jobs.Artifacts.Enabled
+}
+
 func (ps *ProjectsSettings) ExportLogData(id int64) bool {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
@@ -177,6 +257,17 @@ func (ps *ProjectsSettings) ExportMergeRequests(id int64) bool {
 	return cfg.Export.MergeRequests.Enabled
 }
 
+func (ps *ProjectsSettings) ExportMergeRequestEvents(id int64) bool {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	cfg, ok := ps.settings[id]
+	if !ok {
+		return false
+	}
+	return cfg.Export.MergeRequests.Enabled && cfg.Export.MergeRequests.Events
+}
+
 func (ps *ProjectsSettings) ExportJunitReports(id int64) bool {
 	ps.mu.RLock()
 	defer ps.mu.RUnlock()
@@ -196,7 +287,8 @@ type ProjectSettings struct {
 	Export struct {
 		config.ProjectExport
 
-		logQLQueries []logql.MetricQuery
+		logQLQueries      []logql.MetricQuery
+		failureClassifier *failure.Classifier
 	}
 
 	CatchUp struct {
@@ -212,6 +304,8 @@ type ProjectAccessLevels struct {
 	Builds      ProjectAccessLevel
 	Environment ProjectAccessLevel
 	Issues      ProjectAccessLevel
+	Releases    ProjectAccessLevel
+	Repository  ProjectAccessLevel
 }
 
 type ProjectAccessLevel string
@@ -231,121 +325,213 @@ type ControllerConfig struct {
 
 	Export config.Export
 
-	ResolveInterval time.Duration
-	ExportInterval  time.Duration
+	Schedule        Schedule
 	CatchUpInterval time.Duration
+
+	// Checkpoints is used to persist the export progress of each project.
+	// If nil, every run starts exporting from the time it was started.
+	Checkpoints checkpoint.Store
 }
 
 type Controller struct {
 	GitLab   *gitlab.Client
 	Exporter *exporter.Exporter
 
-	config ControllerConfig
+	config      ControllerConfig
+	configMutex sync.RWMutex
+	// signals the export loop that the configuration was reloaded
+	reloaded chan struct{}
+	// limits the size of the data being exported, guarded by configMutex
+	budget *exporter.Budget
 
 	projectsSettings      ProjectsSettings
 	projectsSettingsMutex sync.RWMutex
+
+	// time the export loop last completed an iteration, in unix nanoseconds
+	loopCompletedAt atomic.Int64
+
+	// point in time up to which each kind of data was exported
+	exported      map[string]time.Time
+	exportedMutex sync.Mutex
+
+	// last exported branch heads if no checkpoint store is configured
+	heads *checkpoint.MemoryStore
+	// pipelines that exported releases were linked to
+	releasePipelines *releasePipelines
+
+	metrics *metrics
 }
 
 func NewController(glab *gitlab.Client, exp *exporter.Exporter, cfg ControllerConfig) *Controller {
-	if cfg.ResolveInterval == 0 {
-		cfg.ResolveInterval = 30 * time.Minute
-	}
-	if cfg.ExportInterval == 0 {
-		cfg.ExportInterval = 5 * time.Minute
-	}
-	if cfg.CatchUpInterval == 0 {
-		cfg.CatchUpInterval = 24 * time.Hour
-	}
+	setDefaults(&cfg)
 
 	return &Controller{
 		GitLab:   glab,
 		Exporter: exp,
 
-		config: cfg,
+		config:   cfg,
+		reloaded: make(chan struct{}, 1),
+		budget:   exporter.NewBudget(cfg.Export.MemoryBudget),
+		exported: make(map[string]time.Time),
+		heads:    checkpoint.NewMemoryStore(),
+
+		releasePipelines: newReleasePipelines(),
 		projectsSettings: ProjectsSettings{
 			settings: make(map[int64]ProjectSettings),
 		},
+		metrics: newMetrics(glab),
 	}
 }
 
-func (c *Controller) Run(ctx context.Context) error {
-	var (
-		resolveTicker *time.Ticker = time.NewTicker(c.config.ResolveInterval)
-		exportTicker  *time.Ticker = time.NewTicker(c.config.ExportInterval)
+func setDefaults(cfg *ControllerConfig) {
+	if cfg.Schedule.Resolve == nil {
+		cfg.Schedule.Resolve = schedule.Every(30 * time.Minute)
+	}
+	if cfg.Schedule.Export == nil {
+		cfg.Schedule.Export = schedule.Every(5 * time.Minute)
+	}
+	if cfg.CatchUpInterval == 0 {
+		cfg.CatchUpInterval = 24 * time.Hour
+	}
+}
 
-		after  time.Time
-		before time.Time = time.Now().UTC()
-	)
+func (c *Controller) currentConfig() ControllerConfig {
+	c.configMutex.RLock()
+	defer c.configMutex.RUnlock()
+	return c.config
+}
+
+func (c *Controller) Run(ctx context.Context) error {
+	startedAt := time.Now().UTC()
+	c.loopCompletedAt.Store(startedAt.UnixNano())
 
-	var iteration int
 	for {
+		cfg := c.currentConfig()
+
+		// closed to stop the scheduled tasks when the configuration is reloaded
+		stop := make(chan struct{})
+		var wg sync.WaitGroup
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runScheduled(ctx, stop, cfg.Schedule.Resolve, cfg.Schedule.Jitter, func(time.Time) {
+				slog.Debug("[RUN] Resolving projects...")
+				n, err := c.ResolveProjects(ctx)
+				if err != nil {
+					slog.Error("[RUN] error resolving projects", "error", err)
+					return
+				}
+				slog.Debug("[RUN] Resolving projects... done", "found", n)
+			})
+		}()
+
+		for _, j := range cfg.Schedule.jobs(cfg.Export.Runners.Enabled) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				var iteration int
+				runScheduled(ctx, stop, j.schedule, cfg.Schedule.Jitter, func(now time.Time) {
+					iteration++
+					c.runJob(ctx, j, iteration, startedAt, now)
+				})
+			}()
+		}
+
 		select {
 		case <-ctx.Done():
+			wg.Wait()
 			return ctx.Err()
-		case <-resolveTicker.C:
-			slog.Debug("[RUN] Resolving projects...")
-			n, err := c.ResolveProjects(ctx)
-			if err != nil {
-				slog.Error("[RUN] error resolving projects", "error", err)
-				continue
-			}
-			slog.Debug("[RUN] Resolving projects... done", "found", n)
-		case now := <-exportTicker.C:
-			iteration++
-			firstIteration := (iteration == 1)
-
-			after = before
-			before = now
-
-			slog.Debug("[RUN] Processing projects...", "iteration", iteration, "after", after.Format(time.RFC3339), "before", before.Format(time.RFC3339))
-
-			var wg sync.WaitGroup
-
-			// fetch and export project related data
-			projectBatches := c.projectsSettings.GetBatches(maxRecordsPerPage, nil)
-			for i, batch := range projectBatches {
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-
-					if err := c.process(ctx, batch, &after, &before, firstIteration); err != nil {
-						slog.
-							With(
-								slog.String("error", err.Error()),
-								slog.Int("iteration", iteration),
-								slog.String("after", after.Format(time.RFC3339)), slog.String("before", before.Format(time.RFC3339)),
-								slog.Int("batchIndex", i), slog.Int("batchCount", len(projectBatches)),
-							).
-							With(metaerr.GetMetadata(err)...).
-							Error("[RUN] error processing projects")
-					}
-				}()
-			}
+		case <-c.reloaded:
+			// let running tasks finish before applying the new schedules
+			close(stop)
+			wg.Wait()
+		}
+	}
+}
 
-			// fetch and export runners data
-			if c.config.Export.Runners.Enabled {
-				wg.Add(1)
-				go func() {
-					defer wg.Done()
-
-					if err := c.processRunners(ctx); err != nil {
-						slog.
-							With(
-								slog.String("error", err.Error()),
-								slog.Int("iteration", iteration),
-							).
-							With(metaerr.GetMetadata(err)...).
-							Error("[RUN] error processing runners")
-					}
-				}()
-			}
+// CheckLiveness returns an error if the export loop has not completed an
+// iteration for more than the given number of periods of the export
+// schedule. It never fails before the loop was started or if
+// maxStalledIntervals is not positive.
+func (c *Controller) CheckLiveness(maxStalledIntervals int) error {
+	completedAt := c.loopCompletedAt.Load()
+	if maxStalledIntervals <= 0 || completedAt == 0 {
+		return nil
+	}
 
-			// wait for tasks processing to finish
-			wg.Wait()
+	stalled := time.Since(time.Unix(0, completedAt))
+	period := schedule.Period(c.currentConfig().Schedule.Export, time.Now())
+	if stalled > time.Duration(maxStalledIntervals)*period {
+		return fmt.Errorf("export loop stalled for %s", stalled.Round(time.Second))
+	}
+	return nil
+}
+
+// groupByCheckpoint groups the configured projects by the point in time from
+// which their export of the given kinds of data should resume. Projects
+// without a checkpoint resume from the given default.
+func (c *Controller) groupByCheckpoint(defaultAfter time.Time, kinds []checkpoint.Kind) map[time.Time][]ProjectSettings {
+	groups := make(map[time.Time][]ProjectSettings)
+	for _, ps := range c.projectsSettings.List(nil) {
+		after := c.resumeAfter(ps.Id, defaultAfter, kinds)
+		groups[after] = append(groups[after], ps)
+	}
+	return groups
+}
+
+// resumeAfter returns the earliest checkpoint over the given kinds of data
+// for the given project, so that no kind misses any updates.
+func (c *Controller) resumeAfter(projectId int64, defaultAfter time.Time, kinds []checkpoint.Kind) time.Time {
+	checkpoints := c.currentConfig().Checkpoints
+	if checkpoints == nil {
+		return defaultAfter
+	}
+
+	after := defaultAfter
+	for _, kind := range kinds {
+		if t, ok := checkpoints.Get(projectId, kind); ok && t.Before(after) {
+			after = t
+		}
+	}
+	return after
+}
+
+// advanceCheckpoints moves the checkpoints of the given projects to
+// updatedBefore for every given kind of data that was exported without errors.
+func (c *Controller) advanceCheckpoints(projects []ProjectSettings, kinds []checkpoint.Kind, kindErrs processErrors, updatedBefore time.Time) {
+	store := c.currentConfig().Checkpoints
+	if store == nil || kindErrs == nil {
+		return
+	}
 
-			slog.Debug("[RUN] Processing projects... done", "iteration", iteration)
+	var checkpoints []checkpoint.Checkpoint
+	for _, kind := range kinds {
+		if kindErrs[kind] != nil {
+			continue
+		}
+		for _, ps := range projects {
+			checkpoints = append(checkpoints, checkpoint.Checkpoint{
+				ProjectId:     ps.Id,
+				Kind:          kind,
+				UpdatedBefore: updatedBefore,
+			})
 		}
 	}
+
+	if err := store.Set(checkpoints...); err != nil {
+		slog.Error("[RUN] error storing checkpoints", "error", err)
+	}
+}
+
+// headsStore returns the store of the last exported branch heads, which are
+// kept in memory only if no checkpoint store is configured.
+func (c *Controller) headsStore() checkpoint.Store {
+	if store := c.currentConfig().Checkpoints; store != nil {
+		return store
+	}
+	return c.heads
 }
 
 func (c *Controller) CatchUp(ctx context.Context) error {
@@ -392,7 +578,10 @@ func (c *Controller) CatchUp(ctx context.Context) error {
 			go func() {
 				defer wg.Done()
 
-				if err := c.process(ctx, batch, &after, &before, firstIteration); err != nil {
+				kinds := allExportKinds()
+				kinds.inRange = true
+
+				if _, err := c.process(ctx, batch, kinds, &after, &before, firstIteration); err != nil {
 					slog.
 						With(
 							slog.String("error", err.Error()),
@@ -413,64 +602,149 @@ func (c *Controller) CatchUp(ctx context.Context) error {
 	return nil
 }
 
-func (c *Controller) process(ctx context.Context, projectSettings []ProjectSettings, updatedAfter *time.Time, updatedBefore *time.Time, firstIteration bool) error {
+// processErrors holds the error that occurred while processing each kind of
+// data, if any. Errors of the project data itself are held as kindProjects.
+type processErrors map[checkpoint.Kind]error
+
+// kindProjects identifies the project data, which is not checkpointed.
+const kindProjects checkpoint.Kind = "projects"
+
+func (c *Controller) process(ctx context.Context, projectSettings []ProjectSettings, kinds exportKinds, updatedAfter *time.Time, updatedBefore *time.Time, firstIteration bool) (processErrors, error) {
 	result, err := c.getUpdatedProjects(ctx, projectSettings, updatedAfter, updatedBefore, firstIteration)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	// export the whole batch over a single stream per recorder
+	var (
+		kindErrs processErrors
+		errs     error
+	)
+	if err := c.Exporter.Stream(ctx, func(ctx context.Context) error {
+		kindErrs, errs = c.processUpdated(ctx, result, kinds, updatedAfter, updatedBefore)
+		return nil
+	}); err != nil {
+		if kindErrs == nil {
+			kindErrs = make(processErrors)
+		}
+		// any kind of data may have been lost when a stream failed
+		for _, kind := range kinds.kinds {
+			if kindErrs[kind] == nil {
+				kindErrs[kind] = err
+			}
+		}
+		if kinds.projects && kindErrs[kindProjects] == nil {
+			kindErrs[kindProjects] = err
+		}
+		errs = errors.Join(errs, fmt.Errorf("record stream: %w", err))
+	}
+
+	return kindErrs, errs
+}
+
+func (c *Controller) processUpdated(ctx context.Context, result getUpdatedProjectsResult, kinds exportKinds, updatedAfter *time.Time, updatedBefore *time.Time) (processErrors, error) {
+	type kindError struct {
+		kind checkpoint.Kind
+		err  error
 	}
 
 	var wg sync.WaitGroup
-	errChan := make(chan error)
+	errChan := make(chan kindError)
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	if kinds.projects {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration("projects", time.Now())
 
-		if err := c.processProjects(ctx, result.UpdatedProjects); err != nil {
-			errChan <- fmt.Errorf("process projects: %w", err)
-		}
-	}()
+			if err := c.processProjects(ctx, result.UpdatedProjects); err != nil {
+				errChan <- kindError{kindProjects, fmt.Errorf("process projects: %w", err)}
+			}
+		}()
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	if kinds.has(checkpoint.KindPipelines) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindPipelines), time.Now())
 
-		if err := c.processPipelines(ctx, result.ProjectsWithUpdatedPipelines, updatedAfter, updatedBefore); err != nil {
-			errChan <- fmt.Errorf("process pipelines: %w", err)
-		}
-	}()
+			if err := c.processPipelines(ctx, result.ProjectsWithUpdatedPipelines, updatedAfter, updatedBefore); err != nil {
+				errChan <- kindError{checkpoint.KindPipelines, fmt.Errorf("process pipelines: %w", err)}
+			}
+		}()
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	if kinds.has(checkpoint.KindMergeRequests) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindMergeRequests), time.Now())
 
-		if err := c.processProjectMergeRequests(ctx, result.ProjectsWithUpdatedMergeRequests, updatedAfter, updatedBefore); err != nil {
-			errChan <- fmt.Errorf("process merge requests: %w", err)
-		}
-	}()
+			if err := c.processProjectMergeRequests(ctx, result.ProjectsWithUpdatedMergeRequests, updatedAfter, updatedBefore); err != nil {
+				errChan <- kindError{checkpoint.KindMergeRequests, fmt.Errorf("process merge requests: %w", err)}
+			}
+		}()
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	if kinds.has(checkpoint.KindIssues) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindIssues), time.Now())
 
-		projectIds := make([]int64, 0, len(result.UpdatedProjects))
-		for _, p := range result.UpdatedProjects {
-			projectIds = append(projectIds, p.Id)
-		}
+			projectIds := make([]int64, 0, len(result.UpdatedProjects))
+			for _, p := range result.UpdatedProjects {
+				projectIds = append(projectIds, p.Id)
+			}
 
-		if err := c.processProjectsIssues(ctx, projectIds, updatedAfter, updatedBefore); err != nil {
-			errChan <- fmt.Errorf("process issues: %w", err)
-		}
-	}()
+			if err := c.processProjectsIssues(ctx, projectIds, updatedAfter, updatedBefore); err != nil {
+				errChan <- kindError{checkpoint.KindIssues, fmt.Errorf("process issues: %w", err)}
+			}
+		}()
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
+	if kinds.has(checkpoint.KindDeployments) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindDeployments), time.Now())
 
-		if err := c.processProjectsDeployments(ctx, result.ProjectsWithUpdatedPipelines, updatedAfter, updatedBefore); err != nil {
-			errChan <- fmt.Errorf("process deployments: %w", err)
-		}
-	}()
+			if err := c.processProjectsDeployments(ctx, result.ProjectsWithUpdatedPipelines, updatedAfter, updatedBefore); err != nil {
+				errChan <- kindError{checkpoint.KindDeployments, fmt.Errorf("process deployments: %w", err)}
+			}
+		}()
+	}
+
+	if kinds.has(checkpoint.KindCommits) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindCommits), time.Now())
+
+			if err := c.processProjectsCommits(ctx, result.Projects, updatedAfter, updatedBefore, kinds.inRange); err != nil {
+				errChan <- kindError{checkpoint.KindCommits, fmt.Errorf("process commits: %w", err)}
+			}
+		}()
+	}
+
+	if kinds.has(checkpoint.KindReleases) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer c.metrics.observeDuration(string(checkpoint.KindReleases), time.Now())
+
+			// creating a release does not mark its project as updated
+			projectIds := make([]int64, 0, len(result.Projects))
+			for _, p := range result.Projects {
+				projectIds = append(projectIds, p.Id)
+			}
+
+			if err := c.processProjectsReleases(ctx, projectIds, updatedAfter, updatedBefore); err != nil {
+				errChan <- kindError{checkpoint.KindReleases, fmt.Errorf("process releases: %w", err)}
+			}
+		}()
+	}
 
 	done := make(chan struct{})
 	go func() {
@@ -479,20 +753,25 @@ func (c *Controller) process(ctx context.Context, projectSettings []ProjectSetti
 	}()
 
 	var errs error
+	kindErrs := make(processErrors)
 loop:
 	for {
 		select {
 		case <-done:
 			break loop
-		case err := <-errChan:
-			errs = errors.Join(errs, err)
+		case ke := <-errChan:
+			errs = errors.Join(errs, ke.err)
+			kindErrs[ke.kind] = ke.err
 		}
 	}
 
-	return errs
+	return kindErrs, errs
 }
 
 type getUpdatedProjectsResult struct {
+	// Projects holds all projects, as pushes to a repository do not
+	// necessarily mark its project as updated.
+	Projects                         []types.Project
 	UpdatedProjects                  []types.Project
 	ProjectsWithUpdatedPipelines     []int64
 	ProjectsWithUpdatedMergeRequests []int64
@@ -518,6 +797,8 @@ func (c *Controller) getUpdatedProjects(ctx context.Context, projects []ProjectS
 			return getUpdatedProjectsResult{}, fmt.Errorf("convert project fields: %w", err)
 		}
 
+		result.Projects = append(result.Projects, project)
+
 		// Only export projects that were updated
 		if isUpdated(project, updatedAfter, updatedBefore) || firstIteration {
 			result.UpdatedProjects = append(result.UpdatedProjects, project)
@@ -535,10 +816,14 @@ func (c *Controller) getUpdatedProjects(ctx context.Context, projects []ProjectS
 }
 
 func (s *Controller) processProjects(ctx context.Context, projects []types.Project) error {
-	return s.Exporter.ExportProjects(ctx, projects)
+	err := s.Exporter.ExportProjects(ctx, projects)
+	observeRecords(s.metrics, "projects", projects, func(p types.Project) int64 { return p.Id }, err)
+	return err
 }
 
 func (c *Controller) processRunners(ctx context.Context) error {
+	defer c.metrics.observeDuration("runners", time.Now())
+
 	fetchedAt := time.Now().UTC()
 	runners, err := FetchRunners(ctx, c.GitLab)
 	if errors.Is(err, context.Canceled) {
@@ -547,7 +832,9 @@ func (c *Controller) processRunners(ctx context.Context) error {
 		return fmt.Errorf("fetch runners: %w", err)
 	}
 
-	if err := c.Exporter.ExportRunners(ctx, runners, fetchedAt); err != nil {
+	err = c.Exporter.ExportRunners(ctx, runners, fetchedAt)
+	observeRecords(c.metrics, "runners", runners, nil, err)
+	if err != nil {
 		return fmt.Errorf("export runners: %w", err)
 	}
 
@@ -569,8 +856,10 @@ func (c *Controller) processProjectsIssues(ctx context.Context, projectIds []int
 		err = fmt.Errorf("fetch issues: %w", err)
 	}
 
-	if err := c.Exporter.ExportIssues(ctx, issues); err != nil {
-		return fmt.Errorf("export issues: %w", err)
+	exportErr := c.Exporter.ExportIssues(ctx, issues)
+	observeRecords(c.metrics, "issues", issues, func(i types.Issue) int64 { return i.Project.Id }, exportErr)
+	if exportErr != nil {
+		return fmt.Errorf("export issues: %w", exportErr)
 	}
 
 	return err
@@ -589,13 +878,279 @@ func (c *Controller) processProjectsDeployments(ctx context.Context, projectIds
 		return fmt.Errorf("fetch deployments: %w", err)
 	}
 
-	if err := c.Exporter.ExportDeployments(ctx, deployments); err != nil {
+	err = c.Exporter.ExportDeployments(ctx, deployments)
+	observeRecords(c.metrics, "deployments", deployments, func(d types.Deployment) int64 { return d.Environment.Project.Id }, err)
+	if err != nil {
 		return fmt.Errorf("export deployments: %w", err)
 	}
 
 	return nil
 }
 
+// processProjectsCommits fetches and exports the commits of the given
+// projects that were pushed since their last exported branch heads, or if
+// inRange, that were committed in the given time range. The branch heads are
+// stored once their commits are exported.
+func (c *Controller) processProjectsCommits(ctx context.Context, projects []types.Project, committedAfter *time.Time, committedBefore *time.Time, inRange bool) error {
+	var (
+		commitProjects    []types.Project
+		protectedBranches = make(map[int64][]string)
+	)
+	for _, p := range projects {
+		if c.projectsSettings.ExportCommits(p.Id) {
+			commitProjects = append(commitProjects, p)
+			protectedBranches[p.Id] = c.projectsSettings.CommitsProtectedBranches(p.Id)
+		}
+	}
+
+	var lastHead func(projectId int64, ref string) (string, bool)
+	if !inRange {
+		lastHead = c.headsStore().GetHead
+	}
+
+	var (
+		errs      error
+		exportErr bool
+		heads     []checkpoint.Head
+	)
+
+	commits := newBuffer(c, exporter.MessageSize(messages.NewCommit), func(ctx context.Context, commits []types.Commit) error {
+		err := c.Exporter.ExportCommits(ctx, commits)
+		observeRecords(c.metrics, "commits", commits, func(commit types.Commit) int64 { return commit.Project.Id }, err)
+		return err
+	})
+	for data, err := range FetchProjectsCommits(ctx, c.GitLab, commitProjects, protectedBranches, lastHead, committedAfter, committedBefore) {
+		if err := c.handleError(&errs, err, "fetch commits"); err != nil {
+			return err
+		}
+		heads = append(heads, data.Heads...)
+
+		err = commits.Add(ctx, data.Commits...)
+		exportErr = exportErr || err != nil
+		if err := c.handleError(&errs, err, "export commits"); err != nil {
+			return err
+		}
+	}
+
+	err := commits.Flush(ctx)
+	exportErr = exportErr || err != nil
+	if err := c.handleError(&errs, err, "export commits"); err != nil {
+		return err
+	}
+
+	// commits are fetched from the same heads again if any failed to export
+	if !exportErr && len(heads) > 0 {
+		if err := c.headsStore().SetHeads(heads...); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("store commit heads: %w", err))
+		}
+	}
+
+	return errs
+}
+
+// processProjectsReleases fetches and exports the releases of the given
+// projects that were created in the given time range. Tags and changes of
+// existing releases are exported by processTags.
+func (c *Controller) processProjectsReleases(ctx context.Context, projectIds []int64, createdAfter *time.Time, createdBefore *time.Time) error {
+	pids := make([]int64, 0, len(projectIds))
+	for _, pid := range projectIds {
+		if c.projectsSettings.ExportReleases(pid) {
+			pids = append(pids, pid)
+		}
+	}
+
+	return c.exportReleases(ctx, pids, createdAfter, createdBefore)
+}
+
+// processTags exports all tags and releases of the projects that export
+// releases. Tags are fetched completely, since they can point to commits of
+// any time and pushing them does not mark their project as updated, and
+// releases are, since their release date, milestones and evidences can change
+// after they were created. The pipelines of releases are only resolved again
+// for releases whose tag was moved.
+func (c *Controller) processTags(ctx context.Context) error {
+	defer c.metrics.observeDuration("tags", time.Now())
+
+	projects := c.projectsSettings.List(func(ps ProjectSettings) bool {
+		return ps.Export.Releases.Enabled
+	})
+	var tagsPids, releasesPids []int64
+	for _, ps := range projects {
+		if ps.AccessLevels.Repository != ProjectAccessLevelDisabled {
+			tagsPids = append(tagsPids, ps.Id)
+		}
+		if ps.AccessLevels.Releases != ProjectAccessLevelDisabled {
+			releasesPids = append(releasesPids, ps.Id)
+		}
+	}
+
+	var errs error
+
+	tags := newBuffer(c, exporter.MessageSize(messages.NewTag), func(ctx context.Context, tags []types.Tag) error {
+		err := c.Exporter.ExportTags(ctx, tags)
+		observeRecords(c.metrics, "tags", tags, func(t types.Tag) int64 { return t.Project.Id }, err)
+		return err
+	})
+	for data, err := range FetchProjectsTags(ctx, c.GitLab, tagsPids) {
+		if err := c.handleError(&errs, err, "fetch tags"); err != nil {
+			return err
+		}
+
+		err = tags.Add(ctx, data...)
+		if err := c.handleError(&errs, err, "export tags"); err != nil {
+			return err
+		}
+	}
+	err := tags.Flush(ctx)
+	if err := c.handleError(&errs, err, "export tags"); err != nil {
+		return err
+	}
+
+	err = c.exportReleases(ctx, releasesPids, nil, nil)
+	if errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return errors.Join(errs, err)
+}
+
+func (c *Controller) exportReleases(ctx context.Context, projectIds []int64, createdAfter *time.Time, createdBefore *time.Time) error {
+	var errs error
+
+	releases := newBuffer(c, exporter.MessageSize(messages.NewRelease), func(ctx context.Context, releases []types.Release) error {
+		err := c.Exporter.ExportReleases(ctx, releases)
+		observeRecords(c.metrics, "releases", releases, func(r types.Release) int64 { return r.Project.Id }, err)
+		return err
+	})
+	now := time.Now()
+	for data, err := range FetchProjectsReleases(ctx, c.GitLab, projectIds, c.releasePipelines.get, createdAfter, createdBefore) {
+		if err := c.handleError(&errs, err, "fetch releases"); err != nil {
+			return err
+		}
+		for _, r := range data {
+			c.releasePipelines.set(r, now)
+		}
+
+		err = releases.Add(ctx, data...)
+		if err := c.handleError(&errs, err, "export releases"); err != nil {
+			return err
+		}
+	}
+	err := releases.Flush(ctx)
+	if err := c.handleError(&errs, err, "export releases"); err != nil {
+		return err
+	}
+
+	return errs
+}
+
+// processEnvironments fetches and exports the environments of all projects,
+// since environments change without being updated, e.g. when they are
+// stopped automatically.
+func (c *Controller) processEnvironments(ctx context.Context) error {
+	defer c.metrics.observeDuration("environments", time.Now())
+
+	projects := c.projectsSettings.List(func(ps ProjectSettings) bool {
+		return ps.AccessLevels.Environment != ProjectAccessLevelDisabled && ps.Export.Environments.Enabled
+	})
+
+	var errs error
+	for _, batch := range makeBatches(projects, maxRecordsPerPage) {
+		pids := make([]int64, 0, len(batch))
+		for _, ps := range batch {
+			pids = append(pids, ps.Id)
+		}
+
+		err := c.processProjectsEnvironments(ctx, pids)
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		errs = errors.Join(errs, err)
+	}
+
+	return errs
+}
+
+func (c *Controller) processProjectsEnvironments(ctx context.Context, projectIds []int64) error {
+	pids := make([]int64, 0, len(projectIds))
+	for _, pid := range projectIds {
+		if c.projectsSettings.ExportEnvironments(pid) {
+			pids = append(pids, pid)
+		}
+	}
+
+	environments, err := FetchProjectsEnvironments(ctx, c.GitLab, pids)
+	if errors.Is(err, context.Canceled) {
+		return err
+	} else if err != nil {
+		err = fmt.Errorf("fetch environments: %w", err)
+	}
+
+	// listed environments do not include the path of their project
+	for i, env := range environments {
+		if ps, ok := c.projectsSettings.Get(env.Project.Id); ok && env.Project.FullPath == "" {
+			environments[i].Project.FullPath = ps.FullPath
+		}
+	}
+
+	exportErr := c.Exporter.ExportEnvironments(ctx, environments)
+	observeRecords(c.metrics, "environments", environments, func(e types.Environment) int64 { return e.Project.Id }, exportErr)
+	if exportErr != nil {
+		return fmt.Errorf("export environments: %w", exportErr)
+	}
+
+	return err
+}
+
+// processJobArtifacts exports the artifacts of the projects that export job
+// artifacts again while they can still change, so that changes of their locked
+// state and expiry are recorded even if their pipelines are not updated anymore.
+func (c *Controller) processJobArtifacts(ctx context.Context) error {
+	defer c.metrics.observeDuration("job_artifacts", time.Now())
+
+	projects := c.projectsSettings.List(func(ps ProjectSettings) bool {
+		return ps.Export.Jobs.Artifacts.Enabled
+	})
+	projectPaths := make([]string, 0, len(projects))
+	for _, ps := range projects {
+		projectPaths = append(projectPaths, ps.FullPath)
+	}
+
+	var errs error
+
+	artifacts := newBuffer(c, exporter.MessageSize(messages.NewJobArtifact), func(ctx context.Context, artifacts []types.JobArtifact) error {
+		err := c.Exporter.ExportJobArtifacts(ctx, artifacts)
+		observeRecords(c.metrics, "job_artifacts", artifacts, func(a types.JobArtifact) int64 { return a.Job.Pipeline.Project.Id }, err)
+		return err
+	})
+	for data, err := range FetchProjectsJobArtifacts(ctx, c.GitLab, projectPaths) {
+		if err := c.handleError(&errs, err, "fetch job artifacts"); err != nil {
+			return err
+		}
+
+		err = artifacts.Add(ctx, data...)
+		if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+			return err
+		}
+	}
+
+	err := artifacts.Flush(ctx)
+	if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+		return err
+	}
+
+	return errs
+}
+
+// newBuffer returns a buffer that exports records with the given function
+// and shares the memory budget of the controller.
+func newBuffer[T any](c *Controller, size func(T) int, export func(ctx context.Context, data []T) error) *exporter.Buffer[T] {
+	c.configMutex.RLock()
+	defer c.configMutex.RUnlock()
+
+	return exporter.NewBuffer(c.budget, c.config.Export.FlushSize, size, export)
+}
+
 func (c *Controller) handleError(errs *error, err error, msg string) error {
 	if err == nil {
 		return nil
@@ -618,6 +1173,7 @@ func (c *Controller) processPipelines(ctx context.Context, projectIds []int64, u
 	}
 
 	err = c.Exporter.ExportPipelines(ctx, pipelines)
+	observeRecords(c.metrics, "pipelines", pipelines, func(p types.Pipeline) int64 { return p.Project.Id }, err)
 	if err := c.handleError(&errs, err, "export pipelines"); err != nil {
 		return err
 	}
@@ -638,6 +1194,11 @@ func (c *Controller) processPipelines(ctx context.Context, projectIds []int64, u
 		return err
 	}
 
+	err = c.exportJobArtifacts(ctx, pipelines)
+	if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+		return err
+	}
+
 	err = c.exportReports(ctx, pipelines)
 	if err := c.handleError(&errs, err, "export reports"); err != nil {
 		return err
@@ -649,75 +1210,134 @@ func (c *Controller) processPipelines(ctx context.Context, projectIds []int64, u
 func (c *Controller) exportJobs(ctx context.Context, projectIds []int64, updatedAfter *time.Time, updatedBefore *time.Time) error {
 	var errs error
 
-	jobs, err := FetchProjectsPipelinesJobs(ctx, c.GitLab, projectIds, updatedAfter, updatedBefore)
-	if err := c.handleError(&errs, err, "fetch projects pipelines jobs"); err != nil {
-		return err
+	for jobs, err := range FetchProjectsPipelinesJobs(ctx, c.GitLab, projectIds, updatedAfter, updatedBefore) {
+		if err := c.handleError(&errs, err, "fetch projects pipelines jobs"); err != nil {
+			return err
+		}
+
+		err = c.exportJobsData(ctx, jobs)
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		errs = errors.Join(errs, err)
 	}
 
+	return errs
+}
+
+// exportJobsData exports the given jobs together with the data extracted
+// from their logs. The log data is exported as the logs are fetched, the
+// jobs once all of their logs are processed.
+func (c *Controller) exportJobsData(ctx context.Context, jobs []types.Job) error {
+	var errs error
+
+	sections := newBuffer(c, exporter.MessageSize(messages.NewSection), func(ctx context.Context, sections []types.Section) error {
+		err := c.Exporter.ExportSections(ctx, sections)
+		observeRecords(c.metrics, "sections", sections, func(s types.Section) int64 { return s.Job.Pipeline.Project.Id }, err)
+		return err
+	})
+	sectionSpans := newBuffer(c, exporter.MessageSize(messages.NewSectionSpan), c.Exporter.ExportSectionSpans)
+	metrics := newBuffer(c, exporter.MessageSize(messages.NewMetric), func(ctx context.Context, metrics []types.Metric) error {
+		err := c.Exporter.ExportMetrics(ctx, metrics)
+		observeRecords(c.metrics, "metrics", metrics, func(m types.Metric) int64 { return m.Job.Pipeline.Project.Id }, err)
+		return err
+	})
+
 	var (
 		logDataProjectJobs    []types.Job
 		logDataProjectJobsOpt FetchProjectsJobsLogDataOptions
 	)
 	logDataProjectJobsOpt.ProjectJobLogQueries = make(map[int64][]logql.MetricQuery)
-	for _, job := range jobs {
+	logDataProjectJobsOpt.ProjectPropertiesOnly = make(map[int64]bool)
+	logDataProjectJobsOpt.ProjectFailureClassifiers = make(map[int64]*failure.Classifier)
+	jobLogs := c.currentConfig().Export.JobLogs
+	logDataProjectJobsOpt.Log = rest.JobLogOptions{
+		MaxSize:  jobLogs.MaxSize,
+		TailSize: jobLogs.TailSize,
+	}
+	jobIndex := make(map[int64]int, len(jobs))
+	failuresOnly := make(map[int64]bool) // jobs whose logs are only fetched to classify the failure
+	for i, job := range jobs {
+		jobIndex[job.Id] = i
+
 		if job.Kind == types.JobKindBridge { // bridges don't have logs
 			continue
 		}
 
+		settings, _ := c.projectsSettings.Get(job.Pipeline.Project.Id)
+		classify := settings.Export.failureClassifier != nil && job.Status == "failed"
 		if !c.projectsSettings.ExportLogData(job.Pipeline.Project.Id) {
-			continue
+			if !classify {
+				continue
+			}
+			failuresOnly[job.Id] = true
 		}
 
 		logDataProjectJobs = append(logDataProjectJobs, job)
-		settings, _ := c.projectsSettings.Get(job.Pipeline.Project.Id)
 		logDataProjectJobsOpt.ProjectJobLogQueries[job.Pipeline.Project.Id] = settings.Export.logQLQueries
+		logDataProjectJobsOpt.ProjectPropertiesOnly[job.Pipeline.Project.Id] = !settings.Export.Sections.Enabled && !settings.Export.Metrics.Enabled
+		if settings.Export.failureClassifier != nil {
+			logDataProjectJobsOpt.ProjectFailureClassifiers[job.Pipeline.Project.Id] = settings.Export.failureClassifier
+		}
 	}
 
-	sections, metrics, properties, err := FetchProjectsJobsLogData(ctx, c.GitLab, logDataProjectJobs, logDataProjectJobsOpt)
-	if err := c.handleError(&errs, err, "fetch projects job log data"); err != nil {
-		return err
-	}
-	for jobId, props := range properties {
-		for i := range len(jobs) {
-			if jobs[i].Id == jobId {
-				jobs[i].Properties = props
-				break // inner loop
+	for data, err := range FetchProjectsJobsLogData(ctx, c.GitLab, logDataProjectJobs, logDataProjectJobsOpt) {
+		if err := c.handleError(&errs, err, "fetch projects job log data"); err != nil {
+			return err
+		}
+		if i, ok := jobIndex[data.JobId]; ok && data.Failure != nil {
+			jobs[i].FailureCategory = data.Failure.Category
+			jobs[i].FailureExcerpt = data.Failure.Excerpt
+		}
+		if failuresOnly[data.JobId] {
+			continue
+		}
+		if i, ok := jobIndex[data.JobId]; ok {
+			jobs[i].Properties = append(jobs[i].Properties, data.Properties...)
+		}
+
+		err = sections.Add(ctx, data.Sections...)
+		if err := c.handleError(&errs, err, "export sections"); err != nil {
+			return err
+		}
+		if len(data.Sections) > 0 && c.projectsSettings.ExportTraces(data.Sections[0].Job.Pipeline.Project.Id) {
+			err = sectionSpans.Add(ctx, data.Sections...)
+			if err := c.handleError(&errs, err, "export section spans"); err != nil {
+				return err
 			}
 		}
+		err = metrics.Add(ctx, data.Metrics...)
+		if err := c.handleError(&errs, err, "export metrics"); err != nil {
+			return err
+		}
 	}
 
-	err = c.Exporter.ExportJobs(ctx, jobs)
+	err := c.Exporter.ExportJobs(ctx, jobs)
+	observeRecords(c.metrics, "jobs", jobs, func(j types.Job) int64 { return j.Pipeline.Project.Id }, err)
 	if err := c.handleError(&errs, err, "export jobs"); err != nil {
 		return err
 	}
-	err = c.Exporter.ExportSections(ctx, sections)
+	err = sections.Flush(ctx)
 	if err := c.handleError(&errs, err, "export sections"); err != nil {
 		return err
 	}
 
 	var traceJobs []types.Job
-	var traceSections []types.Section
 	for _, j := range jobs {
 		if c.projectsSettings.ExportTraces(j.Pipeline.Project.Id) {
 			traceJobs = append(traceJobs, j)
-
-			for _, s := range sections {
-				if s.Job.Id == j.Id {
-					traceSections = append(traceSections, s)
-				}
-			}
 		}
 	}
 	err = c.Exporter.ExportJobSpans(ctx, traceJobs)
 	if err := c.handleError(&errs, err, "export job spans"); err != nil {
 		return err
 	}
-	err = c.Exporter.ExportSectionSpans(ctx, traceSections)
+	err = sectionSpans.Flush(ctx)
 	if err := c.handleError(&errs, err, "export section spans"); err != nil {
 		return err
 	}
 
-	err = c.Exporter.ExportMetrics(ctx, metrics)
+	err = metrics.Flush(ctx)
 	if err := c.handleError(&errs, err, "export metrics"); err != nil {
 		return err
 	}
@@ -725,6 +1345,40 @@ func (c *Controller) exportJobs(ctx context.Context, projectIds []int64, updated
 	return errs
 }
 
+func (c *Controller) exportJobArtifacts(ctx context.Context, pipelines []types.Pipeline) error {
+	var artifactPipelines []types.Pipeline
+	for _, p := range pipelines {
+		if c.projectsSettings.ExportJobArtifacts(p.Project.Id) {
+			artifactPipelines = append(artifactPipelines, p)
+		}
+	}
+
+	var errs error
+
+	artifacts := newBuffer(c, exporter.MessageSize(messages.NewJobArtifact), func(ctx context.Context, artifacts []types.JobArtifact) error {
+		err := c.Exporter.ExportJobArtifacts(ctx, artifacts)
+		observeRecords(c.metrics, "job_artifacts", artifacts, func(a types.JobArtifact) int64 { return a.Job.Pipeline.Project.Id }, err)
+		return err
+	})
+	for data, err := range FetchProjectsPipelinesJobArtifacts(ctx, c.GitLab, artifactPipelines) {
+		if err := c.handleError(&errs, err, "fetch job artifacts"); err != nil {
+			return err
+		}
+
+		err = artifacts.Add(ctx, data...)
+		if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+			return err
+		}
+	}
+
+	err := artifacts.Flush(ctx)
+	if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+		return err
+	}
+
+	return errs
+}
+
 func (c *Controller) exportReports(ctx context.Context, pipelines []types.Pipeline) error {
 	testReportProjectPipelines := []types.Pipeline{}
 	junitReportProjectPipelines := make(map[string][]string)
@@ -757,25 +1411,58 @@ func (c *Controller) exportReports(ctx context.Context, pipelines []types.Pipeli
 
 	var joinedErr error
 
+	testReports := newBuffer(c, exporter.MessageSize(messages.NewTestReport), func(ctx context.Context, reports []types.TestReport) error {
+		err := c.Exporter.ExportTestReports(ctx, reports)
+		observeRecords(c.metrics, "test_reports", reports, func(r types.TestReport) int64 { return r.Job.Pipeline.Project.Id }, err)
+		return err
+	})
+	testSuites := newBuffer(c, exporter.MessageSize(messages.NewTestSuite), c.Exporter.ExportTestSuites)
+	testCases := newBuffer(c, exporter.MessageSize(messages.NewTestCase), c.Exporter.ExportTestCases)
+	addTestReports := func(r TestReports) error {
+		err := testReports.Add(ctx, r.TestReports...)
+		if herr := c.handleError(&joinedErr, err, "test reports"); herr != nil {
+			return herr
+		}
+		err = testSuites.Add(ctx, r.TestSuites...)
+		if herr := c.handleError(&joinedErr, err, "test suites"); herr != nil {
+			return herr
+		}
+		err = testCases.Add(ctx, r.TestCases...)
+		if herr := c.handleError(&joinedErr, err, "test cases"); herr != nil {
+			return herr
+		}
+		return nil
+	}
+
+	// fetch and export junit reports as they are available
+	for r, err := range FetchProjectsPipelinesJunitReports(ctx, c.GitLab, junitReportProjectPipelines, junitReportProjectArtifactPaths) {
+		if err := c.handleError(&joinedErr, err, "fetch junit reports"); err != nil {
+			return err
+		}
+		if err := addTestReports(r); err != nil {
+			return err
+		}
+	}
+
 	// fetch test reports
-	testReports, testSuites, testCases, err := FetchProjectsPipelinesTestReports(ctx, c.GitLab, testReportProjectPipelines)
+	reports, suites, cases, err := FetchProjectsPipelinesTestReports(ctx, c.GitLab, testReportProjectPipelines)
 	if err := c.handleError(&joinedErr, err, "fetch test reports"); err != nil {
 		return err
 	}
-	junitReports, junitSuites, junitCases, err := FetchProjectsPipelinesJunitReports(ctx, c.GitLab, junitReportProjectPipelines, junitReportProjectArtifactPaths)
-	if err := c.handleError(&joinedErr, err, "fetch junit reports"); err != nil {
+	if err := addTestReports(TestReports{reports, suites, cases}); err != nil {
 		return err
 	}
-	// export test reports
-	err = c.Exporter.ExportTestReports(ctx, append(junitReports, testReports...))
+
+	// export remaining test reports
+	err = testReports.Flush(ctx)
 	if herr := c.handleError(&joinedErr, err, "test reports"); herr != nil {
 		return herr
 	}
-	err = c.Exporter.ExportTestSuites(ctx, append(junitSuites, testSuites...))
+	err = testSuites.Flush(ctx)
 	if herr := c.handleError(&joinedErr, err, "test suites"); herr != nil {
 		return herr
 	}
-	err = c.Exporter.ExportTestCases(ctx, append(junitCases, testCases...))
+	err = testCases.Flush(ctx)
 	if herr := c.handleError(&joinedErr, err, "test cases"); herr != nil {
 		return herr
 	}
@@ -787,6 +1474,7 @@ func (c *Controller) exportReports(ctx context.Context, pipelines []types.Pipeli
 	}
 	// export coverage reports
 	err = c.Exporter.ExportCoverageReports(ctx, covReports)
+	observeRecords(c.metrics, "coverage_reports", covReports, func(r types.CoverageReport) int64 { return r.Job.Pipeline.Project.Id }, err)
 	if herr := c.handleError(&joinedErr, err, "coverage reports"); herr != nil {
 		return herr
 	}
@@ -819,29 +1507,92 @@ func (c *Controller) processProjectMergeRequests(ctx context.Context, projectIds
 		errs = append(errs, fmt.Errorf("fetch merge request note events: %w", err))
 	}
 
-	if err := c.Exporter.ExportMergeRequests(ctx, mergeRequests); err != nil {
+	err = c.Exporter.ExportMergeRequests(ctx, mergeRequests)
+	observeRecords(c.metrics, "merge_requests", mergeRequests, func(mr types.MergeRequest) int64 { return mr.Project.Id }, err)
+	if err != nil {
 		errs = append(errs, fmt.Errorf("export merge requests: %w", err))
 	}
 
-	if err := c.Exporter.ExportMergeRequestCommits(ctx, mergeRequestCommits); err != nil {
+	err = c.Exporter.ExportMergeRequestCommits(ctx, mergeRequestCommits)
+	observeRecords(c.metrics, "merge_request_commits", mergeRequestCommits, func(mc types.MergeRequestCommit) int64 { return mc.MergeRequest.Project.Id }, err)
+	if err != nil {
 		errs = append(errs, fmt.Errorf("export merge request commits: %w", err))
 	}
 
-	if err := c.Exporter.ExportMergeRequestNoteEvents(ctx, mergeRequestNoteEvents); err != nil {
+	err = c.Exporter.ExportMergeRequestNoteEvents(ctx, mergeRequestNoteEvents)
+	observeRecords(c.metrics, "merge_request_note_events", mergeRequestNoteEvents, func(ne types.MergeRequestNoteEvent) int64 { return ne.MergeRequest.Project.Id }, err)
+	if err != nil {
 		errs = append(errs, fmt.Errorf("export merge request note events: %w", err))
 	}
 
+	if err := c.processMergeRequestsEvents(ctx, mergeRequests, mergeRequestNoteEvents); err != nil {
+		errs = append(errs, fmt.Errorf("process merge request events: %w", err))
+	}
+
 	return errors.Join(errs...)
 }
 
+// processMergeRequestsEvents exports the timeline of the given merge requests
+// of projects that export merge request events. The timeline is made of all
+// resource events of the merge requests and of the events derived from the
+// given note events.
+func (c *Controller) processMergeRequestsEvents(ctx context.Context, mergeRequests []types.MergeRequest, noteEvents []types.MergeRequestNoteEvent) error {
+	var refs []types.MergeRequestReference
+	for _, mr := range mergeRequests {
+		if c.projectsSettings.ExportMergeRequestEvents(mr.Project.Id) {
+			refs = append(refs, types.MergeRequestReference{
+				Id:      mr.Id,
+				Iid:     mr.Iid,
+				Project: mr.Project,
+			})
+		}
+	}
+	var exportedNoteEvents []types.MergeRequestNoteEvent
+	for _, ne := range noteEvents {
+		if c.projectsSettings.ExportMergeRequestEvents(ne.MergeRequest.Project.Id) {
+			exportedNoteEvents = append(exportedNoteEvents, ne)
+		}
+	}
+
+	var errs error
+
+	events := newBuffer(c, exporter.MessageSize(messages.NewMergeRequestEvent), func(ctx context.Context, events []types.MergeRequestEvent) error {
+		err := c.Exporter.ExportMergeRequestEvents(ctx, events)
+		observeRecords(c.metrics, "merge_request_events", events, func(e types.MergeRequestEvent) int64 { return e.MergeRequest.Project.Id }, err)
+		return err
+	})
+	err := events.Add(ctx, MergeRequestEventsFromNoteEvents(exportedNoteEvents)...)
+	if err := c.handleError(&errs, err, "export merge request events"); err != nil {
+		return err
+	}
+	for data, err := range FetchMergeRequestsEvents(ctx, c.GitLab, refs) {
+		if err := c.handleError(&errs, err, "fetch merge request events"); err != nil {
+			return err
+		}
+
+		err = events.Add(ctx, data...)
+		if err := c.handleError(&errs, err, "export merge request events"); err != nil {
+			return err
+		}
+	}
+	err = events.Flush(ctx)
+	if err := c.handleError(&errs, err, "export merge request events"); err != nil {
+		return err
+	}
+
+	return errs
+}
+
 func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 	c.projectsSettingsMutex.Lock()
 	defer c.projectsSettingsMutex.Unlock()
 
+	cfg := c.currentConfig()
 	projectsSettings := make(map[int64]ProjectSettings)
+	now := time.Now()
 
 	opt := rest.ListNamespaceProjectsOptions{}
-	for _, namespace := range c.config.Namespaces {
+	for _, namespace := range cfg.Namespaces {
 		opt.Kind = namespace.Kind
 		if namespace.Visibility != "" {
 			opt.Visibility = &namespace.Visibility
@@ -866,9 +1617,19 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 			}
 			updatedBefore = &before
 		}
+		failureClassifier, err := newFailureClassifier(namespace.ProjectSettings.Export.Jobs.FailureCategories)
+		if err != nil {
+			return 0, fmt.Errorf("error creating failure classifier: %w", err)
+		}
 
-		err := c.GitLab.Rest.ListNamespaceProjects(ctx, namespace.Id, opt, func(projects []*rest.Project) bool {
+		selector := newProjectSelector(namespace.Include, namespace.Exclude)
+
+		err = c.GitLab.Rest.ListNamespaceProjects(ctx, namespace.Id, opt, func(projects []*rest.Project) bool {
 			for _, project := range projects {
+				if !selector.selects(project, now) {
+					continue
+				}
+
 				ps := ProjectSettings{
 					Id:       int64(project.ID),
 					FullPath: project.PathWithNamespace,
@@ -880,27 +1641,26 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 						Builds:      ProjectAccessLevel(project.BuildsAccessLevel),
 						Environment: ProjectAccessLevel(project.EnvironmentsAccessLevel),
 						Issues:      ProjectAccessLevel(project.IssuesAccessLevel),
+						Releases:    ProjectAccessLevel(project.ReleasesAccessLevel),
+						Repository:  ProjectAccessLevel(project.RepositoryAccessLevel),
 					},
 				}
 
 				// Export
 				ps.Export.ProjectExport = namespace.ProjectSettings.Export
 				for _, query := range namespace.ProjectSettings.Export.Metrics.LogQueries {
-					lineFilter, err := logql.ParseLineFilter(query.LineFilter)
+					q, err := parseLogQuery(query)
 					if err != nil {
-						slog.LogAttrs(context.Background(), slog.LevelError, "parse logql line filter",
+						slog.LogAttrs(context.Background(), slog.LevelError, "parse logql query",
 							slog.String("error", err.Error()),
 							slog.Int("project_id", project.ID),
 							slog.String("namespace_id", namespace.Id),
 						)
 						return false
 					}
-					ps.Export.logQLQueries = append(ps.Export.logQLQueries, logql.MetricQuery{
-						Name:       query.Name,
-						LineFilter: lineFilter,
-						LabelAdd:   query.LabelAdd,
-					})
+					ps.Export.logQLQueries = append(ps.Export.logQLQueries, q)
 				}
+				ps.Export.failureClassifier = failureClassifier
 
 				// Catchup
 				ps.CatchUp.Enabled = namespace.ProjectSettings.CatchUp.Enabled
@@ -933,10 +1693,13 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 	}
 
 	// overwrite with explicitly configured projects
-	for _, p := range c.config.Projects {
-		project, err := c.GitLab.Rest.GetProject(ctx, int(p.Id))
+	for _, p := range cfg.Projects {
+		if p.Id == 0 && p.Path == "" {
+			return 0, fmt.Errorf("project without id or path")
+		}
+		project, err := c.GitLab.Rest.GetProject(ctx, p.Pid())
 		if err != nil {
-			return 0, err
+			return 0, fmt.Errorf("get project %v: %w", p.Pid(), err)
 		}
 
 		var updatedAfter *time.Time = project.CreatedAt
@@ -955,6 +1718,10 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 			}
 			updatedBefore = &before
 		}
+		failureClassifier, err := newFailureClassifier(p.ProjectSettings.Export.Jobs.FailureCategories)
+		if err != nil {
+			return 0, fmt.Errorf("error creating failure classifier: %w", err)
+		}
 
 		ps := ProjectSettings{
 			Id:       int64(project.ID),
@@ -966,26 +1733,25 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 			AccessLevels: ProjectAccessLevels{
 				Builds:      ProjectAccessLevel(project.BuildsAccessLevel),
 				Environment: ProjectAccessLevel(project.EnvironmentsAccessLevel),
+				Releases:    ProjectAccessLevel(project.ReleasesAccessLevel),
+				Repository:  ProjectAccessLevel(project.RepositoryAccessLevel),
 			},
 		}
 
 		// Export
 		ps.Export.ProjectExport = p.ProjectSettings.Export
 		for _, query := range p.ProjectSettings.Export.Metrics.LogQueries {
-			lineFilter, err := logql.ParseLineFilter(query.LineFilter)
+			q, err := parseLogQuery(query)
 			if err != nil {
-				slog.LogAttrs(context.Background(), slog.LevelError, "parse logql line filter",
+				slog.LogAttrs(context.Background(), slog.LevelError, "parse logql query",
 					slog.String("error", err.Error()),
 					slog.Int("project_id", project.ID),
 				)
 				continue
 			}
-			ps.Export.logQLQueries = append(ps.Export.logQLQueries, logql.MetricQuery{
-				Name:       query.Name,
-				LineFilter: lineFilter,
-				LabelAdd:   query.LabelAdd,
-			})
+			ps.Export.logQLQueries = append(ps.Export.logQLQueries, q)
 		}
+		ps.Export.failureClassifier = failureClassifier
 
 		// CatchUp
 		ps.CatchUp.Enabled = p.ProjectSettings.CatchUp.Enabled
@@ -999,6 +1765,45 @@ func (c *Controller) ResolveProjects(ctx context.Context) (int, error) {
 	return c.projectsSettings.Len(), nil
 }
 
+// parseLogQuery parses the query of a log metric, or its line filter if it
+// has no query.
+func parseLogQuery(query config.ProjectExportMetricsLogQuery) (logql.MetricQuery, error) {
+	expr := query.Query
+	if expr == "" {
+		expr = query.LineFilter
+	}
+	q, err := logql.ParseQuery(expr)
+	if err != nil {
+		return logql.MetricQuery{}, err
+	}
+	return logql.MetricQuery{
+		Name:     query.Name,
+		Query:    q,
+		LabelAdd: query.LabelAdd,
+	}, nil
+}
+
+// newFailureClassifier returns the classifier for failed jobs, or nil if the
+// classification is disabled.
+func newFailureClassifier(cfg config.ProjectExportJobsFailureCategories) (*failure.Classifier, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	rules := make([]failure.Rule, 0, len(cfg.Rules)+len(failure.DefaultRules))
+	for _, r := range cfg.Rules {
+		rules = append(rules, failure.Rule{
+			Category:   r.Category,
+			Regexp:     r.Regexp,
+			LineFilter: r.LineFilter,
+		})
+	}
+	if cfg.DefaultRules {
+		rules = append(rules, failure.DefaultRules...)
+	}
+	return failure.NewClassifier(rules)
+}
+
 func isUpdated(p types.Project, after *time.Time, before *time.Time) bool {
 	var updated bool = true
 
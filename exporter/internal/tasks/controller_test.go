@@ -0,0 +1,95 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
+)
+
+func TestController_CheckLiveness(t *testing.T) {
+	c := NewController(nil, nil, ControllerConfig{
+		Schedule: Schedule{Export: schedule.Every(time.Minute)},
+	})
+
+	if err := c.CheckLiveness(3); err != nil {
+		t.Errorf("want no error before the export loop started, got %v", err)
+	}
+
+	c.loopCompletedAt.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	if err := c.CheckLiveness(3); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	c.loopCompletedAt.Store(time.Now().Add(-4 * time.Minute).UnixNano())
+	if err := c.CheckLiveness(3); err == nil {
+		t.Error("want error when the export loop stalled")
+	}
+	if err := c.CheckLiveness(0); err != nil {
+		t.Errorf("want no error when the check is disabled, got %v", err)
+	}
+}
+
+func TestSchedule_Jobs(t *testing.T) {
+	s := Schedule{
+		Export:        schedule.Every(5 * time.Minute),
+		Pipelines:     schedule.Every(time.Minute),
+		MergeRequests: schedule.Every(time.Minute),
+		Deployments:   schedule.Every(5 * time.Minute),
+		Environments:  schedule.Every(time.Hour),
+		JobArtifacts:  schedule.Every(24 * time.Hour),
+		Tags:          schedule.Every(time.Hour),
+		Runners:       schedule.Every(time.Hour),
+	}
+
+	type job struct {
+		Name         string
+		Schedule     string
+		Keys         []string
+		Environments bool
+		JobArtifacts bool
+		Tags         bool
+		Runners      bool
+	}
+	var got []job
+	for _, j := range s.jobs(true) {
+		got = append(got, job{j.name, j.schedule.String(), j.keys(), j.environments, j.jobArtifacts, j.tags, j.runners})
+	}
+
+	want := []job{
+		{"export", "5m0s", []string{"projects", "issues", "deployments", "commits", "releases"}, false, false, false, false},
+		{"pipelines,merge_requests", "1m0s", []string{"pipelines", "merge_requests"}, false, false, false, false},
+		{"environments,tags,runners", "1h0m0s", nil, true, false, true, true},
+		{"job_artifacts", "24h0m0s", nil, false, true, false, false},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("jobs mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestController_ExportedUntil(t *testing.T) {
+	c := NewController(nil, nil, ControllerConfig{})
+
+	start := time.Now()
+	if got := c.exportedUntil([]string{"projects", "pipelines"}, start); !got.Equal(start) {
+		t.Errorf("want default before any export, got %s", got)
+	}
+
+	c.setExportedUntil([]string{"projects"}, start.Add(time.Minute))
+	c.setExportedUntil([]string{"pipelines"}, start.Add(2*time.Minute))
+	if got := c.exportedUntil([]string{"projects", "pipelines"}, start); !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("want earliest export, got %s", got)
+	}
+	if got := c.exportedUntil([]string{"issues"}, start); !got.Equal(start) {
+		t.Errorf("want default for kinds not yet exported, got %s", got)
+	}
+
+	if !c.exportedAll([]string{"projects", "pipelines"}) {
+		t.Errorf("want all exported")
+	}
+	if c.exportedAll([]string{"projects", "issues"}) {
+		t.Errorf("want not all exported with kinds not yet exported")
+	}
+}
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func FetchProjectsEnvironments(ctx context.Context, glab *gitlab.Client, projectIds []int64) ([]types.Environment, error) {
+	var (
+		environments []types.Environment
+		errs         error
+	)
+
+	fetch := func(ctx context.Context, projectId int64) ([]types.Environment, error) {
+		return FetchProjectEnvironments(ctx, glab, projectId)
+	}
+	for envs, err := range fetchEach(ctx, glab, projectIds, fetch) {
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		environments = append(environments, envs...)
+	}
+
+	return environments, errs
+}
+
+func FetchProjectEnvironments(ctx context.Context, glab *gitlab.Client, projectId int64) ([]types.Environment, error) {
+	envs, err := glab.Rest.GetProjectEnvironments(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	environments := make([]types.Environment, 0, len(envs))
+	for _, env := range envs {
+		environments = append(environments, rest.ConvertEnvironment(env))
+	}
+
+	return environments, nil
+}
@@ -0,0 +1,183 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/webhook"
+)
+
+const (
+	// eventsFlushInterval is the interval in which queued webhook events are
+	// processed. Events for the same pipeline or merge request that arrive
+	// within this interval are coalesced, e.g. the job events of a pipeline.
+	eventsFlushInterval time.Duration = 10 * time.Second
+
+	// eventsLookback is how far before an event was received the controller
+	// looks for updated issues and deployments, since their webhook events
+	// are not used to fetch single entities.
+	eventsLookback time.Duration = 5 * time.Minute
+)
+
+// HandleEvents processes the webhook events received on the given channel
+// until the context is canceled.
+func (c *Controller) HandleEvents(ctx context.Context, events <-chan webhook.Event) error {
+	ticker := time.NewTicker(eventsFlushInterval)
+	defer ticker.Stop()
+
+	// pending events, mapped to the time the earliest of them was received
+	pending := make(map[webhook.Event]time.Time)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev := <-events:
+			if _, ok := c.projectsSettings.Get(ev.ProjectId); !ok {
+				slog.Debug("[EVENTS] Ignoring event for unknown project", "kind", ev.Kind, "project_id", ev.ProjectId)
+				continue
+			}
+
+			receivedAt := ev.ReceivedAt
+			ev.ReceivedAt = time.Time{}
+			if t, ok := pending[ev]; !ok || receivedAt.Before(t) {
+				pending[ev] = receivedAt
+			}
+		case <-ticker.C:
+			if len(pending) == 0 {
+				continue
+			}
+
+			slog.Debug("[EVENTS] Processing events...", "count", len(pending))
+			for ev, receivedAt := range pending {
+				ev.ReceivedAt = receivedAt
+				if err := c.processEvent(ctx, ev); errors.Is(err, context.Canceled) {
+					return err
+				} else if err != nil {
+					slog.Error("[EVENTS] error processing event",
+						slog.String("error", err.Error()),
+						slog.String("kind", string(ev.Kind)),
+						slog.Int64("project_id", ev.ProjectId),
+					)
+				}
+			}
+			clear(pending)
+			slog.Debug("[EVENTS] Processing events... done")
+		}
+	}
+}
+
+func (c *Controller) processEvent(ctx context.Context, ev webhook.Event) error {
+	var (
+		updatedAfter  = ev.ReceivedAt.Add(-eventsLookback)
+		updatedBefore = time.Now().UTC()
+	)
+
+	switch ev.Kind {
+	case webhook.EventKindPipeline:
+		return c.processPipelineEvent(ctx, ev.ProjectId, ev.PipelineId)
+	case webhook.EventKindMergeRequest:
+		return c.processMergeRequestEvent(ctx, ev.ProjectId, ev.ProjectPath, ev.MergeRequestIid, &updatedAfter, &updatedBefore)
+	case webhook.EventKindIssue:
+		return c.processProjectsIssues(ctx, []int64{ev.ProjectId}, &updatedAfter, &updatedBefore)
+	case webhook.EventKindDeployment:
+		return errors.Join(
+			c.processProjectsDeployments(ctx, []int64{ev.ProjectId}, &updatedAfter, &updatedBefore),
+			c.processProjectsEnvironments(ctx, []int64{ev.ProjectId}),
+		)
+	default:
+		return fmt.Errorf("invalid event kind: %q", ev.Kind)
+	}
+}
+
+func (c *Controller) processPipelineEvent(ctx context.Context, projectId int64, pipelineId int64) error {
+	var errs error
+
+	pipeline, err := FetchProjectPipeline(ctx, c.GitLab, projectId, pipelineId)
+	if err != nil {
+		return fmt.Errorf("fetch pipeline: %w", err)
+	}
+
+	err = c.Exporter.ExportPipelines(ctx, []types.Pipeline{pipeline})
+	observeRecords(c.metrics, "pipelines", []types.Pipeline{pipeline}, func(p types.Pipeline) int64 { return p.Project.Id }, err)
+	if err := c.handleError(&errs, err, "export pipelines"); err != nil {
+		return err
+	}
+
+	if c.projectsSettings.ExportTraces(projectId) {
+		err = c.Exporter.ExportPipelineSpans(ctx, []types.Pipeline{pipeline})
+		if err := c.handleError(&errs, err, "export pipeline spans"); err != nil {
+			return err
+		}
+	}
+
+	jobs, err := FetchProjectPipelineJobs(ctx, c.GitLab, pipeline.Project.FullPath, pipeline.Iid)
+	if err := c.handleError(&errs, err, "fetch pipeline jobs"); err != nil {
+		return err
+	}
+
+	err = c.exportJobsData(ctx, jobs)
+	if err := c.handleError(&errs, err, "export jobs"); err != nil {
+		return err
+	}
+
+	if c.projectsSettings.ExportJobArtifacts(projectId) {
+		err = c.exportJobArtifacts(ctx, []types.Pipeline{pipeline})
+		if err := c.handleError(&errs, err, "export job artifacts"); err != nil {
+			return err
+		}
+	}
+
+	err = c.exportReports(ctx, []types.Pipeline{pipeline})
+	if err := c.handleError(&errs, err, "export reports"); err != nil {
+		return err
+	}
+
+	return errs
+}
+
+func (c *Controller) processMergeRequestEvent(ctx context.Context, projectId int64, projectPath string, mergeRequestIid int64, updatedAfter *time.Time, updatedBefore *time.Time) error {
+	if !c.projectsSettings.ExportMergeRequests(projectId) {
+		return nil
+	}
+
+	var errs []error
+
+	mergeRequest, mergeRequestCommits, err := FetchProjectMergeRequest(ctx, c.GitLab, projectPath, mergeRequestIid)
+	if err != nil {
+		return fmt.Errorf("fetch merge request: %w", err)
+	}
+
+	mergeRequestNoteEvents, err := FetchProjectsMergeRequestsNotes(ctx, c.GitLab, []int64{projectId}, updatedAfter, updatedBefore)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("fetch merge request note events: %w", err))
+	}
+
+	err = c.Exporter.ExportMergeRequests(ctx, []types.MergeRequest{mergeRequest})
+	observeRecords(c.metrics, "merge_requests", []types.MergeRequest{mergeRequest}, func(mr types.MergeRequest) int64 { return mr.Project.Id }, err)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("export merge requests: %w", err))
+	}
+
+	err = c.Exporter.ExportMergeRequestCommits(ctx, mergeRequestCommits)
+	observeRecords(c.metrics, "merge_request_commits", mergeRequestCommits, func(mc types.MergeRequestCommit) int64 { return mc.MergeRequest.Project.Id }, err)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("export merge request commits: %w", err))
+	}
+
+	err = c.Exporter.ExportMergeRequestNoteEvents(ctx, mergeRequestNoteEvents)
+	observeRecords(c.metrics, "merge_request_note_events", mergeRequestNoteEvents, func(ne types.MergeRequestNoteEvent) int64 { return ne.MergeRequest.Project.Id }, err)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("export merge request note events: %w", err))
+	}
+
+	if err := c.processMergeRequestsEvents(ctx, []types.MergeRequest{mergeRequest}, mergeRequestNoteEvents); err != nil {
+		errs = append(errs, fmt.Errorf("process merge request events: %w", err))
+	}
+
+	return errors.Join(errs...)
+}
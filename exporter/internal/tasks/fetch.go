@@ -0,0 +1,84 @@
+package tasks
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+	"sync"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+)
+
+// fetchEach calls fetch for each item concurrently, as far as the GitLab
+// client permits, and yields the results in the order they complete. Fetching
+// waits while a result is being processed, so that only a bounded number of
+// results is held in memory. Stopping the iteration cancels the remaining
+// fetches.
+func fetchEach[T any, R any](ctx context.Context, glab *gitlab.Client, items []T, fetch func(ctx context.Context, item T) (R, error)) iter.Seq2[R, error] {
+	return fetchPages(ctx, glab, items, func(ctx context.Context, item T, yield func(R, error) bool) {
+		yield(fetch(ctx, item))
+	})
+}
+
+// fetchPages is like fetchEach, but fetch may yield the results of an item
+// page by page. It stops fetching the item once yield returns false.
+func fetchPages[T any, R any](ctx context.Context, glab *gitlab.Client, items []T, fetch func(ctx context.Context, item T, yield func(R, error) bool)) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			value R
+			err   error
+		}
+
+		var (
+			wg      sync.WaitGroup
+			results = make(chan result)
+		)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, item := range items {
+				if err := glab.Acquire(ctx, 1); err != nil {
+					if ctx.Err() == nil {
+						slog.Error("failed to acquire gitlab client", "error", err)
+					}
+					break
+				}
+				wg.Add(1)
+				go func() {
+					defer glab.Release(1)
+					defer wg.Done()
+
+					fetch(ctx, item, func(value R, err error) bool {
+						results <- result{value: value, err: err}
+						return ctx.Err() == nil
+					})
+				}()
+			}
+		}()
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			wg.Wait()
+		}()
+
+		stopped := false
+		for {
+			select {
+			case <-done:
+				return
+			case r := <-results:
+				if stopped {
+					continue // drain
+				}
+				if !yield(r.value, r.err) {
+					stopped = true
+					cancel()
+				}
+			}
+		}
+	}
+}
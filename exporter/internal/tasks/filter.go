@@ -0,0 +1,105 @@
+package tasks
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+)
+
+// projectFilter is the compiled form of a config.ProjectFilter.
+type projectFilter struct {
+	path         *regexp.Regexp
+	topics       []string
+	archived     *bool
+	activeWithin time.Duration
+}
+
+func newProjectFilter(cfg config.ProjectFilter) projectFilter {
+	f := projectFilter{
+		topics:       cfg.Topics,
+		archived:     cfg.Archived,
+		activeWithin: time.Duration(cfg.ActiveWithinDays) * 24 * time.Hour,
+	}
+	if cfg.Path != "" {
+		f.path = compileGlob(cfg.Path)
+	}
+	return f
+}
+
+// matches reports whether the project satisfies all conditions of the filter.
+func (f projectFilter) matches(p *rest.Project, now time.Time) bool {
+	if f.path != nil && !f.path.MatchString(p.PathWithNamespace) {
+		return false
+	}
+	for _, topic := range f.topics {
+		if !slices.Contains(p.Topics, topic) {
+			return false
+		}
+	}
+	if f.archived != nil && *f.archived != p.Archived {
+		return false
+	}
+	if f.activeWithin > 0 && (p.LastActivityAt == nil || now.Sub(*p.LastActivityAt) > f.activeWithin) {
+		return false
+	}
+	return true
+}
+
+// projectSelector selects the projects that match any of its include filters,
+// or all if there are none, unless they match any of its exclude filters.
+type projectSelector struct {
+	include []projectFilter
+	exclude []projectFilter
+}
+
+func newProjectSelector(include []config.ProjectFilter, exclude []config.ProjectFilter) projectSelector {
+	var s projectSelector
+	for _, f := range include {
+		s.include = append(s.include, newProjectFilter(f))
+	}
+	for _, f := range exclude {
+		s.exclude = append(s.exclude, newProjectFilter(f))
+	}
+	return s
+}
+
+func (s projectSelector) selects(p *rest.Project, now time.Time) bool {
+	matches := func(f projectFilter) bool {
+		return f.matches(p, now)
+	}
+
+	if len(s.include) > 0 && !slices.ContainsFunc(s.include, matches) {
+		return false
+	}
+	return !slices.ContainsFunc(s.exclude, matches)
+}
+
+// compileGlob turns a path pattern into a regular expression matching the
+// whole path, where `*` matches any characters but `/`, `**` matches any
+// characters and `**/` matches any number of leading path segments.
+func compileGlob(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+		case pattern[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
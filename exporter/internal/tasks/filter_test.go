@@ -0,0 +1,106 @@
+package tasks
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+)
+
+func TestCompileGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"platform/**/service-*", "platform/service-a", true},
+		{"platform/**/service-*", "platform/team/sub/service-b", true},
+		{"platform/**/service-*", "platform/team/api", false},
+		{"platform/**/service-*", "other/service-a", false},
+		{"platform/*", "platform/service-a", true},
+		{"platform/*", "platform/team/service-a", false},
+		{"platform/**", "platform/team/service-a", true},
+		{"group/project", "group/project", true},
+		{"group/project", "group/project-2", false},
+		{"group/project-?", "group/project-2", true},
+		{"group.io/*", "groupxio/project", false},
+	}
+
+	for _, tt := range tests {
+		if got := compileGlob(tt.pattern).MatchString(tt.path); got != tt.want {
+			t.Errorf("glob %q matching %q = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestProjectSelector(t *testing.T) {
+	now := time.Now()
+	ago := func(days int) *time.Time {
+		t := now.Add(-time.Duration(days) * 24 * time.Hour)
+		return &t
+	}
+
+	projects := []*rest.Project{
+		{ID: 1, PathWithNamespace: "platform/team/service-a", Topics: []string{"go", "ci"}, LastActivityAt: ago(1)},
+		{ID: 2, PathWithNamespace: "platform/team/service-b", Topics: []string{"go"}, LastActivityAt: ago(60)},
+		{ID: 3, PathWithNamespace: "platform/service-c", Archived: true, LastActivityAt: ago(1)},
+		{ID: 4, PathWithNamespace: "platform/docs", Topics: []string{"ci"}, LastActivityAt: ago(1)},
+	}
+
+	tests := []struct {
+		name    string
+		include []config.ProjectFilter
+		exclude []config.ProjectFilter
+		want    []int
+	}{
+		{
+			name: "all",
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name:    "path",
+			include: []config.ProjectFilter{{Path: "platform/**/service-*"}},
+			want:    []int{1, 2, 3},
+		},
+		{
+			name:    "path and activity",
+			include: []config.ProjectFilter{{Path: "platform/**/service-*", ActiveWithinDays: 30}},
+			want:    []int{1, 3},
+		},
+		{
+			name:    "any include",
+			include: []config.ProjectFilter{{Topics: []string{"go", "ci"}}, {Path: "platform/docs"}},
+			want:    []int{1, 4},
+		},
+		{
+			name:    "exclude archived",
+			exclude: []config.ProjectFilter{{Archived: &[]bool{true}[0]}},
+			want:    []int{1, 2, 4},
+		},
+		{
+			name:    "include and exclude",
+			include: []config.ProjectFilter{{Topics: []string{"ci"}}},
+			exclude: []config.ProjectFilter{{Path: "platform/docs"}},
+			want:    []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newProjectSelector(tt.include, tt.exclude)
+
+			var got []int
+			for _, p := range projects {
+				if s.selects(p, now) {
+					got = append(got, p.ID)
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("want projects %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/graphql"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+// FetchProjectsPipelinesJobArtifacts fetches the job artifacts of the given
+// pipelines concurrently and yields the artifacts of each pipeline as soon as
+// they are available.
+func FetchProjectsPipelinesJobArtifacts(ctx context.Context, glab *gitlab.Client, pipelines []types.Pipeline) iter.Seq2[[]types.JobArtifact, error] {
+	return fetchEach(ctx, glab, pipelines, func(ctx context.Context, p types.Pipeline) ([]types.JobArtifact, error) {
+		return FetchProjectPipelineJobArtifacts(ctx, glab, p.Project.FullPath, strconv.FormatInt(p.Iid, 10))
+	})
+}
+
+func FetchProjectPipelineJobArtifacts(ctx context.Context, glab *gitlab.Client, projectPath string, pipelineIid string) ([]types.JobArtifact, error) {
+	afs, err := glab.GraphQL.GetProjectPipelineJobsArtifacts(ctx, projectPath, pipelineIid)
+	if err != nil {
+		return nil, fmt.Errorf("get project pipeline job artifacts: %w", err)
+	}
+
+	return convertJobArtifacts(afs)
+}
+
+// FetchProjectsJobArtifacts fetches the artifacts of the jobs of the given
+// projects concurrently and yields them page by page as soon as they are
+// available. Artifacts that expired and are not locked are left out, as they
+// are removed by GitLab and do not change anymore.
+func FetchProjectsJobArtifacts(ctx context.Context, glab *gitlab.Client, projectPaths []string) iter.Seq2[[]types.JobArtifact, error] {
+	return fetchPages(ctx, glab, projectPaths, func(ctx context.Context, projectPath string, yield func([]types.JobArtifact, error) bool) {
+		if err := FetchProjectJobArtifacts(ctx, glab, projectPath, yield); err != nil {
+			yield(nil, err)
+		}
+	})
+}
+
+// FetchProjectJobArtifacts fetches the artifacts of the jobs of a project
+// that can still change and yields them page by page.
+func FetchProjectJobArtifacts(ctx context.Context, glab *gitlab.Client, projectPath string, yield func([]types.JobArtifact, error) bool) error {
+	now := time.Now()
+
+	err := glab.GraphQL.ListProjectJobsArtifacts(ctx, projectPath, func(afs []graphql.JobArtifactFields) bool {
+		artifacts, err := convertJobArtifacts(afs)
+		artifacts = slices.DeleteFunc(artifacts, func(a types.JobArtifact) bool {
+			return !a.Locked && a.ExpireAt != nil && a.ExpireAt.Before(now)
+		})
+		return yield(artifacts, err)
+	})
+	if err != nil {
+		return fmt.Errorf("get project job artifacts: %w", err)
+	}
+
+	return nil
+}
+
+func convertJobArtifacts(afs []graphql.JobArtifactFields) ([]types.JobArtifact, error) {
+	var errs error
+	artifacts := make([]types.JobArtifact, 0, len(afs))
+	for _, af := range afs {
+		artifact, err := graphql.ConvertJobArtifact(af)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("convert job artifact: %w", err))
+			continue
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, errs
+}
@@ -0,0 +1,88 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+// FetchMergeRequestsEvents fetches the resource events of the given merge
+// requests concurrently and yields the events of each merge request as soon
+// as they are available.
+func FetchMergeRequestsEvents(ctx context.Context, glab *gitlab.Client, mergeRequests []types.MergeRequestReference) iter.Seq2[[]types.MergeRequestEvent, error] {
+	return fetchEach(ctx, glab, mergeRequests, func(ctx context.Context, mr types.MergeRequestReference) ([]types.MergeRequestEvent, error) {
+		return FetchMergeRequestEvents(ctx, glab, mr)
+	})
+}
+
+// FetchMergeRequestEvents fetches all state, label and milestone events of a
+// merge request.
+func FetchMergeRequestEvents(ctx context.Context, glab *gitlab.Client, mr types.MergeRequestReference) ([]types.MergeRequestEvent, error) {
+	var events []types.MergeRequestEvent
+
+	stateEvents, err := glab.Rest.GetMergeRequestStateEvents(ctx, mr.Project.Id, mr.Iid)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d merge request %d state events: %w", mr.Project.Id, mr.Iid, err)
+	}
+	for _, e := range stateEvents {
+		events = append(events, rest.ConvertMergeRequestStateEvent(mr, e))
+	}
+
+	labelEvents, err := glab.Rest.GetMergeRequestLabelEvents(ctx, mr.Project.Id, mr.Iid)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d merge request %d label events: %w", mr.Project.Id, mr.Iid, err)
+	}
+	for _, e := range labelEvents {
+		events = append(events, rest.ConvertMergeRequestLabelEvent(mr, e))
+	}
+
+	milestoneEvents, err := glab.Rest.GetMergeRequestMilestoneEvents(ctx, mr.Project.Id, mr.Iid)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d merge request %d milestone events: %w", mr.Project.Id, mr.Iid, err)
+	}
+	for _, e := range milestoneEvents {
+		events = append(events, rest.ConvertMergeRequestMilestoneEvent(mr, e))
+	}
+
+	return events, nil
+}
+
+// mergeRequestNoteEventActions maps the types of system notes that have no
+// resource events to the kind and action of their merge request event.
+var mergeRequestNoteEventActions = map[string][2]string{
+	"Approved":             {"approval", "approved"},
+	"Unapproved":           {"approval", "unapproved"},
+	"ReviewRequested":      {"review", "requested"},
+	"ReviewRequestRemoved": {"review", "request_removed"},
+	"ChangesRequested":     {"review", "changes_requested"},
+	"MarkedDraft":          {"draft", "draft"},
+	"MarkedReady":          {"draft", "ready"},
+}
+
+// MergeRequestEventsFromNoteEvents returns the approval, review and draft
+// events of merge requests, which GitLab only records as system notes.
+func MergeRequestEventsFromNoteEvents(noteEvents []types.MergeRequestNoteEvent) []types.MergeRequestEvent {
+	var events []types.MergeRequestEvent
+	for _, ne := range noteEvents {
+		action, ok := mergeRequestNoteEventActions[ne.Type]
+		if !ok || !ne.System {
+			continue
+		}
+
+		events = append(events, types.MergeRequestEvent{
+			Id:           ne.Id,
+			MergeRequest: ne.MergeRequest,
+			Kind:         action[0],
+
+			CreatedAt: ne.CreatedAt,
+			User:      ne.Author,
+
+			Action: action[1],
+		})
+	}
+	return events
+}
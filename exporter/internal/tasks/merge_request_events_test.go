@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"testing"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestMergeRequestEventsFromNoteEvents(t *testing.T) {
+	mr := types.MergeRequestReference{Id: 42, Iid: 7, Project: types.ProjectReference{Id: 1}}
+	author := types.UserReference{Id: 3, Username: "reviewer"}
+
+	noteEvents := []types.MergeRequestNoteEvent{
+		{Id: 1, MergeRequest: mr, Type: "ReviewRequested", System: true, Author: author},
+		{Id: 2, MergeRequest: mr, Type: "", System: false, Author: author},
+		{Id: 3, MergeRequest: mr, Type: "Approved", System: true, Author: author},
+		{Id: 4, MergeRequest: mr, Type: "DescriptionChanged", System: true, Author: author},
+		{Id: 5, MergeRequest: mr, Type: "MarkedReady", System: true, Author: author},
+	}
+
+	want := []struct {
+		id     int64
+		kind   string
+		action string
+	}{
+		{1, "review", "requested"},
+		{3, "approval", "approved"},
+		{5, "draft", "ready"},
+	}
+
+	got := MergeRequestEventsFromNoteEvents(noteEvents)
+	if len(got) != len(want) {
+		t.Fatalf("want %d events, got %d: %v", len(want), len(got), got)
+	}
+	for i, w := range want {
+		e := got[i]
+		if e.Id != w.id || e.Kind != w.kind || e.Action != w.action {
+			t.Errorf("event %d: want {%d %s %s}, got {%d %s %s}", i, w.id, w.kind, w.action, e.Id, e.Kind, e.Action)
+		}
+		if e.MergeRequest != mr || e.User != author {
+			t.Errorf("event %d: want merge request %v by %v, got %v by %v", i, mr, author, e.MergeRequest, e.User)
+		}
+	}
+}
@@ -44,31 +44,55 @@ func FetchProjectsMergeRequests(ctx context.Context, glab *gitlab.Client, projec
 		}
 		mergeRequests = append(mergeRequests, mr)
 
-		if len(mrf.Commits) > 0 {
-			mrRef := types.MergeRequestReference{
-				Id:      mr.Id,
-				Iid:     mr.Iid,
-				Project: mr.Project,
-			}
-			for _, cf := range mrf.Commits {
-				mrc, err := graphql.ConvertMergeRequestCommit(mrRef, cf)
-				if err != nil {
-					slog.Error("error converting merge request commit fields",
-						slog.String("commit.sha", cf.Sha),
-						slog.String("merge_request.iid", mrf.Iid),
-						slog.String("merge_request.project.id", mrf.Project.Id),
-						slog.String("error", err.Error()),
-					)
-					continue
-				}
-				mergeRequestCommits = append(mergeRequestCommits, mrc)
-			}
-		}
+		mergeRequestCommits = append(mergeRequestCommits, convertMergeRequestCommits(mr, mrf)...)
 	}
 
 	return mergeRequests, mergeRequestCommits, err
 }
 
+func FetchProjectMergeRequest(ctx context.Context, glab *gitlab.Client, projectPath string, mergeRequestIid int64) (types.MergeRequest, []types.MergeRequestCommit, error) {
+	mrf, err := glab.GraphQL.GetProjectMergeRequest(ctx, projectPath, mergeRequestIid)
+	if err != nil {
+		return types.MergeRequest{}, nil, fmt.Errorf("get merge request fields: %w", err)
+	}
+
+	mr, err := graphql.ConvertMergeRequest(mrf)
+	if err != nil {
+		return types.MergeRequest{}, nil, fmt.Errorf("convert merge request fields: %w", err)
+	}
+
+	return mr, convertMergeRequestCommits(mr, mrf), nil
+}
+
+func convertMergeRequestCommits(mr types.MergeRequest, mrf graphql.MergeRequestFields) []types.MergeRequestCommit {
+	if len(mrf.Commits) == 0 {
+		return nil
+	}
+
+	mrRef := types.MergeRequestReference{
+		Id:      mr.Id,
+		Iid:     mr.Iid,
+		Project: mr.Project,
+	}
+
+	commits := make([]types.MergeRequestCommit, 0, len(mrf.Commits))
+	for _, cf := range mrf.Commits {
+		mrc, err := graphql.ConvertMergeRequestCommit(mrRef, cf)
+		if err != nil {
+			slog.Error("error converting merge request commit fields",
+				slog.String("commit.sha", cf.Sha),
+				slog.String("merge_request.iid", mrf.Iid),
+				slog.String("merge_request.project.id", mrf.Project.Id),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		commits = append(commits, mrc)
+	}
+
+	return commits
+}
+
 func FetchProjectsMergeRequestsNotes(ctx context.Context, glab *gitlab.Client, projectIds []int64, updatedAfter *time.Time, updatedBefore *time.Time) ([]types.MergeRequestNoteEvent, error) {
 	gids := make([]string, 0, len(projectIds))
 	for _, id := range projectIds {
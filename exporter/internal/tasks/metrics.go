@@ -0,0 +1,122 @@
+package tasks
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+)
+
+type metrics struct {
+	gitlab *gitlab.Client
+
+	exportDuration  *prometheus.HistogramVec
+	fetchedRecords  *prometheus.CounterVec
+	exportedRecords *prometheus.CounterVec
+	lastExport      *prometheus.GaugeVec
+	jobLogBytesDesc *prometheus.Desc
+	truncatedDesc   *prometheus.Desc
+}
+
+func newMetrics(glab *gitlab.Client) *metrics {
+	return &metrics{
+		gitlab: glab,
+
+		exportDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_exporter_export_duration_seconds",
+			Help:    "Duration of fetching and exporting one kind of data for a batch of projects.",
+			Buckets: prometheus.ExponentialBuckets(0.5, 2, 12), // 0.5s to ~17m
+		}, []string{"kind"}),
+		fetchedRecords: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_exporter_fetched_records_total",
+			Help: "Total number of records fetched from GitLab by kind of data and project.",
+		}, []string{"kind", "project_id"}),
+		exportedRecords: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_exporter_exported_records_total",
+			Help: "Total number of records exported to all recorders without errors by kind of data and project.",
+		}, []string{"kind", "project_id"}),
+		lastExport: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gitlab_exporter_last_successful_export_timestamp_seconds",
+			Help: "Time up to which the data of a project was last exported without errors.",
+		}, []string{"project_id"}),
+		jobLogBytesDesc: prometheus.NewDesc(
+			"gitlab_exporter_job_log_bytes_total",
+			"Total number of job log bytes downloaded from GitLab.",
+			nil, nil,
+		),
+		truncatedDesc: prometheus.NewDesc(
+			"gitlab_exporter_job_logs_truncated_total",
+			"Total number of job logs that were not parsed completely because they exceed the maximum size.",
+			nil, nil,
+		),
+	}
+}
+
+// MetricsCollector returns a collector for the export progress metrics.
+func (c *Controller) MetricsCollector() prometheus.Collector {
+	return c.metrics
+}
+
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.exportDuration.Describe(ch)
+	m.fetchedRecords.Describe(ch)
+	m.exportedRecords.Describe(ch)
+	m.lastExport.Describe(ch)
+	ch <- m.jobLogBytesDesc
+	ch <- m.truncatedDesc
+}
+
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	m.exportDuration.Collect(ch)
+	m.fetchedRecords.Collect(ch)
+	m.exportedRecords.Collect(ch)
+	m.lastExport.Collect(ch)
+
+	var jobLogBytes, truncated uint64
+	if m.gitlab != nil {
+		jobLogBytes = m.gitlab.Rest.JobLogBytes()
+		truncated = m.gitlab.Rest.JobLogsTruncated()
+	}
+	ch <- prometheus.MustNewConstMetric(m.jobLogBytesDesc, prometheus.CounterValue, float64(jobLogBytes))
+	ch <- prometheus.MustNewConstMetric(m.truncatedDesc, prometheus.CounterValue, float64(truncated))
+}
+
+// observeDuration records the time passed since start for the given kind of
+// data. Meant to be deferred:
+//
+//	defer c.metrics.observeDuration("pipelines", time.Now())
+func (m *metrics) observeDuration(kind string, start time.Time) {
+	m.exportDuration.WithLabelValues(kind).Observe(time.Since(start).Seconds())
+}
+
+// setLastExport records the time up to which the data of the given projects
+// was exported.
+func (m *metrics) setLastExport(projects []ProjectSettings, updatedBefore time.Time) {
+	for _, ps := range projects {
+		m.lastExport.WithLabelValues(strconv.FormatInt(ps.Id, 10)).Set(float64(updatedBefore.Unix()))
+	}
+}
+
+// observeRecords counts the fetched records of the given kind of data per
+// project, and as exported as well unless exporting them failed. Data that
+// does not belong to a project is counted without a project if projectId is
+// nil.
+func observeRecords[T any](m *metrics, kind string, data []T, projectId func(T) int64, exportErr error) {
+	counts := make(map[string]int)
+	for _, d := range data {
+		var label string
+		if projectId != nil {
+			label = strconv.FormatInt(projectId(d), 10)
+		}
+		counts[label]++
+	}
+
+	for label, n := range counts {
+		m.fetchedRecords.WithLabelValues(kind, label).Add(float64(n))
+		if exportErr == nil {
+			m.exportedRecords.WithLabelValues(kind, label).Add(float64(n))
+		}
+	}
+}
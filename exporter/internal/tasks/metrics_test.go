@@ -0,0 +1,68 @@
+package tasks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestMetrics(t *testing.T) {
+	m := newMetrics(nil)
+
+	pipelineProject := func(p types.Pipeline) int64 { return p.Project.Id }
+	observeRecords(m, "pipelines", []types.Pipeline{
+		{Id: 1, Project: types.ProjectReference{Id: 42}},
+		{Id: 2, Project: types.ProjectReference{Id: 42}},
+		{Id: 3, Project: types.ProjectReference{Id: 7}},
+	}, pipelineProject, nil)
+	observeRecords(m, "pipelines", []types.Pipeline{
+		{Id: 4, Project: types.ProjectReference{Id: 7}},
+	}, pipelineProject, errors.New("export failed"))
+	observeRecords(m, "runners", []types.Runner{{Id: 1}}, nil, nil)
+
+	m.setLastExport([]ProjectSettings{{Id: 42}}, time.Unix(1700000000, 0))
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(m)
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make(map[string]float64)
+	for _, mf := range mfs {
+		for _, metric := range mf.GetMetric() {
+			labels := make([]string, 0, len(metric.GetLabel()))
+			for _, l := range metric.GetLabel() {
+				labels = append(labels, l.GetName()+"="+l.GetValue())
+			}
+			name := mf.GetName() + "{" + strings.Join(labels, ",") + "}"
+			if metric.GetCounter() != nil {
+				got[name] = metric.GetCounter().GetValue()
+			} else {
+				got[name] = metric.GetGauge().GetValue()
+			}
+		}
+	}
+
+	want := map[string]float64{
+		"gitlab_exporter_fetched_records_total{kind=pipelines,project_id=42}":     2,
+		"gitlab_exporter_fetched_records_total{kind=pipelines,project_id=7}":      2,
+		"gitlab_exporter_fetched_records_total{kind=runners,project_id=}":         1,
+		"gitlab_exporter_exported_records_total{kind=pipelines,project_id=42}":    2,
+		"gitlab_exporter_exported_records_total{kind=pipelines,project_id=7}":     1,
+		"gitlab_exporter_exported_records_total{kind=runners,project_id=}":        1,
+		"gitlab_exporter_last_successful_export_timestamp_seconds{project_id=42}": 1700000000,
+		"gitlab_exporter_job_log_bytes_total{}":                                   0,
+		"gitlab_exporter_job_logs_truncated_total{}":                              0,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("metrics mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -1,14 +1,17 @@
 package tasks
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log/slog"
+	"maps"
+	"strconv"
 	"sync"
 	"time"
 
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/failure"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/graphql"
 	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
@@ -51,7 +54,17 @@ func FetchProjectsPipelines(ctx context.Context, glab *gitlab.Client, projectIds
 	return pipelines, err
 }
 
-func FetchProjectsPipelinesJobs(ctx context.Context, glab *gitlab.Client, projectIds []int64, updatedAfter *time.Time, updatedBefore *time.Time) ([]types.Job, error) {
+// FetchProjectsPipelinesJobs fetches the jobs of the pipelines of the given
+// projects concurrently and yields the jobs of each project as soon as they
+// are available, so that only the jobs of the projects being fetched are held
+// in memory.
+func FetchProjectsPipelinesJobs(ctx context.Context, glab *gitlab.Client, projectIds []int64, updatedAfter *time.Time, updatedBefore *time.Time) iter.Seq2[[]types.Job, error] {
+	return fetchEach(ctx, glab, projectIds, func(ctx context.Context, projectId int64) ([]types.Job, error) {
+		return fetchProjectsPipelinesJobs(ctx, glab, []int64{projectId}, updatedAfter, updatedBefore)
+	})
+}
+
+func fetchProjectsPipelinesJobs(ctx context.Context, glab *gitlab.Client, projectIds []int64, updatedAfter *time.Time, updatedBefore *time.Time) ([]types.Job, error) {
 	gids := make([]string, 0, len(projectIds))
 	for _, id := range projectIds {
 		gids = append(gids, graphql.FormatId(id, graphql.GlobalIdProjectPrefix))
@@ -91,91 +104,103 @@ func FetchProjectsPipelinesJobs(ctx context.Context, glab *gitlab.Client, projec
 	return jobs, err
 }
 
-type FetchProjectsJobsLogDataOptions struct {
-	ProjectJobLogQueries map[int64][]logql.MetricQuery
-}
+func FetchProjectPipeline(ctx context.Context, glab *gitlab.Client, projectId int64, pipelineId int64) (types.Pipeline, error) {
+	projectGid := graphql.FormatId(projectId, graphql.GlobalIdProjectPrefix)
+	pipelineGid := graphql.FormatId(pipelineId, graphql.GlobalIdPipelinePrefix)
 
-func FetchProjectsJobsLogData(ctx context.Context, glab *gitlab.Client, jobs []types.Job, opts FetchProjectsJobsLogDataOptions) ([]types.Section, []types.Metric, map[int64][]types.JobLogProperty, error) {
-	var (
-		sections   []types.Section
-		metrics    []types.Metric
-		properties = make(map[int64][]types.JobLogProperty)
-	)
+	pf, err := glab.GraphQL.GetProjectPipeline(ctx, projectGid, pipelineGid)
+	if err != nil {
+		return types.Pipeline{}, fmt.Errorf("get pipeline fields: %w", err)
+	}
 
-	type result struct {
-		jobId int64
+	p, err := graphql.ConvertPipeline(pf)
+	if err != nil {
+		return types.Pipeline{}, fmt.Errorf("convert pipeline fields: %w", err)
+	}
 
-		sections   []types.Section
-		metrics    []types.Metric
-		properties []types.JobLogProperty
+	return p, nil
+}
 
-		err error
+func FetchProjectPipelineJobs(ctx context.Context, glab *gitlab.Client, projectPath string, pipelineIid int64) ([]types.Job, error) {
+	jobsFields, err := glab.GraphQL.GetProjectPipelineJobs(ctx, projectPath, strconv.FormatInt(pipelineIid, 10))
+	if errors.Is(err, context.Canceled) {
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("get project pipeline jobs: %w", err)
 	}
 
-	var (
-		wg      sync.WaitGroup
-		results = make(chan result, len(jobs))
-	)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for _, job := range jobs {
-			if err := glab.Acquire(ctx, 1); err != nil {
-				slog.Error("failed to acquire gitlab client", "error", err)
-				break
-			}
-			wg.Add(1)
-			go func() {
-				defer glab.Release(1)
-				defer wg.Done()
+	jobs := make([]types.Job, 0, len(jobsFields))
+	for _, jf := range jobsFields {
+		j, err := graphql.ConvertJob(jf)
+		if err != nil {
+			slog.Error("error converting job fields",
+				slog.String("id", *jf.Id),
+				slog.String("pipelineId", jf.Pipeline.Id),
+				slog.String("projectId", jf.Project.Id),
+				slog.String("error", err.Error()),
+			)
+			continue
+		}
+		jobs = append(jobs, j)
+	}
 
-				var opt = FetchProjectJobLogDataOptions{
-					Queries: opts.ProjectJobLogQueries[job.Pipeline.Project.Id],
-				}
+	return jobs, nil
+}
 
-				var r result
-				r.jobId = job.Id
-				r.sections, r.metrics, r.properties, r.err = FetchProjectJobLogData(ctx, glab, job, opt)
-				results <- r
-			}()
-		}
-	}()
+type FetchProjectsJobsLogDataOptions struct {
+	ProjectJobLogQueries map[int64][]logql.MetricQuery
+	// Projects of which only job properties are exported, for those only the
+	// tail of the logs is fetched if Log.TailSize is set, except for the logs
+	// of failed jobs that are classified
+	ProjectPropertiesOnly map[int64]bool
+	// Classifiers of the projects whose failed jobs are classified
+	ProjectFailureClassifiers map[int64]*failure.Classifier
+
+	Log rest.JobLogOptions
+}
 
-	done := make(chan struct{})
-	go func() {
-		defer close(done)
-		wg.Wait()
-	}()
+// JobLogData holds the data extracted from the log of a job.
+type JobLogData struct {
+	JobId int64
 
-	var errs error
-loop:
-	for {
-		select {
-		case <-done:
-			break loop
-		case r := <-results:
-			if r.err != nil {
-				errs = errors.Join(errs, r.err)
-			}
-			sections = append(sections, r.sections...)
-			metrics = append(metrics, r.metrics...)
-			properties[r.jobId] = append(properties[r.jobId], r.properties...)
+	Sections   []types.Section
+	Metrics    []types.Metric
+	Properties []types.JobLogProperty
+
+	// Failure is the classification of a failed job, if any rule matched.
+	Failure *failure.Result
+}
+
+// FetchProjectsJobsLogData fetches the logs of the given jobs concurrently and
+// yields the data extracted from each log as soon as it is available.
+func FetchProjectsJobsLogData(ctx context.Context, glab *gitlab.Client, jobs []types.Job, opts FetchProjectsJobsLogDataOptions) iter.Seq2[JobLogData, error] {
+	return fetchEach(ctx, glab, jobs, func(ctx context.Context, job types.Job) (JobLogData, error) {
+		var opt = FetchProjectJobLogDataOptions{
+			Queries: opts.ProjectJobLogQueries[job.Pipeline.Project.Id],
+			Log:     opts.Log,
+		}
+		if job.Status == "failed" {
+			opt.FailureClassifier = opts.ProjectFailureClassifiers[job.Pipeline.Project.Id]
+		}
+		// rules can match any line, so failures are classified by the
+		// entire log
+		if !opts.ProjectPropertiesOnly[job.Pipeline.Project.Id] || opt.FailureClassifier != nil {
+			opt.Log.TailSize = 0
 		}
-	}
 
-	return sections, metrics, properties, errs
+		return FetchProjectJobLogData(ctx, glab, job, opt)
+	})
 }
 
 type FetchProjectJobLogDataOptions struct {
 	Queries []logql.MetricQuery
+	// FailureClassifier classifies the job by its log, if set
+	FailureClassifier *failure.Classifier
+	Log               rest.JobLogOptions
 }
 
-func FetchProjectJobLogData(ctx context.Context, glab *gitlab.Client, job types.Job, opts FetchProjectJobLogDataOptions) ([]types.Section, []types.Metric, []types.JobLogProperty, error) {
-	var (
-		sections   []types.Section
-		metrics    []types.Metric
-		properties []types.JobLogProperty
-	)
+func FetchProjectJobLogData(ctx context.Context, glab *gitlab.Client, job types.Job, opts FetchProjectJobLogDataOptions) (JobLogData, error) {
+	data := JobLogData{JobId: job.Id}
 
 	jobRef := types.JobReference{
 		Id:       job.Id,
@@ -183,16 +208,37 @@ func FetchProjectJobLogData(ctx context.Context, glab *gitlab.Client, job types.
 		Pipeline: job.Pipeline,
 	}
 
-	log, err := glab.Rest.GetJobLog(ctx, job.Pipeline.Project.Id, job.Id)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("get job log: %w", err)
-	} else if log == nil {
-		return nil, nil, nil, nil
+	evaluators := make([]*logql.Evaluator, 0, len(opts.Queries))
+	for _, query := range opts.Queries {
+		evaluators = append(evaluators, query.Query.Evaluator())
+	}
+
+	var matcher *failure.Matcher
+	if opts.FailureClassifier != nil {
+		matcher = opts.FailureClassifier.Matcher()
 	}
 
-	logData, err := rest.ParseJobLog(log)
+	logOpts := opts.Log
+	if len(evaluators) > 0 || matcher != nil {
+		logOpts.LineFunc = func(line []byte) {
+			for _, e := range evaluators {
+				e.Add(line)
+			}
+			if matcher != nil {
+				matcher.Add(line)
+			}
+		}
+	}
+	logData, err := glab.Rest.GetJobLogData(ctx, job.Pipeline.Project.Id, job.Id, logOpts)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("parse job log: %w", err)
+		return data, fmt.Errorf("get job log data: %w", err)
+	}
+	if logData.Truncated {
+		slog.Warn("job log exceeds maximum size, skipped the rest",
+			slog.Int64("projectId", job.Pipeline.Project.Id),
+			slog.Int64("jobId", job.Id),
+			slog.Int64("maxSize", opts.Log.MaxSize),
+		)
 	}
 
 	var jobFinishedAtUnix int64 = 0
@@ -214,11 +260,11 @@ func FetchProjectJobLogData(ctx context.Context, glab *gitlab.Client, job types.
 			Duration:   time.Duration((secdat.End - secdat.Start) * int64(time.Second)),
 		}
 
-		sections = append(sections, section)
+		data.Sections = append(data.Sections, section)
 	}
 
 	for iid, m := range logData.Metrics {
-		metrics = append(metrics, types.Metric{
+		data.Metrics = append(data.Metrics, types.Metric{
 			Id:  fmt.Sprintf("%d-%d", jobRef.Id, iid+1),
 			Iid: int64(iid + 1),
 			Job: jobRef,
@@ -231,31 +277,27 @@ func FetchProjectJobLogData(ctx context.Context, glab *gitlab.Client, job types.
 	}
 
 	for _, p := range logData.Properties {
-		properties = append(properties, types.JobLogProperty{
+		data.Properties = append(data.Properties, types.JobLogProperty{
 			Name:  p.Name,
 			Value: p.Value,
 		})
 	}
 
-	if _, err := log.Seek(0, 0); err != nil {
-		return sections, metrics, properties, fmt.Errorf("rewind job log: %w", err)
-	}
-	logqlMetrics, err := queryJobLogQLMetrics(log, opts.Queries, jobRef, int64(len(logData.Metrics)))
-	if err != nil {
-		return sections, metrics, properties, fmt.Errorf("query job logql metrics: %w", err)
-	}
+	logqlMetrics := queryJobLogQLMetrics(evaluators, opts.Queries, jobRef, int64(len(logData.Metrics)))
 	if job.FinishedAt != nil && !job.FinishedAt.IsZero() {
 		for i := range len(logqlMetrics) {
 			logqlMetrics[i].Timestamp = job.FinishedAt.UnixMilli()
 		}
 	}
-	for _, m := range logqlMetrics {
-		if m.Value > 0 {
-			metrics = append(metrics, m)
+	data.Metrics = append(data.Metrics, logqlMetrics...)
+
+	if matcher != nil {
+		if result, ok := matcher.Result(); ok {
+			data.Failure = &result
 		}
 	}
 
-	return sections, metrics, properties, nil
+	return data, nil
 }
 
 // convertSectionTimestamp converts a timestamp from a job log section to time.Time.
@@ -295,33 +337,35 @@ func convertSectionTimestamp(ts int64) time.Time {
 	return time.Unix(0, ts)
 }
 
-func queryJobLogQLMetrics(log *bytes.Reader, queries []logql.MetricQuery, job types.JobReference, startIid int64) ([]types.Metric, error) {
-	filters := make([]logql.LineFilter, 0, len(queries))
-	for _, query := range queries {
-		filters = append(filters, query.LineFilter)
-	}
-
-	counts, err := logql.Count(log, filters)
-	if err != nil {
-		return nil, err
-	}
-
-	metrics := make([]types.Metric, 0, len(queries))
+func queryJobLogQLMetrics(evaluators []*logql.Evaluator, queries []logql.MetricQuery, job types.JobReference, startIid int64) []types.Metric {
+	var metrics []types.Metric
+	iid := startIid
 	for i, query := range queries {
-		iid := startIid + 1 + int64(i)
-		metrics = append(metrics, types.Metric{
-			Id:  fmt.Sprintf("%d-%d", job.Id, iid),
-			Iid: iid,
-			Job: job,
-
-			Name:   query.Name,
-			Labels: query.LabelAdd,
-			Value:  float64(counts[i]),
-			// Timestamp: 0,
-		})
+		for _, sample := range evaluators[i].Samples() {
+			labels := sample.Labels
+			if len(query.LabelAdd) > 0 {
+				labels = maps.Clone(labels)
+				if labels == nil {
+					labels = make(map[string]string, len(query.LabelAdd))
+				}
+				maps.Copy(labels, query.LabelAdd)
+			}
+
+			iid++
+			metrics = append(metrics, types.Metric{
+				Id:  fmt.Sprintf("%d-%d", job.Id, iid),
+				Iid: iid,
+				Job: job,
+
+				Name:   query.Name,
+				Labels: labels,
+				Value:  sample.Value,
+				// Timestamp: 0,
+			})
+		}
 	}
 
-	return metrics, nil
+	return metrics
 }
 
 func FetchProjectsPipelinesTestReports(ctx context.Context, glab *gitlab.Client, pipelines []types.Pipeline) ([]types.TestReport, []types.TestSuite, []types.TestCase, error) {
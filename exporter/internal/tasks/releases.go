@@ -0,0 +1,137 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/gitlab/rest"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+// FetchProjectsTags fetches the tags of the given projects concurrently and
+// yields the tags of each project as soon as they are available.
+func FetchProjectsTags(ctx context.Context, glab *gitlab.Client, projectIds []int64) iter.Seq2[[]types.Tag, error] {
+	return fetchEach(ctx, glab, projectIds, func(ctx context.Context, projectId int64) ([]types.Tag, error) {
+		return FetchProjectTags(ctx, glab, projectId)
+	})
+}
+
+func FetchProjectTags(ctx context.Context, glab *gitlab.Client, projectId int64) ([]types.Tag, error) {
+	ts, err := glab.Rest.GetProjectTags(ctx, projectId)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d tags: %w", projectId, err)
+	}
+
+	tags := make([]types.Tag, 0, len(ts))
+	for _, t := range ts {
+		tags = append(tags, rest.ConvertTag(projectId, t))
+	}
+
+	return tags, nil
+}
+
+// FetchProjectsReleases fetches the releases of the given projects that were
+// created in the given time range concurrently and yields the releases of
+// each project as soon as they are available.
+func FetchProjectsReleases(ctx context.Context, glab *gitlab.Client, projectIds []int64, lastPipeline func(release types.Release) (*types.PipelineReference, bool), createdAfter *time.Time, createdBefore *time.Time) iter.Seq2[[]types.Release, error] {
+	return fetchEach(ctx, glab, projectIds, func(ctx context.Context, projectId int64) ([]types.Release, error) {
+		return FetchProjectReleases(ctx, glab, projectId, lastPipeline, createdAfter, createdBefore)
+	})
+}
+
+// FetchProjectReleases fetches the releases of a project that were created in
+// the given time range, linked to the latest pipeline that ran for the commit
+// of their tag.
+//
+// Resolving the pipeline requires requests per release, so if lastPipeline is
+// not nil, the pipeline it returns for a release is used instead.
+func FetchProjectReleases(ctx context.Context, glab *gitlab.Client, projectId int64, lastPipeline func(release types.Release) (*types.PipelineReference, bool), createdAfter *time.Time, createdBefore *time.Time) ([]types.Release, error) {
+	opt := rest.GetProjectReleasesOptions{
+		CreatedAfter:  createdAfter,
+		CreatedBefore: createdBefore,
+	}
+
+	rs, err := glab.Rest.GetProjectReleases(ctx, projectId, opt)
+	if err != nil {
+		return nil, fmt.Errorf("get project %d releases: %w", projectId, err)
+	}
+
+	releases := make([]types.Release, 0, len(rs))
+	for _, r := range rs {
+		release := rest.ConvertRelease(projectId, r)
+
+		if lastPipeline != nil {
+			if p, ok := lastPipeline(release); ok {
+				release.Pipeline = p
+				releases = append(releases, release)
+				continue
+			}
+		}
+
+		if release.CommitSha != "" {
+			p, err := glab.Rest.GetProjectCommitPipeline(ctx, projectId, release.CommitSha, release.TagName)
+			if err != nil {
+				return nil, fmt.Errorf("get project %d release %s pipeline: %w", projectId, release.TagName, err)
+			}
+			if p != nil {
+				release.Pipeline = &types.PipelineReference{
+					Id:  int64(p.ID),
+					Iid: int64(p.IID),
+					Project: types.ProjectReference{
+						Id: int64(p.ProjectID),
+					},
+				}
+			}
+		}
+
+		releases = append(releases, release)
+	}
+
+	return releases, nil
+}
+
+type releasePipelineKey struct {
+	projectId int64
+	tagName   string
+	commitSha string
+}
+
+// releasePipelines remembers the pipelines that releases were linked to, so
+// that they are only resolved again for new releases or when their tag was
+// moved to another commit.
+type releasePipelines struct {
+	mu        sync.Mutex
+	pipelines map[releasePipelineKey]*types.PipelineReference
+}
+
+func newReleasePipelines() *releasePipelines {
+	return &releasePipelines{
+		pipelines: make(map[releasePipelineKey]*types.PipelineReference),
+	}
+}
+
+func (rp *releasePipelines) get(release types.Release) (*types.PipelineReference, bool) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	p, ok := rp.pipelines[releasePipelineKey{release.Project.Id, release.TagName, release.CommitSha}]
+	return p, ok
+}
+
+// set remembers the pipeline of the release. Releases without a pipeline are
+// only remembered an hour after they were created, as the pipeline of their
+// tag may not have been created yet.
+func (rp *releasePipelines) set(release types.Release, now time.Time) {
+	if release.Pipeline == nil && (release.CreatedAt == nil || now.Sub(*release.CreatedAt) < time.Hour) {
+		return
+	}
+
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+
+	rp.pipelines[releasePipelineKey{release.Project.Id, release.TagName, release.CommitSha}] = release.Pipeline
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"testing"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/types"
+)
+
+func TestReleasePipelines(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-2 * time.Hour)
+	justCreated := now.Add(-time.Minute)
+
+	release := func(tagName string, commitSha string, createdAt *time.Time, pipelineId int64) types.Release {
+		r := types.Release{
+			TagName:   tagName,
+			Project:   types.ProjectReference{Id: 1},
+			CreatedAt: createdAt,
+			CommitSha: commitSha,
+		}
+		if pipelineId != 0 {
+			r.Pipeline = &types.PipelineReference{Id: pipelineId}
+		}
+		return r
+	}
+
+	rp := newReleasePipelines()
+	rp.set(release("v1", "a", &created, 10), now)
+	rp.set(release("v2", "b", &created, 0), now)
+	rp.set(release("v3", "c", &justCreated, 0), now)
+
+	tests := []struct {
+		name       string
+		release    types.Release
+		wantOk     bool
+		wantPipeId int64
+	}{
+		{"with pipeline", release("v1", "a", nil, 0), true, 10},
+		{"tag moved", release("v1", "b", nil, 0), false, 0},
+		{"without pipeline", release("v2", "b", nil, 0), true, 0},
+		{"without pipeline yet", release("v3", "c", nil, 0), false, 0},
+		{"new", release("v4", "d", nil, 0), false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, ok := rp.get(tt.release)
+			if ok != tt.wantOk {
+				t.Fatalf("get() ok = %v, want %v", ok, tt.wantOk)
+			}
+			var id int64
+			if p != nil {
+				id = p.Id
+			}
+			if id != tt.wantPipeId {
+				t.Errorf("get() pipeline = %d, want %d", id, tt.wantPipeId)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/exporter"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
+)
+
+// Reload applies the given configuration at runtime: the projects are
+// resolved again and the export loop picks up the new schedules. The
+// checkpoint store cannot be changed and is kept. Changes to the schedules
+// and the settings of the resolved projects are logged.
+func (c *Controller) Reload(ctx context.Context, cfg ControllerConfig) error {
+	setDefaults(&cfg)
+
+	c.configMutex.Lock()
+	old := c.config
+	oldBudget := c.budget
+	cfg.Checkpoints = old.Checkpoints
+	c.config = cfg
+	if cfg.Export.MemoryBudget != old.Export.MemoryBudget {
+		c.budget = exporter.NewBudget(cfg.Export.MemoryBudget)
+	}
+	c.configMutex.Unlock()
+
+	before := make(map[int64]ProjectSettings)
+	for _, ps := range c.projectsSettings.List(nil) {
+		before[ps.Id] = ps
+	}
+
+	n, err := c.ResolveProjects(ctx)
+	if err != nil {
+		c.configMutex.Lock()
+		c.config = old
+		c.budget = oldBudget
+		c.configMutex.Unlock()
+		return fmt.Errorf("resolve projects: %w", err)
+	}
+
+	logScheduleChanges(old.Schedule, cfg.Schedule)
+	if old.CatchUpInterval != cfg.CatchUpInterval {
+		slog.Info("[RELOAD] Catch up interval changed", "old", old.CatchUpInterval.String(), "new", cfg.CatchUpInterval.String())
+	}
+	if old.Export.Runners.Enabled != cfg.Export.Runners.Enabled {
+		slog.Info("[RELOAD] Runners export changed", "enabled", cfg.Export.Runners.Enabled)
+	}
+	if old.Export.MemoryBudget != cfg.Export.MemoryBudget || old.Export.FlushSize != cfg.Export.FlushSize {
+		slog.Info("[RELOAD] Export buffer changed", "memory_budget", cfg.Export.MemoryBudget, "flush_size", cfg.Export.FlushSize)
+	}
+
+	after := c.projectsSettings.List(nil)
+	for _, ps := range after {
+		prev, ok := before[ps.Id]
+		if !ok {
+			slog.Info("[RELOAD] Project added", "project_id", ps.Id, "path", ps.FullPath)
+			continue
+		}
+		delete(before, ps.Id)
+
+		changed := changedFields(prev.Export.ProjectExport, ps.Export.ProjectExport, "export")
+		changed = append(changed, changedFields(prev.CatchUp, ps.CatchUp, "catch_up")...)
+		if len(changed) > 0 {
+			slog.Info("[RELOAD] Project settings changed", "project_id", ps.Id, "path", ps.FullPath, "settings", strings.Join(changed, ","))
+		}
+	}
+	for _, ps := range before {
+		slog.Info("[RELOAD] Project removed", "project_id", ps.Id, "path", ps.FullPath)
+	}
+
+	// wake up the export loop to reset its tickers
+	select {
+	case c.reloaded <- struct{}{}:
+	default:
+	}
+
+	slog.Debug("[RELOAD] Resolved projects", "found", n)
+	return nil
+}
+
+func logScheduleChanges(prev, next Schedule) {
+	spec := func(s schedule.Schedule) string {
+		if s == nil {
+			return ""
+		}
+		return s.String()
+	}
+
+	for _, s := range []struct {
+		name       string
+		prev, next schedule.Schedule
+	}{
+		{"resolve", prev.Resolve, next.Resolve},
+		{"export", prev.Export, next.Export},
+		{"pipelines", prev.Pipelines, next.Pipelines},
+		{"merge_requests", prev.MergeRequests, next.MergeRequests},
+		{"issues", prev.Issues, next.Issues},
+		{"deployments", prev.Deployments, next.Deployments},
+		{"commits", prev.Commits, next.Commits},
+		{"releases", prev.Releases, next.Releases},
+		{"environments", prev.Environments, next.Environments},
+		{"job_artifacts", prev.JobArtifacts, next.JobArtifacts},
+		{"tags", prev.Tags, next.Tags},
+		{"runners", prev.Runners, next.Runners},
+	} {
+		if spec(s.prev) != spec(s.next) {
+			slog.Info("[RELOAD] Schedule changed", "schedule", s.name, "old", spec(s.prev), "new", spec(s.next))
+		}
+	}
+	if prev.Jitter != next.Jitter {
+		slog.Info("[RELOAD] Schedule jitter changed", "old", prev.Jitter.String(), "new", next.Jitter.String())
+	}
+}
+
+// changedFields returns the names of the fields that differ between two
+// values of the same struct type, using their yaml names where available.
+// Nested structs are compared field by field.
+func changedFields(a any, b any, prefix string) []string {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() != reflect.Struct || va.Type() != vb.Type() {
+		if !reflect.DeepEqual(a, b) {
+			return []string{prefix}
+		}
+		return nil
+	}
+
+	var changed []string
+	for i := range va.NumField() {
+		field := va.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		changed = append(changed, changedFields(va.Field(i).Interface(), vb.Field(i).Interface(), prefix+"."+name)...)
+	}
+	return changed
+}
@@ -0,0 +1,57 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/config"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
+)
+
+func TestChangedFields(t *testing.T) {
+	a := config.DefaultProjectSettings().Export
+	b := a
+	b.Issues.Enabled = !a.Issues.Enabled
+	b.Metrics.LogQueries = append(b.Metrics.LogQueries, config.ProjectExportMetricsLogQuery{Name: "errors"})
+
+	want := []string{"export.issues.enabled", "export.metrics.log_queries"}
+	if diff := cmp.Diff(want, changedFields(a, b, "export")); diff != "" {
+		t.Errorf("changed fields mismatch (-want, +got):\n%s", diff)
+	}
+	if got := changedFields(a, a, "export"); len(got) > 0 {
+		t.Errorf("want no changed fields, got %v", got)
+	}
+}
+
+func TestController_Reload(t *testing.T) {
+	c := NewController(nil, nil, ControllerConfig{
+		Schedule: Schedule{Export: schedule.Every(time.Minute)},
+	})
+	c.projectsSettings.Add(42, ProjectSettings{Id: 42, FullPath: "group/project"})
+
+	err := c.Reload(context.Background(), ControllerConfig{
+		Schedule: Schedule{Export: schedule.Every(2 * time.Minute)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.currentConfig().Schedule.Export.String(); got != "2m0s" {
+		t.Errorf("want export schedule 2m, got %s", got)
+	}
+	if got := c.currentConfig().Schedule.Resolve.String(); got != "30m0s" {
+		t.Errorf("want default resolve schedule, got %s", got)
+	}
+	if n := c.projectsSettings.Len(); n != 0 {
+		t.Errorf("want projects no longer configured to be removed, got %d", n)
+	}
+
+	select {
+	case <-c.reloaded:
+	default:
+		t.Error("want export loop to be notified")
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"log/slog"
 	"sync"
 
@@ -20,78 +21,42 @@ import (
 // # Test Reports (JUnit)
 // ############################################################################
 
-func FetchProjectsPipelinesJunitReports(ctx context.Context, glab *gitlab.Client, projectPipelines map[string][]string, projectArtifactPaths map[string][]string) ([]types.TestReport, []types.TestSuite, []types.TestCase, error) {
-	var (
-		testReports []types.TestReport
-		testSuites  []types.TestSuite
-		testCases   []types.TestCase
-	)
-
-	type result struct {
-		testReports []types.TestReport
-		testSuites  []types.TestSuite
-		testCases   []types.TestCase
+// TestReports holds the test reports of a pipeline.
+type TestReports struct {
+	TestReports []types.TestReport
+	TestSuites  []types.TestSuite
+	TestCases   []types.TestCase
+}
 
-		err error
+// FetchProjectsPipelinesJunitReports fetches the junit reports of the given
+// pipelines concurrently and yields the reports of each pipeline as soon as
+// they are available.
+func FetchProjectsPipelinesJunitReports(ctx context.Context, glab *gitlab.Client, projectPipelines map[string][]string, projectArtifactPaths map[string][]string) iter.Seq2[TestReports, error] {
+	type pipeline struct {
+		projectPath   string
+		pipelineIid   string
+		artifactPaths []string
 	}
 
-	var (
-		wg      sync.WaitGroup
-		results = make(chan result)
-	)
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-
-		for projectPath, pipelineIids := range projectPipelines {
-			artifactPaths := projectArtifactPaths[projectPath]
-			for _, pipelineIid := range pipelineIids {
-				if err := glab.Acquire(ctx, 1); err != nil {
-					slog.Error("failed to acquire gitlab client", "error", err)
-					continue
-				}
-				wg.Add(1)
-				go func(projectPath string, pipelineIid string, artifactPaths []string) {
-					defer glab.Release(1)
-					defer wg.Done()
-
-					tr, ts, tc, err := FetchProjectPipelineJunitReports(ctx, glab, projectPath, pipelineIid, artifactPaths)
-
-					results <- result{
-						testReports: tr,
-						testSuites:  ts,
-						testCases:   tc,
-						err:         err,
-					}
-				}(projectPath, pipelineIid, artifactPaths)
-			}
-		}
-	}()
-
-	done := make(chan struct{})
-	go func() {
-		defer close(done)
-		wg.Wait()
-	}()
-
-	var errs error
-loop:
-	for {
-		select {
-		case <-done:
-			break loop
-		case r := <-results:
-			if r.err != nil {
-				errs = errors.Join(errs, r.err)
-			} else {
-				testReports = append(testReports, r.testReports...)
-				testSuites = append(testSuites, r.testSuites...)
-				testCases = append(testCases, r.testCases...)
-			}
+	var pipelines []pipeline
+	for projectPath, pipelineIids := range projectPipelines {
+		for _, pipelineIid := range pipelineIids {
+			pipelines = append(pipelines, pipeline{
+				projectPath:   projectPath,
+				pipelineIid:   pipelineIid,
+				artifactPaths: projectArtifactPaths[projectPath],
+			})
 		}
 	}
 
-	return testReports, testSuites, testCases, errs
+	return fetchEach(ctx, glab, pipelines, func(ctx context.Context, p pipeline) (TestReports, error) {
+		var (
+			r   TestReports
+			err error
+		)
+		r.TestReports, r.TestSuites, r.TestCases, err = FetchProjectPipelineJunitReports(ctx, glab, p.projectPath, p.pipelineIid, p.artifactPaths)
+		return r, err
+	})
 }
 
 func FetchProjectPipelineJunitReports(ctx context.Context, glab *gitlab.Client, projectPath string, pipelineIid string, artifactPaths []string) ([]types.TestReport, []types.TestSuite, []types.TestCase, error) {
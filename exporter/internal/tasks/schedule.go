@@ -0,0 +1,327 @@
+package tasks
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/checkpoint"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/metaerr"
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/schedule"
+)
+
+// Schedule determines when projects are resolved and each kind of data is
+// fetched. Kinds of data without a schedule of their own are fetched on the
+// export schedule.
+type Schedule struct {
+	Resolve schedule.Schedule
+	Export  schedule.Schedule
+
+	Pipelines     schedule.Schedule
+	MergeRequests schedule.Schedule
+	Issues        schedule.Schedule
+	Deployments   schedule.Schedule
+	Commits       schedule.Schedule
+	Releases      schedule.Schedule
+	Environments  schedule.Schedule
+	JobArtifacts  schedule.Schedule
+	Tags          schedule.Schedule
+	Runners       schedule.Schedule
+
+	// Maximum random delay added to each run
+	Jitter time.Duration
+}
+
+// exportKinds selects the kinds of project related data to process.
+type exportKinds struct {
+	projects bool
+	kinds    []checkpoint.Kind
+	// inRange fetches the commits committed in the time range instead of
+	// those pushed since the last exported branch heads, to catch up
+	inRange bool
+}
+
+func allExportKinds() exportKinds {
+	return exportKinds{projects: true, kinds: checkpoint.Kinds()}
+}
+
+func (k exportKinds) has(kind checkpoint.Kind) bool {
+	return slices.Contains(k.kinds, kind)
+}
+
+func (k exportKinds) empty() bool {
+	return !k.projects && len(k.kinds) == 0
+}
+
+// job fetches and exports the selected kinds of data on a schedule.
+type job struct {
+	name     string
+	schedule schedule.Schedule
+
+	kinds        exportKinds
+	environments bool
+	jobArtifacts bool
+	tags         bool
+	runners      bool
+}
+
+// jobs groups the kinds of data by schedule, so that kinds sharing a
+// schedule are fetched together. The first job is the one on the export
+// schedule.
+func (s Schedule) jobs(runners bool) []job {
+	jobs := []job{{
+		name:     "export",
+		schedule: s.Export,
+		kinds:    exportKinds{projects: true},
+	}}
+	find := func(sched schedule.Schedule) *job {
+		if sched == nil {
+			sched = s.Export
+		}
+		i := slices.IndexFunc(jobs, func(j job) bool {
+			return j.schedule.String() == sched.String()
+		})
+		if i < 0 {
+			jobs = append(jobs, job{schedule: sched})
+			i = len(jobs) - 1
+		}
+		return &jobs[i]
+	}
+
+	for _, k := range []struct {
+		kind     checkpoint.Kind
+		schedule schedule.Schedule
+	}{
+		{checkpoint.KindPipelines, s.Pipelines},
+		{checkpoint.KindMergeRequests, s.MergeRequests},
+		{checkpoint.KindIssues, s.Issues},
+		{checkpoint.KindDeployments, s.Deployments},
+		{checkpoint.KindCommits, s.Commits},
+		{checkpoint.KindReleases, s.Releases},
+	} {
+		j := find(k.schedule)
+		j.kinds.kinds = append(j.kinds.kinds, k.kind)
+	}
+	find(s.Environments).environments = true
+	find(s.JobArtifacts).jobArtifacts = true
+	find(s.Tags).tags = true
+	if runners {
+		find(s.Runners).runners = true
+	}
+
+	for i := range jobs[1:] {
+		j := &jobs[i+1]
+		var names []string
+		for _, kind := range j.kinds.kinds {
+			names = append(names, string(kind))
+		}
+		if j.environments {
+			names = append(names, "environments")
+		}
+		if j.jobArtifacts {
+			names = append(names, "job_artifacts")
+		}
+		if j.tags {
+			names = append(names, "tags")
+		}
+		if j.runners {
+			names = append(names, "runners")
+		}
+		j.name = strings.Join(names, ",")
+	}
+
+	return jobs
+}
+
+// keys returns the names under which the progress of the job's kinds of
+// data is tracked.
+func (j job) keys() []string {
+	var keys []string
+	if j.kinds.projects {
+		keys = append(keys, string(kindProjects))
+	}
+	for _, kind := range j.kinds.kinds {
+		keys = append(keys, string(kind))
+	}
+	return keys
+}
+
+// runScheduled calls fn at the times of the given schedule, each delayed by
+// up to jitter, until the context is done or stop is closed. Runs that are
+// missed while fn is running are skipped.
+func runScheduled(ctx context.Context, stop <-chan struct{}, s schedule.Schedule, jitter time.Duration, fn func(now time.Time)) {
+	last := time.Now()
+	for {
+		now := time.Now()
+		next := s.Next(last)
+		if next.Before(now) {
+			next = s.Next(now)
+		}
+		if next.IsZero() {
+			return
+		}
+		last = next
+
+		timer := time.NewTimer(next.Sub(now) + schedule.Jitter(jitter))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-stop:
+			timer.Stop()
+			return
+		case t := <-timer.C:
+			fn(t)
+		}
+	}
+}
+
+// exportedUntil returns the earliest point in time up to which the given
+// kinds of data were exported, or defaultAfter if any were not yet.
+func (c *Controller) exportedUntil(keys []string, defaultAfter time.Time) time.Time {
+	c.exportedMutex.Lock()
+	defer c.exportedMutex.Unlock()
+
+	after := time.Time{}
+	for _, key := range keys {
+		t, ok := c.exported[key]
+		if !ok {
+			t = defaultAfter
+		}
+		if after.IsZero() || t.Before(after) {
+			after = t
+		}
+	}
+	if after.IsZero() {
+		return defaultAfter
+	}
+	return after
+}
+
+// exportedAll reports whether all of the given kinds of data were exported
+// before. It holds across reloads of the configuration, unlike the iterations
+// of a job.
+func (c *Controller) exportedAll(keys []string) bool {
+	c.exportedMutex.Lock()
+	defer c.exportedMutex.Unlock()
+
+	for _, key := range keys {
+		if _, ok := c.exported[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Controller) setExportedUntil(keys []string, before time.Time) {
+	c.exportedMutex.Lock()
+	defer c.exportedMutex.Unlock()
+
+	for _, key := range keys {
+		c.exported[key] = before
+	}
+}
+
+// runJob fetches and exports the data of the job that was updated since
+// it was last exported, up to now.
+func (c *Controller) runJob(ctx context.Context, j job, iteration int, startedAt time.Time, now time.Time) {
+	// all projects are treated as updated until the job's kinds of data were
+	// exported once, reloads keep the progress
+	firstIteration := !c.exportedAll(j.keys())
+
+	after := c.exportedUntil(j.keys(), startedAt)
+	before := now.UTC()
+
+	slog.Debug("[RUN] Processing projects...", "job", j.name, "iteration", iteration, "after", after.Format(time.RFC3339), "before", before.Format(time.RFC3339))
+
+	var (
+		wg sync.WaitGroup
+
+		// keys of the kinds of data that failed to export in any batch,
+		// these are exported again from the same point in time next run
+		failed      = make(map[string]bool)
+		failedMutex sync.Mutex
+	)
+
+	// fetch and export project related data, resuming each project
+	// from its last checkpoint
+	if !j.kinds.empty() {
+		for resumeAfter, projects := range c.groupByCheckpoint(after, j.kinds.kinds) {
+			projectBatches := makeBatches(projects, maxRecordsPerPage)
+			for i, batch := range projectBatches {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					kindErrs, err := c.process(ctx, batch, j.kinds, &resumeAfter, &before, firstIteration)
+					if err != nil {
+						slog.
+							With(
+								slog.String("error", err.Error()),
+								slog.String("job", j.name),
+								slog.Int("iteration", iteration),
+								slog.String("after", resumeAfter.Format(time.RFC3339)), slog.String("before", before.Format(time.RFC3339)),
+								slog.Int("batchIndex", i), slog.Int("batchCount", len(projectBatches)),
+							).
+							With(metaerr.GetMetadata(err)...).
+							Error("[RUN] error processing projects")
+					} else {
+						c.metrics.setLastExport(batch, before)
+					}
+
+					c.advanceCheckpoints(batch, j.kinds.kinds, kindErrs, before)
+
+					failedMutex.Lock()
+					for _, key := range j.keys() {
+						if kindErrs == nil || kindErrs[checkpoint.Kind(key)] != nil {
+							failed[key] = true
+						}
+					}
+					failedMutex.Unlock()
+				}()
+			}
+		}
+	}
+
+	// fetch and export data that is not exported per project and time range
+	processAll := func(name string, process func(ctx context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := process(ctx); err != nil {
+				slog.
+					With(
+						slog.String("error", err.Error()),
+						slog.Int("iteration", iteration),
+					).
+					With(metaerr.GetMetadata(err)...).
+					Error("[RUN] error processing " + name)
+			}
+		}()
+	}
+	if j.environments {
+		processAll("environments", c.processEnvironments)
+	}
+	if j.jobArtifacts {
+		processAll("job artifacts", c.processJobArtifacts)
+	}
+	if j.tags {
+		processAll("tags", c.processTags)
+	}
+	if j.runners {
+		processAll("runners", c.processRunners)
+	}
+
+	// wait for tasks processing to finish
+	wg.Wait()
+	c.setExportedUntil(slices.DeleteFunc(j.keys(), func(key string) bool { return failed[key] }), before)
+	if j.kinds.projects {
+		c.loopCompletedAt.Store(time.Now().UnixNano())
+	}
+
+	slog.Debug("[RUN] Processing projects... done", "job", j.name, "iteration", iteration)
+}
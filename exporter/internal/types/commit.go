@@ -1,5 +1,44 @@
 package types
 
+import (
+	"time"
+)
+
+type Commit struct {
+	Sha       string
+	ShortSha  string
+	ParentIds []string
+	Project   ProjectReference
+
+	// Branch the commit was fetched from
+	Ref string
+
+	AuthoredDate *time.Time
+	AuthorName   string
+	AuthorEmail  string
+
+	CommittedDate  *time.Time
+	CommitterName  string
+	CommitterEmail string
+
+	CreatedAt *time.Time
+
+	Title    string
+	Message  string
+	Trailers []CommitTrailer
+
+	Stats *CommitStats
+
+	Status string
+	WebUrl string
+}
+
+type CommitStats struct {
+	Additions int64
+	Deletions int64
+	Total     int64
+}
+
 type CommitTrailer struct {
 	Key   string
 	Value string
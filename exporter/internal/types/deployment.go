@@ -16,15 +16,19 @@ type Environment struct {
 	Id      int64
 	Project ProjectReference
 
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	CreatedAt  *time.Time
+	UpdatedAt  *time.Time
+	AutoStopAt *time.Time
 
 	Name        string
 	Slug        string
 	Description string
+	ExternalUrl string
 
 	State string
 	Tier  string
+
+	LastDeployment *Deployment
 }
 
 type Deployment struct {
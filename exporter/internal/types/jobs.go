@@ -24,10 +24,12 @@ type Job struct {
 	Name     string
 	Pipeline PipelineReference
 
-	Ref           string
-	RefPath       string
-	Status        string
-	FailureReason string
+	Ref             string
+	RefPath         string
+	Status          string
+	FailureReason   string
+	FailureCategory string
+	FailureExcerpt  string
 
 	CreatedAt  *time.Time
 	QueuedAt   *time.Time
@@ -62,10 +64,15 @@ type JobLogProperty struct {
 }
 
 type JobArtifact struct {
+	Id            int64
 	Job           JobReference
 	JobFinishedAt *time.Time
 
 	FileType     string
 	Name         string
 	DownloadPath string
+	Size         int64
+
+	ExpireAt *time.Time
+	Locked   bool
 }
@@ -121,3 +121,19 @@ type MergeRequestNoteEvent struct {
 	ResolvedAt *time.Time
 	Resolver   UserReference
 }
+
+// MergeRequestEvent is an entry of the timeline of a merge request, built
+// from its resource events and from the system notes of reviews.
+type MergeRequestEvent struct {
+	Id           int64
+	MergeRequest MergeRequestReference
+	Kind         string
+
+	CreatedAt *time.Time
+	User      UserReference
+
+	Action string
+
+	Label     string
+	Milestone *MilestoneReference
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"time"
+)
+
+type Tag struct {
+	Name    string
+	Project ProjectReference
+
+	CommitSha string
+	Target    string
+	Message   string
+	Protected bool
+
+	CreatedAt   *time.Time
+	CommittedAt *time.Time
+}
+
+type Release struct {
+	TagName string
+	Project ProjectReference
+
+	Name        string
+	Description string
+
+	CreatedAt       *time.Time
+	ReleasedAt      *time.Time
+	UpcomingRelease bool
+
+	Author    UserReference
+	CommitSha string
+
+	Milestones []ReleaseMilestone
+	Evidences  []ReleaseEvidence
+
+	// The latest pipeline that ran for the commit of the tag, if any
+	Pipeline *PipelineReference
+}
+
+type ReleaseMilestone struct {
+	Id      int64
+	Iid     int64
+	Project ProjectReference
+
+	Title string
+	State string
+}
+
+type ReleaseEvidence struct {
+	Sha         string
+	Filepath    string
+	CollectedAt *time.Time
+}
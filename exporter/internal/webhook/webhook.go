@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	// HeaderEvent is the header in which GitLab sends the event type.
+	HeaderEvent = "X-Gitlab-Event"
+	// HeaderToken is the header in which GitLab sends the configured secret token.
+	HeaderToken = "X-Gitlab-Token"
+
+	maxBodySize int64 = 25 << 20 // 25 MiB, the limit GitLab applies to webhook payloads
+)
+
+// https://docs.gitlab.com/user/project/integrations/webhook_events/
+const (
+	eventPipelineHook     = "Pipeline Hook"
+	eventJobHook          = "Job Hook"
+	eventMergeRequestHook = "Merge Request Hook"
+	eventIssueHook        = "Issue Hook"
+	eventDeploymentHook   = "Deployment Hook"
+)
+
+var ErrUnsupportedEvent = errors.New("unsupported event")
+
+// EventKind identifies the kind of data affected by a webhook event.
+type EventKind string
+
+const (
+	EventKindPipeline     EventKind = "pipeline"
+	EventKindMergeRequest EventKind = "merge_request"
+	EventKindIssue        EventKind = "issue"
+	EventKindDeployment   EventKind = "deployment"
+)
+
+// Event describes the data that has to be fetched again in response to a
+// webhook event.
+type Event struct {
+	Kind        EventKind
+	ProjectId   int64
+	ProjectPath string
+
+	// The pipeline id, set for pipeline events.
+	PipelineId int64
+	// The merge request iid, set for merge request events.
+	MergeRequestIid int64
+
+	ReceivedAt time.Time
+}
+
+// Handler is an http.Handler that receives GitLab webhook events and queues
+// them for processing.
+type Handler struct {
+	secret string
+	queue  chan<- Event
+}
+
+// NewHandler returns a handler that authenticates requests using the given
+// secret token and sends the parsed events to queue.
+func NewHandler(secret string, queue chan<- Event) *Handler {
+	return &Handler{
+		secret: secret,
+		queue:  queue,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := r.Header.Get(HeaderToken)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.secret)) != 1 {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "error reading request body", http.StatusBadRequest)
+		return
+	}
+
+	eventType := r.Header.Get(HeaderEvent)
+	event, err := ParseEvent(eventType, body)
+	if errors.Is(err, ErrUnsupportedEvent) {
+		slog.Debug("Ignoring webhook event", "event", eventType)
+		w.WriteHeader(http.StatusAccepted)
+		return
+	} else if err != nil {
+		slog.Warn("error parsing webhook event", "event", eventType, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	event.ReceivedAt = time.Now().UTC()
+
+	select {
+	case h.queue <- event:
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		// the events that are dropped here are picked up by the next
+		// reconciliation run
+		slog.Warn("Dropping webhook event, queue is full", "event", eventType, "project_id", event.ProjectId)
+		http.Error(w, "event queue is full", http.StatusServiceUnavailable)
+	}
+}
+
+type payload struct {
+	ObjectKind string `json:"object_kind"`
+
+	ProjectId int64 `json:"project_id"`
+	Project   struct {
+		Id                int64  `json:"id"`
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+
+	ObjectAttributes struct {
+		Id  int64 `json:"id"`
+		Iid int64 `json:"iid"`
+	} `json:"object_attributes"`
+
+	// job events
+	PipelineId int64 `json:"pipeline_id"`
+}
+
+// ParseEvent parses the payload of a webhook event of the given type, as
+// sent in the X-Gitlab-Event header.
+func ParseEvent(eventType string, data []byte) (Event, error) {
+	var kind EventKind
+	switch eventType {
+	case eventPipelineHook, eventJobHook:
+		kind = EventKindPipeline
+	case eventMergeRequestHook:
+		kind = EventKindMergeRequest
+	case eventIssueHook:
+		kind = EventKindIssue
+	case eventDeploymentHook:
+		kind = EventKindDeployment
+	default:
+		return Event{}, fmt.Errorf("%w: %q", ErrUnsupportedEvent, eventType)
+	}
+
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Event{}, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	event := Event{
+		Kind:        kind,
+		ProjectId:   p.Project.Id,
+		ProjectPath: p.Project.PathWithNamespace,
+	}
+	if event.ProjectId == 0 { // job events only have a flat project id
+		event.ProjectId = p.ProjectId
+	}
+	if event.ProjectId == 0 {
+		return Event{}, errors.New("missing project id")
+	}
+
+	switch eventType {
+	case eventPipelineHook:
+		event.PipelineId = p.ObjectAttributes.Id
+	case eventJobHook:
+		event.PipelineId = p.PipelineId
+	case eventMergeRequestHook:
+		event.MergeRequestIid = p.ObjectAttributes.Iid
+	}
+
+	if kind == EventKindPipeline && event.PipelineId == 0 {
+		return Event{}, errors.New("missing pipeline id")
+	}
+	if kind == EventKindMergeRequest && (event.MergeRequestIid == 0 || event.ProjectPath == "") {
+		return Event{}, errors.New("missing merge request reference")
+	}
+
+	return event, nil
+}
@@ -0,0 +1,123 @@
+package webhook_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.cluttr.dev/gitlab-exporter/exporter/internal/webhook"
+)
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		payload   string
+		want      webhook.Event
+		wantErr   bool
+	}{
+		{
+			name:      "pipeline",
+			eventType: "Pipeline Hook",
+			payload:   `{"object_kind":"pipeline","object_attributes":{"id":31,"iid":3},"project":{"id":1,"path_with_namespace":"group/project"}}`,
+			want:      webhook.Event{Kind: webhook.EventKindPipeline, ProjectId: 1, ProjectPath: "group/project", PipelineId: 31},
+		},
+		{
+			name:      "job",
+			eventType: "Job Hook",
+			payload:   `{"object_kind":"build","build_id":1977,"pipeline_id":2366,"project_id":380}`,
+			want:      webhook.Event{Kind: webhook.EventKindPipeline, ProjectId: 380, PipelineId: 2366},
+		},
+		{
+			name:      "merge request",
+			eventType: "Merge Request Hook",
+			payload:   `{"object_kind":"merge_request","object_attributes":{"id":99,"iid":1},"project":{"id":1,"path_with_namespace":"group/project"}}`,
+			want:      webhook.Event{Kind: webhook.EventKindMergeRequest, ProjectId: 1, ProjectPath: "group/project", MergeRequestIid: 1},
+		},
+		{
+			name:      "issue",
+			eventType: "Issue Hook",
+			payload:   `{"object_kind":"issue","object_attributes":{"id":301,"iid":23},"project":{"id":1,"path_with_namespace":"group/project"}}`,
+			want:      webhook.Event{Kind: webhook.EventKindIssue, ProjectId: 1, ProjectPath: "group/project"},
+		},
+		{
+			name:      "deployment",
+			eventType: "Deployment Hook",
+			payload:   `{"object_kind":"deployment","deployment_id":15,"project":{"id":30,"path_with_namespace":"group/project"}}`,
+			want:      webhook.Event{Kind: webhook.EventKindDeployment, ProjectId: 30, ProjectPath: "group/project"},
+		},
+		{
+			name:      "missing project",
+			eventType: "Pipeline Hook",
+			payload:   `{"object_kind":"pipeline","object_attributes":{"id":31}}`,
+			wantErr:   true,
+		},
+		{
+			name:      "malformed",
+			eventType: "Issue Hook",
+			payload:   `{`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := webhook.ParseEvent(tt.eventType, []byte(tt.payload))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("want %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	_, err := webhook.ParseEvent("Push Hook", []byte(`{}`))
+	if !errors.Is(err, webhook.ErrUnsupportedEvent) {
+		t.Errorf("want ErrUnsupportedEvent, got %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	const payload = `{"object_kind":"pipeline","object_attributes":{"id":31},"project":{"id":1}}`
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		event  string
+		want   int
+		queued bool
+	}{
+		{"ok", http.MethodPost, "secret", "Pipeline Hook", http.StatusAccepted, true},
+		{"wrong token", http.MethodPost, "wrong", "Pipeline Hook", http.StatusUnauthorized, false},
+		{"missing token", http.MethodPost, "", "Pipeline Hook", http.StatusUnauthorized, false},
+		{"wrong method", http.MethodGet, "secret", "Pipeline Hook", http.StatusMethodNotAllowed, false},
+		{"unsupported event", http.MethodPost, "secret", "Push Hook", http.StatusAccepted, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := make(chan webhook.Event, 1)
+			h := webhook.NewHandler("secret", queue)
+
+			req := httptest.NewRequest(tt.method, "/webhooks/gitlab", strings.NewReader(payload))
+			req.Header.Set(webhook.HeaderEvent, tt.event)
+			if tt.token != "" {
+				req.Header.Set(webhook.HeaderToken, tt.token)
+			}
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("want status %d, got %d", tt.want, rec.Code)
+			}
+			if queued := len(queue) == 1; queued != tt.queued {
+				t.Errorf("want queued=%v, got queued=%v", tt.queued, queued)
+			}
+		})
+	}
+}
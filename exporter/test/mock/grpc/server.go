@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/grpc"
 
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
 	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
 )
@@ -77,3 +78,7 @@ func (ms *MockExporterServer) RecordJobs(ctx context.Context, r *servicepb.Recor
 	n, err := check(ms, ms.expectedJobs, r.Data)
 	return &servicepb.RecordSummary{RecordedCount: n}, err
 }
+
+func (ms *MockExporterServer) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(ms, stream)
+}
@@ -8,6 +8,7 @@ import (
 	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
@@ -57,6 +58,43 @@ func (c *Client) MetricsCollector() prometheus.Collector {
 	return c.metrics
 }
 
+func (c *Client) Conn() grpc.ClientConnInterface {
+	return c.conn
+}
+
+// Close tears down the connection to the recorder.
+func (c *Client) Close() error {
+	if cc, ok := c.conn.(*grpc.ClientConn); ok {
+		return cc.Close()
+	}
+	return nil
+}
+
+func GetCapabilities(c *Client, ctx context.Context) (*servicepb.Capabilities, error) {
+	req := &servicepb.GetCapabilitiesRequest{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+	}
+	caps, err := c.stub.GetCapabilities(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return nil, fmt.Errorf("get capabilities: %w", err)
+	}
+
+	return caps, nil
+}
+
+// CheckHealth returns an error unless the recorder reports to be serving.
+func CheckHealth(c *Client, ctx context.Context) error {
+	resp, err := healthpb.NewHealthClient(c.conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("check health: %w", err)
+	}
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("recorder not serving: %s", resp.GetStatus())
+	}
+
+	return nil
+}
+
 func RecordCommits(c *Client, ctx context.Context, data []*typespb.Commit) error {
 	req := &servicepb.RecordCommitsRequest{
 		Data: data,
@@ -129,6 +167,18 @@ func RecordDeployments(c *Client, ctx context.Context, data []*typespb.Deploymen
 	return nil
 }
 
+func RecordEnvironments(c *Client, ctx context.Context, data []*typespb.Environment) error {
+	req := &servicepb.RecordEnvironmentsRequest{
+		Data: data,
+	}
+	_, err := c.stub.RecordEnvironments(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return fmt.Errorf("record environments: %w", err)
+	}
+
+	return nil
+}
+
 func RecordIssues(c *Client, ctx context.Context, data []*typespb.Issue) error {
 	req := &servicepb.RecordIssuesRequest{
 		Data: data,
@@ -153,6 +203,18 @@ func RecordJobs(c *Client, ctx context.Context, data []*typespb.Job) error {
 	return nil
 }
 
+func RecordJobArtifacts(c *Client, ctx context.Context, data []*typespb.JobArtifact) error {
+	req := &servicepb.RecordJobArtifactsRequest{
+		Data: data,
+	}
+	_, err := c.stub.RecordJobArtifacts(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return fmt.Errorf("record job artifacts: %w", err)
+	}
+
+	return nil
+}
+
 func RecordMergeRequests(c *Client, ctx context.Context, data []*typespb.MergeRequest) error {
 	req := &servicepb.RecordMergeRequestsRequest{
 		Data: data,
@@ -177,6 +239,18 @@ func RecordMergeRequestCommits(c *Client, ctx context.Context, data []*typespb.M
 	return nil
 }
 
+func RecordMergeRequestEvents(c *Client, ctx context.Context, data []*typespb.MergeRequestEvent) error {
+	req := &servicepb.RecordMergeRequestEventsRequest{
+		Data: data,
+	}
+	_, err := c.stub.RecordMergeRequestEvents(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return fmt.Errorf("record merge request events: %w", err)
+	}
+
+	return nil
+}
+
 func RecordMergeRequestNoteEvents(c *Client, ctx context.Context, data []*typespb.MergeRequestNoteEvent) error {
 	req := &servicepb.RecordMergeRequestNoteEventsRequest{
 		Data: data,
@@ -225,6 +299,18 @@ func RecordProjects(c *Client, ctx context.Context, data []*typespb.Project) err
 	return nil
 }
 
+func RecordReleases(c *Client, ctx context.Context, data []*typespb.Release) error {
+	req := &servicepb.RecordReleasesRequest{
+		Data: data,
+	}
+	_, err := c.stub.RecordReleases(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return fmt.Errorf("record releases: %w", err)
+	}
+
+	return nil
+}
+
 func RecordRunners(c *Client, ctx context.Context, data []*typespb.Runner, fetchedAt time.Time) error {
 	req := &servicepb.RecordRunnersRequest{
 		Data: data,
@@ -253,6 +339,18 @@ func RecordSections(c *Client, ctx context.Context, data []*typespb.Section) err
 	return nil
 }
 
+func RecordTags(c *Client, ctx context.Context, data []*typespb.Tag) error {
+	req := &servicepb.RecordTagsRequest{
+		Data: data,
+	}
+	_, err := c.stub.RecordTags(ctx, req /* opts ...grpc.CallOption */)
+	if err != nil {
+		return fmt.Errorf("record tags: %w", err)
+	}
+
+	return nil
+}
+
 func RecordTestCases(c *Client, ctx context.Context, data []*typespb.TestCase) error {
 	req := &servicepb.RecordTestCasesRequest{
 		Data: data,
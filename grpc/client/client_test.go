@@ -82,3 +82,48 @@ func Test_RecordJobs(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func Test_RecordStream(t *testing.T) {
+	server, client, err := newServerAndClient()
+	defer server.GracefulStop()
+	if err != nil {
+		t.Error(err)
+	}
+
+	pipelines := []*typespb.Pipeline{
+		{
+			Id: 42,
+		},
+	}
+	jobs := []*typespb.Job{
+		{
+			Id: 43,
+		},
+		{
+			Id: 44,
+		},
+	}
+
+	server.ExpectPipelines(pipelines)
+	server.ExpectJobs(jobs)
+
+	ctx := context.Background()
+	stream, err := grpc_client.NewRecordStream(client, ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := grpc_client.StreamPipelines(stream, pipelines); err != nil {
+		t.Error(err)
+	}
+	if err := grpc_client.StreamJobs(stream, jobs); err != nil {
+		t.Error(err)
+	}
+
+	n, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("want 3 recorded, got %d", n)
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfig holds the settings to connect to a recorder over TLS.
+type TLSConfig struct {
+	// CAFile is the PEM encoded CA bundle used to verify the recorder's
+	// certificate. If empty, the system roots are used.
+	CAFile string
+	// CertFile and KeyFile are the PEM encoded client certificate and key
+	// presented to recorders that require mutual TLS.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the name used to verify the recorder's certificate.
+	ServerName string
+}
+
+// NewTLSCredentials returns transport credentials for the given TLS config.
+func NewTLSCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	tlsConfig, err := NewTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// NewTLSConfig returns the client TLS configuration for the given config.
+func NewTLSConfig(cfg TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca file: %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// WithToken returns a dial option that authenticates every call with the
+// given bearer token. The token is only sent over secure connections.
+func WithToken(token string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(tokenCredentials(token))
+}
+
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + string(t),
+	}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return true
+}
@@ -0,0 +1,458 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+// RecordStream sends records of any kind to the recorder over a single
+// client-streaming call. It is safe for concurrent use.
+type RecordStream struct {
+	mu     sync.Mutex
+	stream servicepb.GitLabExporter_RecordStreamClient
+	err    error
+}
+
+func NewRecordStream(c *Client, ctx context.Context) (*RecordStream, error) {
+	stream, err := c.stub.RecordStream(ctx /* opts ...grpc.CallOption */)
+	if err != nil {
+		return nil, fmt.Errorf("open record stream: %w", err)
+	}
+
+	return &RecordStream{
+		stream: stream,
+	}, nil
+}
+
+// Send sends a record to the recorder. Send blocks while the recorder falls
+// behind. Once sending failed, the stream is broken and all further calls
+// return the same error.
+func (s *RecordStream) Send(req *servicepb.RecordStreamRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	if err := s.stream.Send(req); err != nil {
+		if errors.Is(err, io.EOF) {
+			// the actual error is returned by the recorder
+			if _, rerr := s.stream.CloseAndRecv(); rerr != nil {
+				err = rerr
+			}
+		}
+		s.err = err
+		return err
+	}
+
+	return nil
+}
+
+// CloseAndRecv closes the stream and waits for the recorder to handle all
+// records. It returns the number of records the recorder reported.
+func (s *RecordStream) CloseAndRecv() (int32, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	summary, err := s.stream.CloseAndRecv()
+	if err != nil {
+		s.err = err
+		return 0, fmt.Errorf("close record stream: %w", err)
+	}
+	s.err = io.ErrClosedPipe
+
+	return summary.GetRecordedCount(), nil
+}
+
+func StreamCommits(s *RecordStream, data []*typespb.Commit) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Commits{
+			Commits: &servicepb.RecordCommitsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream commits: %w", err)
+	}
+
+	return nil
+}
+
+func StreamCoverageReports(s *RecordStream, data []*typespb.CoverageReport) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_CoverageReports{
+			CoverageReports: &servicepb.RecordCoverageReportsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream coverage reports: %w", err)
+	}
+
+	return nil
+}
+
+func StreamCoveragePackages(s *RecordStream, data []*typespb.CoveragePackage) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_CoveragePackages{
+			CoveragePackages: &servicepb.RecordCoveragePackagesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream coverage packages: %w", err)
+	}
+
+	return nil
+}
+
+func StreamCoverageClasses(s *RecordStream, data []*typespb.CoverageClass) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_CoverageClasses{
+			CoverageClasses: &servicepb.RecordCoverageClassesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream coverage classes: %w", err)
+	}
+
+	return nil
+}
+
+func StreamCoverageMethods(s *RecordStream, data []*typespb.CoverageMethod) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_CoverageMethods{
+			CoverageMethods: &servicepb.RecordCoverageMethodsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream coverage methods: %w", err)
+	}
+
+	return nil
+}
+
+func StreamDeployments(s *RecordStream, data []*typespb.Deployment) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Deployments{
+			Deployments: &servicepb.RecordDeploymentsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream deployments: %w", err)
+	}
+
+	return nil
+}
+
+func StreamEnvironments(s *RecordStream, data []*typespb.Environment) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Environments{
+			Environments: &servicepb.RecordEnvironmentsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream environments: %w", err)
+	}
+
+	return nil
+}
+
+func StreamIssues(s *RecordStream, data []*typespb.Issue) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Issues{
+			Issues: &servicepb.RecordIssuesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream issues: %w", err)
+	}
+
+	return nil
+}
+
+func StreamJobs(s *RecordStream, data []*typespb.Job) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Jobs{
+			Jobs: &servicepb.RecordJobsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream jobs: %w", err)
+	}
+
+	return nil
+}
+
+func StreamJobArtifacts(s *RecordStream, data []*typespb.JobArtifact) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_JobArtifacts{
+			JobArtifacts: &servicepb.RecordJobArtifactsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream job artifacts: %w", err)
+	}
+
+	return nil
+}
+
+func StreamMergeRequests(s *RecordStream, data []*typespb.MergeRequest) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_MergeRequests{
+			MergeRequests: &servicepb.RecordMergeRequestsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream merge requests: %w", err)
+	}
+
+	return nil
+}
+
+func StreamMergeRequestCommits(s *RecordStream, data []*typespb.MergeRequestCommit) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_MergeRequestCommits{
+			MergeRequestCommits: &servicepb.RecordMergeRequestCommitsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream merge request commits: %w", err)
+	}
+
+	return nil
+}
+
+func StreamMergeRequestEvents(s *RecordStream, data []*typespb.MergeRequestEvent) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_MergeRequestEvents{
+			MergeRequestEvents: &servicepb.RecordMergeRequestEventsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream merge request events: %w", err)
+	}
+
+	return nil
+}
+
+func StreamMergeRequestNoteEvents(s *RecordStream, data []*typespb.MergeRequestNoteEvent) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_MergeRequestNoteEvents{
+			MergeRequestNoteEvents: &servicepb.RecordMergeRequestNoteEventsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream merge request note events: %w", err)
+	}
+
+	return nil
+}
+
+func StreamMetrics(s *RecordStream, data []*typespb.Metric) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Metrics{
+			Metrics: &servicepb.RecordMetricsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream metrics: %w", err)
+	}
+
+	return nil
+}
+
+func StreamPipelines(s *RecordStream, data []*typespb.Pipeline) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Pipelines{
+			Pipelines: &servicepb.RecordPipelinesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream pipelines: %w", err)
+	}
+
+	return nil
+}
+
+func StreamProjects(s *RecordStream, data []*typespb.Project) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Projects{
+			Projects: &servicepb.RecordProjectsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream projects: %w", err)
+	}
+
+	return nil
+}
+
+func StreamReleases(s *RecordStream, data []*typespb.Release) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Releases{
+			Releases: &servicepb.RecordReleasesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream releases: %w", err)
+	}
+
+	return nil
+}
+
+func StreamRunners(s *RecordStream, data []*typespb.Runner, fetchedAt time.Time) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Runners{
+			Runners: &servicepb.RecordRunnersRequest{
+				Data: data,
+				Metadata: &servicepb.RecordRequestMetadata{
+					FetchedAt:  timestamppb.New(fetchedAt),
+					ExportedAt: timestamppb.Now(),
+				},
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream runners: %w", err)
+	}
+
+	return nil
+}
+
+func StreamSections(s *RecordStream, data []*typespb.Section) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Sections{
+			Sections: &servicepb.RecordSectionsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream sections: %w", err)
+	}
+
+	return nil
+}
+
+func StreamTags(s *RecordStream, data []*typespb.Tag) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Tags{
+			Tags: &servicepb.RecordTagsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream tags: %w", err)
+	}
+
+	return nil
+}
+
+func StreamTestCases(s *RecordStream, data []*typespb.TestCase) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_TestCases{
+			TestCases: &servicepb.RecordTestCasesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream test cases: %w", err)
+	}
+
+	return nil
+}
+
+func StreamTestReports(s *RecordStream, data []*typespb.TestReport) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_TestReports{
+			TestReports: &servicepb.RecordTestReportsRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream test reports: %w", err)
+	}
+
+	return nil
+}
+
+func StreamTestSuites(s *RecordStream, data []*typespb.TestSuite) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_TestSuites{
+			TestSuites: &servicepb.RecordTestSuitesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream test suites: %w", err)
+	}
+
+	return nil
+}
+
+func StreamTraces(s *RecordStream, data []*typespb.Trace) error {
+	req := &servicepb.RecordStreamRequest{
+		Records: &servicepb.RecordStreamRequest_Traces{
+			Traces: &servicepb.RecordTracesRequest{
+				Data: data,
+			},
+		},
+	}
+	if err := s.Send(req); err != nil {
+		return fmt.Errorf("stream traces: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TLSConfig holds the settings to serve over TLS.
+type TLSConfig struct {
+	// CertFile and KeyFile are the PEM encoded server certificate and key.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is the PEM encoded CA bundle used to verify client
+	// certificates. If set, clients must present a valid certificate.
+	ClientCAFile string
+}
+
+// NewTLSCredentials returns transport credentials for the given TLS config.
+func NewTLSCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client ca file: %s", cfg.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// OptionsFromFiles returns the options to serve with the given TLS config and
+// the bearer token read from tokenFile. TLS is enabled if a certificate file is
+// set, a token requires TLS. Empty settings yield no options.
+func OptionsFromFiles(tlsCfg TLSConfig, tokenFile string) ([]Option, error) {
+	var opts []Option
+
+	if tlsCfg.CertFile != "" {
+		creds, err := NewTLSCredentials(tlsCfg)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		opts = append(opts, WithCredentials(creds))
+	}
+
+	if tokenFile != "" {
+		if tlsCfg.CertFile == "" {
+			return nil, fmt.Errorf("token requires tls to be enabled")
+		}
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("read token file: %w", err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return nil, fmt.Errorf("empty token file: %s", tokenFile)
+		}
+		opts = append(opts, WithToken(token))
+	}
+
+	return opts, nil
+}
+
+// healthPrefix is the method prefix of the health service, which is
+// available without authentication so that probes keep working.
+var healthPrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// authenticate checks the bearer token of an incoming call.
+func authenticate(ctx context.Context, method string, token string) error {
+	if strings.HasPrefix(method, healthPrefix) {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get("authorization") {
+		scheme, t, ok := strings.Cut(v, " ")
+		if ok && strings.EqualFold(scheme, "bearer") && subtle.ConstantTimeCompare([]byte(t), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid or missing token")
+}
+
+func tokenUnaryInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authenticate(ctx, info.FullMethod, token); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func tokenStreamInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authenticate(ss.Context(), info.FullMethod, token); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpc_client "go.cluttr.dev/gitlab-exporter/grpc/client"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+func TestAuthenticate(t *testing.T) {
+	const method = "/gitlabexporter.protobuf.service.GitLabExporter/RecordPipelines"
+
+	tests := []struct {
+		name   string
+		method string
+		auth   []string
+		want   codes.Code
+	}{
+		{"valid token", method, []string{"Bearer secret"}, codes.OK},
+		{"lower case scheme", method, []string{"bearer secret"}, codes.OK},
+		{"invalid token", method, []string{"Bearer wrong"}, codes.Unauthenticated},
+		{"invalid scheme", method, []string{"Basic secret"}, codes.Unauthenticated},
+		{"missing token", method, nil, codes.Unauthenticated},
+		{"health check", "/grpc.health.v1.Health/Check", nil, codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.auth != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.auth[0]))
+			}
+			if got := status.Code(authenticate(ctx, tt.method, "secret")); got != tt.want {
+				t.Errorf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOptionsFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCertificate(t, dir)
+	tlsCfg := TLSConfig{CertFile: certFile, KeyFile: keyFile}
+
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("s3cr3t\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyTokenFile := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyTokenFile, []byte(" \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		tlsCfg    TLSConfig
+		tokenFile string
+		wantOpts  int
+		wantErr   bool
+	}{
+		{"none", TLSConfig{}, "", 0, false},
+		{"tls", tlsCfg, "", 1, false},
+		{"tls with token", tlsCfg, tokenFile, 2, false},
+		{"token without tls", TLSConfig{}, tokenFile, 0, true},
+		{"empty token", tlsCfg, emptyTokenFile, 0, true},
+		{"missing token file", tlsCfg, filepath.Join(dir, "missing"), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := OptionsFromFiles(tt.tlsCfg, tt.tokenFile)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OptionsFromFiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(opts) != tt.wantOpts {
+				t.Errorf("OptionsFromFiles() returned %d options, want %d", len(opts), tt.wantOpts)
+			}
+		})
+	}
+}
+
+// writeCertificate writes a self-signed certificate for localhost and its
+// key to dir.
+func writeCertificate(t *testing.T, dir string) (certFile string, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+type capabilitiesRecorder struct {
+	servicepb.UnimplementedGitLabExporterServer
+}
+
+func (r *capabilitiesRecorder) GetCapabilities(context.Context, *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	return &servicepb.Capabilities{}, nil
+}
+
+func TestMutualTLSWithToken(t *testing.T) {
+	certFile, keyFile := writeCertificate(t, t.TempDir())
+
+	serverCreds, err := NewTLSCredentials(TLSConfig{
+		CertFile:     certFile,
+		KeyFile:      keyFile,
+		ClientCAFile: certFile,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.Creds(serverCreds),
+		grpc.ChainUnaryInterceptor(tokenUnaryInterceptor("secret")),
+	)
+	servicepb.RegisterGitLabExporterServer(grpcServer, &capabilitiesRecorder{})
+	healthpb.RegisterHealthServer(grpcServer, New(nil).health)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = grpcServer.Serve(listener) }()
+	defer grpcServer.Stop()
+
+	clientCreds, err := grpc_client.NewTLSCredentials(grpc_client.TLSConfig{
+		CAFile:     certFile,
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+		ServerName: "localhost",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dial := func(opts ...grpc.DialOption) *grpc_client.Client {
+		opts = append(opts,
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			}),
+			grpc.WithTransportCredentials(clientCreds),
+		)
+		client, err := grpc_client.NewCLient("passthrough://bufnet", opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+
+	ctx := context.Background()
+
+	if _, err := grpc_client.GetCapabilities(dial(grpc_client.WithToken("secret")), ctx); err != nil {
+		t.Errorf("want authenticated call to succeed, got %v", err)
+	}
+
+	unauthenticated := dial()
+	if _, err := grpc_client.GetCapabilities(unauthenticated, ctx); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("want unauthenticated call to fail, got %v", err)
+	}
+	if _, err := healthpb.NewHealthClient(unauthenticated.Conn()).Check(ctx, &healthpb.HealthCheckRequest{}); err != nil {
+		t.Errorf("want health check without token to succeed, got %v", err)
+	}
+}
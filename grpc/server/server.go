@@ -12,6 +12,7 @@ import (
 	"github.com/oklog/run"
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
@@ -22,19 +23,45 @@ type Server struct {
 	recorder servicepb.GitLabExporterServer
 	health   *health.Server
 	metrics  *grpcprom.ServerMetrics
+
+	creds credentials.TransportCredentials
+	token string
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithCredentials serves with the given transport credentials, e.g. as
+// returned by NewTLSCredentials.
+func WithCredentials(creds credentials.TransportCredentials) Option {
+	return func(s *Server) {
+		s.creds = creds
+	}
+}
+
+// WithToken requires all calls, except health checks, to carry the given
+// bearer token.
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
 }
 
-func New(recorder servicepb.GitLabExporterServer) *Server {
+func New(recorder servicepb.GitLabExporterServer, opts ...Option) *Server {
 	healthServer := health.NewServer()
 	healthServer.SetServingStatus("" /* system */, healthpb.HealthCheckResponse_NOT_SERVING)
 
 	metricsServer := grpcprom.NewServerMetrics()
 
-	return &Server{
+	s := &Server{
 		recorder: recorder,
 		health:   healthServer,
 		metrics:  metricsServer,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Server) MetricsCollector() prometheus.Collector {
@@ -50,10 +77,20 @@ func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
 	network, address := parseAddress(addr)
 
 	// setup grpc server
-	grpcServer := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(s.metrics.UnaryServerInterceptor()),
 		grpc.ChainStreamInterceptor(s.metrics.StreamServerInterceptor()),
-	)
+	}
+	if s.creds != nil {
+		opts = append(opts, grpc.Creds(s.creds))
+	}
+	if s.token != "" {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(tokenUnaryInterceptor(s.token)),
+			grpc.ChainStreamInterceptor(tokenStreamInterceptor(s.token)),
+		)
+	}
+	grpcServer := grpc.NewServer(opts...)
 
 	servicepb.RegisterGitLabExporterServer(grpcServer, s.recorder)
 	healthpb.RegisterHealthServer(grpcServer, s.health)
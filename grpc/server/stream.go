@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+// RecordStream receives the records of a RecordStream call and passes them
+// to the matching unary Record method of the recorder, in the order they were
+// sent. Records are handled one at a time, so a slow recorder slows down the
+// sender through the stream's flow control.
+//
+// Recorders implement the RecordStream RPC by calling this function:
+//
+//	func (r *MyRecorder) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+//		return server.RecordStream(r, stream)
+//	}
+func RecordStream(recorder servicepb.GitLabExporterServer, stream grpc.ClientStreamingServer[servicepb.RecordStreamRequest, servicepb.RecordSummary]) error {
+	ctx := stream.Context()
+
+	var recorded int32
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(&servicepb.RecordSummary{
+				RecordedCount: recorded,
+			})
+		}
+		if err != nil {
+			return err
+		}
+
+		summary, err := dispatch(ctx, recorder, req)
+		if err != nil {
+			return err
+		}
+		recorded += summary.GetRecordedCount()
+	}
+}
+
+func dispatch(ctx context.Context, recorder servicepb.GitLabExporterServer, req *servicepb.RecordStreamRequest) (*servicepb.RecordSummary, error) {
+	switch r := req.GetRecords().(type) {
+	case *servicepb.RecordStreamRequest_Commits:
+		return recorder.RecordCommits(ctx, r.Commits)
+	case *servicepb.RecordStreamRequest_CoverageReports:
+		return recorder.RecordCoverageReports(ctx, r.CoverageReports)
+	case *servicepb.RecordStreamRequest_CoveragePackages:
+		return recorder.RecordCoveragePackages(ctx, r.CoveragePackages)
+	case *servicepb.RecordStreamRequest_CoverageClasses:
+		return recorder.RecordCoverageClasses(ctx, r.CoverageClasses)
+	case *servicepb.RecordStreamRequest_CoverageMethods:
+		return recorder.RecordCoverageMethods(ctx, r.CoverageMethods)
+	case *servicepb.RecordStreamRequest_Deployments:
+		return recorder.RecordDeployments(ctx, r.Deployments)
+	case *servicepb.RecordStreamRequest_Environments:
+		return recorder.RecordEnvironments(ctx, r.Environments)
+	case *servicepb.RecordStreamRequest_Issues:
+		return recorder.RecordIssues(ctx, r.Issues)
+	case *servicepb.RecordStreamRequest_Jobs:
+		return recorder.RecordJobs(ctx, r.Jobs)
+	case *servicepb.RecordStreamRequest_JobArtifacts:
+		return recorder.RecordJobArtifacts(ctx, r.JobArtifacts)
+	case *servicepb.RecordStreamRequest_MergeRequests:
+		return recorder.RecordMergeRequests(ctx, r.MergeRequests)
+	case *servicepb.RecordStreamRequest_MergeRequestCommits:
+		return recorder.RecordMergeRequestCommits(ctx, r.MergeRequestCommits)
+	case *servicepb.RecordStreamRequest_MergeRequestEvents:
+		return recorder.RecordMergeRequestEvents(ctx, r.MergeRequestEvents)
+	case *servicepb.RecordStreamRequest_MergeRequestNoteEvents:
+		return recorder.RecordMergeRequestNoteEvents(ctx, r.MergeRequestNoteEvents)
+	case *servicepb.RecordStreamRequest_Metrics:
+		return recorder.RecordMetrics(ctx, r.Metrics)
+	case *servicepb.RecordStreamRequest_Pipelines:
+		return recorder.RecordPipelines(ctx, r.Pipelines)
+	case *servicepb.RecordStreamRequest_Projects:
+		return recorder.RecordProjects(ctx, r.Projects)
+	case *servicepb.RecordStreamRequest_Releases:
+		return recorder.RecordReleases(ctx, r.Releases)
+	case *servicepb.RecordStreamRequest_Runners:
+		return recorder.RecordRunners(ctx, r.Runners)
+	case *servicepb.RecordStreamRequest_Sections:
+		return recorder.RecordSections(ctx, r.Sections)
+	case *servicepb.RecordStreamRequest_Tags:
+		return recorder.RecordTags(ctx, r.Tags)
+	case *servicepb.RecordStreamRequest_TestCases:
+		return recorder.RecordTestCases(ctx, r.TestCases)
+	case *servicepb.RecordStreamRequest_TestReports:
+		return recorder.RecordTestReports(ctx, r.TestReports)
+	case *servicepb.RecordStreamRequest_TestSuites:
+		return recorder.RecordTestSuites(ctx, r.TestSuites)
+	case *servicepb.RecordStreamRequest_Traces:
+		return recorder.RecordTraces(ctx, r.Traces)
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown record type: %T", r))
+	}
+}
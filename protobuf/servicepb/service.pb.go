@@ -23,6 +23,274 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProtocolVersion int32
+
+const (
+	ProtocolVersion_PROTOCOL_VERSION_UNSPECIFIED ProtocolVersion = 0
+	ProtocolVersion_PROTOCOL_VERSION_1           ProtocolVersion = 1
+)
+
+// Enum value maps for ProtocolVersion.
+var (
+	ProtocolVersion_name = map[int32]string{
+		0: "PROTOCOL_VERSION_UNSPECIFIED",
+		1: "PROTOCOL_VERSION_1",
+	}
+	ProtocolVersion_value = map[string]int32{
+		"PROTOCOL_VERSION_UNSPECIFIED": 0,
+		"PROTOCOL_VERSION_1":           1,
+	}
+)
+
+func (x ProtocolVersion) Enum() *ProtocolVersion {
+	p := new(ProtocolVersion)
+	*p = x
+	return p
+}
+
+func (x ProtocolVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProtocolVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitlabexporter_protobuf_service_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ProtocolVersion) Type() protoreflect.EnumType {
+	return &file_gitlabexporter_protobuf_service_service_proto_enumTypes[0]
+}
+
+func (x ProtocolVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProtocolVersion.Descriptor instead.
+func (ProtocolVersion) EnumDescriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{0}
+}
+
+type RecordKind int32
+
+const (
+	RecordKind_RECORD_KIND_UNSPECIFIED               RecordKind = 0
+	RecordKind_RECORD_KIND_COMMITS                   RecordKind = 1
+	RecordKind_RECORD_KIND_COVERAGE_REPORTS          RecordKind = 2
+	RecordKind_RECORD_KIND_COVERAGE_PACKAGES         RecordKind = 3
+	RecordKind_RECORD_KIND_COVERAGE_CLASSES          RecordKind = 4
+	RecordKind_RECORD_KIND_COVERAGE_METHODS          RecordKind = 5
+	RecordKind_RECORD_KIND_DEPLOYMENTS               RecordKind = 6
+	RecordKind_RECORD_KIND_ISSUES                    RecordKind = 7
+	RecordKind_RECORD_KIND_JOBS                      RecordKind = 8
+	RecordKind_RECORD_KIND_MERGE_REQUESTS            RecordKind = 9
+	RecordKind_RECORD_KIND_MERGE_REQUEST_COMMITS     RecordKind = 10
+	RecordKind_RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS RecordKind = 11
+	RecordKind_RECORD_KIND_METRICS                   RecordKind = 12
+	RecordKind_RECORD_KIND_PIPELINES                 RecordKind = 13
+	RecordKind_RECORD_KIND_PROJECTS                  RecordKind = 14
+	RecordKind_RECORD_KIND_RUNNERS                   RecordKind = 15
+	RecordKind_RECORD_KIND_SECTIONS                  RecordKind = 16
+	RecordKind_RECORD_KIND_TEST_CASES                RecordKind = 17
+	RecordKind_RECORD_KIND_TEST_REPORTS              RecordKind = 18
+	RecordKind_RECORD_KIND_TEST_SUITES               RecordKind = 19
+	RecordKind_RECORD_KIND_TRACES                    RecordKind = 20
+	RecordKind_RECORD_KIND_ENVIRONMENTS              RecordKind = 21
+	RecordKind_RECORD_KIND_JOB_ARTIFACTS             RecordKind = 22
+	RecordKind_RECORD_KIND_TAGS                      RecordKind = 23
+	RecordKind_RECORD_KIND_RELEASES                  RecordKind = 24
+	RecordKind_RECORD_KIND_MERGE_REQUEST_EVENTS      RecordKind = 25
+)
+
+// Enum value maps for RecordKind.
+var (
+	RecordKind_name = map[int32]string{
+		0:  "RECORD_KIND_UNSPECIFIED",
+		1:  "RECORD_KIND_COMMITS",
+		2:  "RECORD_KIND_COVERAGE_REPORTS",
+		3:  "RECORD_KIND_COVERAGE_PACKAGES",
+		4:  "RECORD_KIND_COVERAGE_CLASSES",
+		5:  "RECORD_KIND_COVERAGE_METHODS",
+		6:  "RECORD_KIND_DEPLOYMENTS",
+		7:  "RECORD_KIND_ISSUES",
+		8:  "RECORD_KIND_JOBS",
+		9:  "RECORD_KIND_MERGE_REQUESTS",
+		10: "RECORD_KIND_MERGE_REQUEST_COMMITS",
+		11: "RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS",
+		12: "RECORD_KIND_METRICS",
+		13: "RECORD_KIND_PIPELINES",
+		14: "RECORD_KIND_PROJECTS",
+		15: "RECORD_KIND_RUNNERS",
+		16: "RECORD_KIND_SECTIONS",
+		17: "RECORD_KIND_TEST_CASES",
+		18: "RECORD_KIND_TEST_REPORTS",
+		19: "RECORD_KIND_TEST_SUITES",
+		20: "RECORD_KIND_TRACES",
+		21: "RECORD_KIND_ENVIRONMENTS",
+		22: "RECORD_KIND_JOB_ARTIFACTS",
+		23: "RECORD_KIND_TAGS",
+		24: "RECORD_KIND_RELEASES",
+		25: "RECORD_KIND_MERGE_REQUEST_EVENTS",
+	}
+	RecordKind_value = map[string]int32{
+		"RECORD_KIND_UNSPECIFIED":               0,
+		"RECORD_KIND_COMMITS":                   1,
+		"RECORD_KIND_COVERAGE_REPORTS":          2,
+		"RECORD_KIND_COVERAGE_PACKAGES":         3,
+		"RECORD_KIND_COVERAGE_CLASSES":          4,
+		"RECORD_KIND_COVERAGE_METHODS":          5,
+		"RECORD_KIND_DEPLOYMENTS":               6,
+		"RECORD_KIND_ISSUES":                    7,
+		"RECORD_KIND_JOBS":                      8,
+		"RECORD_KIND_MERGE_REQUESTS":            9,
+		"RECORD_KIND_MERGE_REQUEST_COMMITS":     10,
+		"RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS": 11,
+		"RECORD_KIND_METRICS":                   12,
+		"RECORD_KIND_PIPELINES":                 13,
+		"RECORD_KIND_PROJECTS":                  14,
+		"RECORD_KIND_RUNNERS":                   15,
+		"RECORD_KIND_SECTIONS":                  16,
+		"RECORD_KIND_TEST_CASES":                17,
+		"RECORD_KIND_TEST_REPORTS":              18,
+		"RECORD_KIND_TEST_SUITES":               19,
+		"RECORD_KIND_TRACES":                    20,
+		"RECORD_KIND_ENVIRONMENTS":              21,
+		"RECORD_KIND_JOB_ARTIFACTS":             22,
+		"RECORD_KIND_TAGS":                      23,
+		"RECORD_KIND_RELEASES":                  24,
+		"RECORD_KIND_MERGE_REQUEST_EVENTS":      25,
+	}
+)
+
+func (x RecordKind) Enum() *RecordKind {
+	p := new(RecordKind)
+	*p = x
+	return p
+}
+
+func (x RecordKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecordKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitlabexporter_protobuf_service_service_proto_enumTypes[1].Descriptor()
+}
+
+func (RecordKind) Type() protoreflect.EnumType {
+	return &file_gitlabexporter_protobuf_service_service_proto_enumTypes[1]
+}
+
+func (x RecordKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecordKind.Descriptor instead.
+func (RecordKind) EnumDescriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{1}
+}
+
+type GetCapabilitiesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion ProtocolVersion        `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3,enum=gitlabexporter.protobuf.service.ProtocolVersion" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCapabilitiesRequest) Reset() {
+	*x = GetCapabilitiesRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilitiesRequest) ProtoMessage() {}
+
+func (x *GetCapabilitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCapabilitiesRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCapabilitiesRequest) GetProtocolVersion() ProtocolVersion {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ProtocolVersion_PROTOCOL_VERSION_UNSPECIFIED
+}
+
+type Capabilities struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProtocolVersion ProtocolVersion        `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3,enum=gitlabexporter.protobuf.service.ProtocolVersion" json:"protocol_version,omitempty"`
+	RecordKinds     []RecordKind           `protobuf:"varint,2,rep,packed,name=record_kinds,json=recordKinds,proto3,enum=gitlabexporter.protobuf.service.RecordKind" json:"record_kinds,omitempty"`
+	RecordStream    bool                   `protobuf:"varint,3,opt,name=record_stream,json=recordStream,proto3" json:"record_stream,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Capabilities) GetProtocolVersion() ProtocolVersion {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ProtocolVersion_PROTOCOL_VERSION_UNSPECIFIED
+}
+
+func (x *Capabilities) GetRecordKinds() []RecordKind {
+	if x != nil {
+		return x.RecordKinds
+	}
+	return nil
+}
+
+func (x *Capabilities) GetRecordStream() bool {
+	if x != nil {
+		return x.RecordStream
+	}
+	return false
+}
+
 type RecordSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RecordedCount int32                  `protobuf:"varint,1,opt,name=recorded_count,json=recordedCount,proto3" json:"recorded_count,omitempty"`
@@ -32,7 +300,7 @@ type RecordSummary struct {
 
 func (x *RecordSummary) Reset() {
 	*x = RecordSummary{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[0]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +312,7 @@ func (x *RecordSummary) String() string {
 func (*RecordSummary) ProtoMessage() {}
 
 func (x *RecordSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[0]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +325,7 @@ func (x *RecordSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSummary.ProtoReflect.Descriptor instead.
 func (*RecordSummary) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{0}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RecordSummary) GetRecordedCount() int32 {
@@ -77,7 +345,7 @@ type RecordRequestMetadata struct {
 
 func (x *RecordRequestMetadata) Reset() {
 	*x = RecordRequestMetadata{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[1]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -89,7 +357,7 @@ func (x *RecordRequestMetadata) String() string {
 func (*RecordRequestMetadata) ProtoMessage() {}
 
 func (x *RecordRequestMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[1]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -102,7 +370,7 @@ func (x *RecordRequestMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordRequestMetadata.ProtoReflect.Descriptor instead.
 func (*RecordRequestMetadata) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{1}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RecordRequestMetadata) GetFetchedAt() *timestamppb.Timestamp {
@@ -128,7 +396,7 @@ type RecordCommitsRequest struct {
 
 func (x *RecordCommitsRequest) Reset() {
 	*x = RecordCommitsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[2]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -140,7 +408,7 @@ func (x *RecordCommitsRequest) String() string {
 func (*RecordCommitsRequest) ProtoMessage() {}
 
 func (x *RecordCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[2]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +421,7 @@ func (x *RecordCommitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordCommitsRequest.ProtoReflect.Descriptor instead.
 func (*RecordCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{2}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RecordCommitsRequest) GetData() []*typespb.Commit {
@@ -172,7 +440,7 @@ type RecordCoverageReportsRequest struct {
 
 func (x *RecordCoverageReportsRequest) Reset() {
 	*x = RecordCoverageReportsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[3]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +452,7 @@ func (x *RecordCoverageReportsRequest) String() string {
 func (*RecordCoverageReportsRequest) ProtoMessage() {}
 
 func (x *RecordCoverageReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[3]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +465,7 @@ func (x *RecordCoverageReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordCoverageReportsRequest.ProtoReflect.Descriptor instead.
 func (*RecordCoverageReportsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{3}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RecordCoverageReportsRequest) GetData() []*typespb.CoverageReport {
@@ -216,7 +484,7 @@ type RecordCoveragePackagesRequest struct {
 
 func (x *RecordCoveragePackagesRequest) Reset() {
 	*x = RecordCoveragePackagesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[4]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -228,7 +496,7 @@ func (x *RecordCoveragePackagesRequest) String() string {
 func (*RecordCoveragePackagesRequest) ProtoMessage() {}
 
 func (x *RecordCoveragePackagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[4]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -241,7 +509,7 @@ func (x *RecordCoveragePackagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordCoveragePackagesRequest.ProtoReflect.Descriptor instead.
 func (*RecordCoveragePackagesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{4}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *RecordCoveragePackagesRequest) GetData() []*typespb.CoveragePackage {
@@ -260,7 +528,7 @@ type RecordCoverageClassesRequest struct {
 
 func (x *RecordCoverageClassesRequest) Reset() {
 	*x = RecordCoverageClassesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[5]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +540,7 @@ func (x *RecordCoverageClassesRequest) String() string {
 func (*RecordCoverageClassesRequest) ProtoMessage() {}
 
 func (x *RecordCoverageClassesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[5]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +553,7 @@ func (x *RecordCoverageClassesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordCoverageClassesRequest.ProtoReflect.Descriptor instead.
 func (*RecordCoverageClassesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{5}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RecordCoverageClassesRequest) GetData() []*typespb.CoverageClass {
@@ -304,7 +572,7 @@ type RecordCoverageMethodsRequest struct {
 
 func (x *RecordCoverageMethodsRequest) Reset() {
 	*x = RecordCoverageMethodsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[6]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +584,7 @@ func (x *RecordCoverageMethodsRequest) String() string {
 func (*RecordCoverageMethodsRequest) ProtoMessage() {}
 
 func (x *RecordCoverageMethodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[6]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +597,7 @@ func (x *RecordCoverageMethodsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordCoverageMethodsRequest.ProtoReflect.Descriptor instead.
 func (*RecordCoverageMethodsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{6}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RecordCoverageMethodsRequest) GetData() []*typespb.CoverageMethod {
@@ -348,7 +616,7 @@ type RecordDeploymentsRequest struct {
 
 func (x *RecordDeploymentsRequest) Reset() {
 	*x = RecordDeploymentsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[7]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -360,7 +628,7 @@ func (x *RecordDeploymentsRequest) String() string {
 func (*RecordDeploymentsRequest) ProtoMessage() {}
 
 func (x *RecordDeploymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[7]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -373,7 +641,7 @@ func (x *RecordDeploymentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordDeploymentsRequest.ProtoReflect.Descriptor instead.
 func (*RecordDeploymentsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{7}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RecordDeploymentsRequest) GetData() []*typespb.Deployment {
@@ -383,6 +651,50 @@ func (x *RecordDeploymentsRequest) GetData() []*typespb.Deployment {
 	return nil
 }
 
+type RecordEnvironmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*typespb.Environment `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordEnvironmentsRequest) Reset() {
+	*x = RecordEnvironmentsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordEnvironmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordEnvironmentsRequest) ProtoMessage() {}
+
+func (x *RecordEnvironmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordEnvironmentsRequest.ProtoReflect.Descriptor instead.
+func (*RecordEnvironmentsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecordEnvironmentsRequest) GetData() []*typespb.Environment {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type RecordIssuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*typespb.Issue       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -392,7 +704,7 @@ type RecordIssuesRequest struct {
 
 func (x *RecordIssuesRequest) Reset() {
 	*x = RecordIssuesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[8]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +716,7 @@ func (x *RecordIssuesRequest) String() string {
 func (*RecordIssuesRequest) ProtoMessage() {}
 
 func (x *RecordIssuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[8]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +729,7 @@ func (x *RecordIssuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordIssuesRequest.ProtoReflect.Descriptor instead.
 func (*RecordIssuesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{8}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RecordIssuesRequest) GetData() []*typespb.Issue {
@@ -436,7 +748,7 @@ type RecordJobsRequest struct {
 
 func (x *RecordJobsRequest) Reset() {
 	*x = RecordJobsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[9]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +760,7 @@ func (x *RecordJobsRequest) String() string {
 func (*RecordJobsRequest) ProtoMessage() {}
 
 func (x *RecordJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[9]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +773,7 @@ func (x *RecordJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordJobsRequest.ProtoReflect.Descriptor instead.
 func (*RecordJobsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{9}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RecordJobsRequest) GetData() []*typespb.Job {
@@ -471,28 +783,28 @@ func (x *RecordJobsRequest) GetData() []*typespb.Job {
 	return nil
 }
 
-type RecordMergeRequestsRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Data          []*typespb.MergeRequest `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type RecordJobArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*typespb.JobArtifact `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordMergeRequestsRequest) Reset() {
-	*x = RecordMergeRequestsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[10]
+func (x *RecordJobArtifactsRequest) Reset() {
+	*x = RecordJobArtifactsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordMergeRequestsRequest) String() string {
+func (x *RecordJobArtifactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordMergeRequestsRequest) ProtoMessage() {}
+func (*RecordJobArtifactsRequest) ProtoMessage() {}
 
-func (x *RecordMergeRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[10]
+func (x *RecordJobArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,40 +815,40 @@ func (x *RecordMergeRequestsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordMergeRequestsRequest.ProtoReflect.Descriptor instead.
-func (*RecordMergeRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RecordJobArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*RecordJobArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RecordMergeRequestsRequest) GetData() []*typespb.MergeRequest {
+func (x *RecordJobArtifactsRequest) GetData() []*typespb.JobArtifact {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type RecordMergeRequestCommitsRequest struct {
-	state         protoimpl.MessageState        `protogen:"open.v1"`
-	Data          []*typespb.MergeRequestCommit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type RecordTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*typespb.Tag         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordMergeRequestCommitsRequest) Reset() {
-	*x = RecordMergeRequestCommitsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[11]
+func (x *RecordTagsRequest) Reset() {
+	*x = RecordTagsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordMergeRequestCommitsRequest) String() string {
+func (x *RecordTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordMergeRequestCommitsRequest) ProtoMessage() {}
+func (*RecordTagsRequest) ProtoMessage() {}
 
-func (x *RecordMergeRequestCommitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[11]
+func (x *RecordTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,40 +859,40 @@ func (x *RecordMergeRequestCommitsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordMergeRequestCommitsRequest.ProtoReflect.Descriptor instead.
-func (*RecordMergeRequestCommitsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RecordTagsRequest.ProtoReflect.Descriptor instead.
+func (*RecordTagsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RecordMergeRequestCommitsRequest) GetData() []*typespb.MergeRequestCommit {
+func (x *RecordTagsRequest) GetData() []*typespb.Tag {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type RecordMergeRequestNoteEventsRequest struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Data          []*typespb.MergeRequestNoteEvent `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type RecordReleasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*typespb.Release     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecordMergeRequestNoteEventsRequest) Reset() {
-	*x = RecordMergeRequestNoteEventsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[12]
+func (x *RecordReleasesRequest) Reset() {
+	*x = RecordReleasesRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecordMergeRequestNoteEventsRequest) String() string {
+func (x *RecordReleasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecordMergeRequestNoteEventsRequest) ProtoMessage() {}
+func (*RecordReleasesRequest) ProtoMessage() {}
 
-func (x *RecordMergeRequestNoteEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[12]
+func (x *RecordReleasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -591,9 +903,141 @@ func (x *RecordMergeRequestNoteEventsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecordMergeRequestNoteEventsRequest.ProtoReflect.Descriptor instead.
-func (*RecordMergeRequestNoteEventsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RecordReleasesRequest.ProtoReflect.Descriptor instead.
+func (*RecordReleasesRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RecordReleasesRequest) GetData() []*typespb.Release {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RecordMergeRequestsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*typespb.MergeRequest `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMergeRequestsRequest) Reset() {
+	*x = RecordMergeRequestsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMergeRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMergeRequestsRequest) ProtoMessage() {}
+
+func (x *RecordMergeRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMergeRequestsRequest.ProtoReflect.Descriptor instead.
+func (*RecordMergeRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RecordMergeRequestsRequest) GetData() []*typespb.MergeRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RecordMergeRequestCommitsRequest struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Data          []*typespb.MergeRequestCommit `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMergeRequestCommitsRequest) Reset() {
+	*x = RecordMergeRequestCommitsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMergeRequestCommitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMergeRequestCommitsRequest) ProtoMessage() {}
+
+func (x *RecordMergeRequestCommitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMergeRequestCommitsRequest.ProtoReflect.Descriptor instead.
+func (*RecordMergeRequestCommitsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RecordMergeRequestCommitsRequest) GetData() []*typespb.MergeRequestCommit {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type RecordMergeRequestNoteEventsRequest struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Data          []*typespb.MergeRequestNoteEvent `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMergeRequestNoteEventsRequest) Reset() {
+	*x = RecordMergeRequestNoteEventsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMergeRequestNoteEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMergeRequestNoteEventsRequest) ProtoMessage() {}
+
+func (x *RecordMergeRequestNoteEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMergeRequestNoteEventsRequest.ProtoReflect.Descriptor instead.
+func (*RecordMergeRequestNoteEventsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RecordMergeRequestNoteEventsRequest) GetData() []*typespb.MergeRequestNoteEvent {
@@ -603,6 +1047,50 @@ func (x *RecordMergeRequestNoteEventsRequest) GetData() []*typespb.MergeRequestN
 	return nil
 }
 
+type RecordMergeRequestEventsRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Data          []*typespb.MergeRequestEvent `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordMergeRequestEventsRequest) Reset() {
+	*x = RecordMergeRequestEventsRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordMergeRequestEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordMergeRequestEventsRequest) ProtoMessage() {}
+
+func (x *RecordMergeRequestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordMergeRequestEventsRequest.ProtoReflect.Descriptor instead.
+func (*RecordMergeRequestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecordMergeRequestEventsRequest) GetData() []*typespb.MergeRequestEvent {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 type RecordMetricsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Data          []*typespb.Metric      `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
@@ -612,7 +1100,7 @@ type RecordMetricsRequest struct {
 
 func (x *RecordMetricsRequest) Reset() {
 	*x = RecordMetricsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[13]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -624,7 +1112,7 @@ func (x *RecordMetricsRequest) String() string {
 func (*RecordMetricsRequest) ProtoMessage() {}
 
 func (x *RecordMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[13]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,7 +1125,7 @@ func (x *RecordMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordMetricsRequest.ProtoReflect.Descriptor instead.
 func (*RecordMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{13}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RecordMetricsRequest) GetData() []*typespb.Metric {
@@ -656,7 +1144,7 @@ type RecordPipelinesRequest struct {
 
 func (x *RecordPipelinesRequest) Reset() {
 	*x = RecordPipelinesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[14]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -668,7 +1156,7 @@ func (x *RecordPipelinesRequest) String() string {
 func (*RecordPipelinesRequest) ProtoMessage() {}
 
 func (x *RecordPipelinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[14]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,7 +1169,7 @@ func (x *RecordPipelinesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordPipelinesRequest.ProtoReflect.Descriptor instead.
 func (*RecordPipelinesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{14}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RecordPipelinesRequest) GetData() []*typespb.Pipeline {
@@ -700,7 +1188,7 @@ type RecordProjectsRequest struct {
 
 func (x *RecordProjectsRequest) Reset() {
 	*x = RecordProjectsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[15]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +1200,7 @@ func (x *RecordProjectsRequest) String() string {
 func (*RecordProjectsRequest) ProtoMessage() {}
 
 func (x *RecordProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[15]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +1213,7 @@ func (x *RecordProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordProjectsRequest.ProtoReflect.Descriptor instead.
 func (*RecordProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{15}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RecordProjectsRequest) GetData() []*typespb.Project {
@@ -745,7 +1233,7 @@ type RecordRunnersRequest struct {
 
 func (x *RecordRunnersRequest) Reset() {
 	*x = RecordRunnersRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[16]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +1245,7 @@ func (x *RecordRunnersRequest) String() string {
 func (*RecordRunnersRequest) ProtoMessage() {}
 
 func (x *RecordRunnersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[16]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1258,7 @@ func (x *RecordRunnersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordRunnersRequest.ProtoReflect.Descriptor instead.
 func (*RecordRunnersRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{16}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RecordRunnersRequest) GetData() []*typespb.Runner {
@@ -796,7 +1284,7 @@ type RecordSectionsRequest struct {
 
 func (x *RecordSectionsRequest) Reset() {
 	*x = RecordSectionsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[17]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +1296,7 @@ func (x *RecordSectionsRequest) String() string {
 func (*RecordSectionsRequest) ProtoMessage() {}
 
 func (x *RecordSectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[17]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1309,7 @@ func (x *RecordSectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordSectionsRequest.ProtoReflect.Descriptor instead.
 func (*RecordSectionsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{17}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RecordSectionsRequest) GetData() []*typespb.Section {
@@ -840,7 +1328,7 @@ type RecordTestCasesRequest struct {
 
 func (x *RecordTestCasesRequest) Reset() {
 	*x = RecordTestCasesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[18]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -852,7 +1340,7 @@ func (x *RecordTestCasesRequest) String() string {
 func (*RecordTestCasesRequest) ProtoMessage() {}
 
 func (x *RecordTestCasesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[18]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +1353,7 @@ func (x *RecordTestCasesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTestCasesRequest.ProtoReflect.Descriptor instead.
 func (*RecordTestCasesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{18}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *RecordTestCasesRequest) GetData() []*typespb.TestCase {
@@ -884,7 +1372,7 @@ type RecordTestReportsRequest struct {
 
 func (x *RecordTestReportsRequest) Reset() {
 	*x = RecordTestReportsRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[19]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -896,7 +1384,7 @@ func (x *RecordTestReportsRequest) String() string {
 func (*RecordTestReportsRequest) ProtoMessage() {}
 
 func (x *RecordTestReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[19]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,7 +1397,7 @@ func (x *RecordTestReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTestReportsRequest.ProtoReflect.Descriptor instead.
 func (*RecordTestReportsRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{19}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *RecordTestReportsRequest) GetData() []*typespb.TestReport {
@@ -928,7 +1416,7 @@ type RecordTestSuitesRequest struct {
 
 func (x *RecordTestSuitesRequest) Reset() {
 	*x = RecordTestSuitesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[20]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -940,7 +1428,7 @@ func (x *RecordTestSuitesRequest) String() string {
 func (*RecordTestSuitesRequest) ProtoMessage() {}
 
 func (x *RecordTestSuitesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[20]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1441,7 @@ func (x *RecordTestSuitesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTestSuitesRequest.ProtoReflect.Descriptor instead.
 func (*RecordTestSuitesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{20}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *RecordTestSuitesRequest) GetData() []*typespb.TestSuite {
@@ -972,7 +1460,7 @@ type RecordTracesRequest struct {
 
 func (x *RecordTracesRequest) Reset() {
 	*x = RecordTracesRequest{}
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[21]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -984,7 +1472,7 @@ func (x *RecordTracesRequest) String() string {
 func (*RecordTracesRequest) ProtoMessage() {}
 
 func (x *RecordTracesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[21]
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,7 +1485,7 @@ func (x *RecordTracesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RecordTracesRequest.ProtoReflect.Descriptor instead.
 func (*RecordTracesRequest) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{21}
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RecordTracesRequest) GetData() []*typespb.Trace {
@@ -1007,11 +1495,467 @@ func (x *RecordTracesRequest) GetData() []*typespb.Trace {
 	return nil
 }
 
+type RecordStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Records:
+	//
+	//	*RecordStreamRequest_Commits
+	//	*RecordStreamRequest_CoverageReports
+	//	*RecordStreamRequest_CoveragePackages
+	//	*RecordStreamRequest_CoverageClasses
+	//	*RecordStreamRequest_CoverageMethods
+	//	*RecordStreamRequest_Deployments
+	//	*RecordStreamRequest_Issues
+	//	*RecordStreamRequest_Jobs
+	//	*RecordStreamRequest_MergeRequests
+	//	*RecordStreamRequest_MergeRequestCommits
+	//	*RecordStreamRequest_MergeRequestNoteEvents
+	//	*RecordStreamRequest_Metrics
+	//	*RecordStreamRequest_Pipelines
+	//	*RecordStreamRequest_Projects
+	//	*RecordStreamRequest_Runners
+	//	*RecordStreamRequest_Sections
+	//	*RecordStreamRequest_TestCases
+	//	*RecordStreamRequest_TestReports
+	//	*RecordStreamRequest_TestSuites
+	//	*RecordStreamRequest_Traces
+	//	*RecordStreamRequest_Environments
+	//	*RecordStreamRequest_JobArtifacts
+	//	*RecordStreamRequest_Tags
+	//	*RecordStreamRequest_Releases
+	//	*RecordStreamRequest_MergeRequestEvents
+	Records       isRecordStreamRequest_Records `protobuf_oneof:"records"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStreamRequest) Reset() {
+	*x = RecordStreamRequest{}
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStreamRequest) ProtoMessage() {}
+
+func (x *RecordStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_service_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStreamRequest.ProtoReflect.Descriptor instead.
+func (*RecordStreamRequest) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecordStreamRequest) GetRecords() isRecordStreamRequest_Records {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetCommits() *RecordCommitsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Commits); ok {
+			return x.Commits
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetCoverageReports() *RecordCoverageReportsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_CoverageReports); ok {
+			return x.CoverageReports
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetCoveragePackages() *RecordCoveragePackagesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_CoveragePackages); ok {
+			return x.CoveragePackages
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetCoverageClasses() *RecordCoverageClassesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_CoverageClasses); ok {
+			return x.CoverageClasses
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetCoverageMethods() *RecordCoverageMethodsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_CoverageMethods); ok {
+			return x.CoverageMethods
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetDeployments() *RecordDeploymentsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Deployments); ok {
+			return x.Deployments
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetIssues() *RecordIssuesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Issues); ok {
+			return x.Issues
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetJobs() *RecordJobsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Jobs); ok {
+			return x.Jobs
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetMergeRequests() *RecordMergeRequestsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_MergeRequests); ok {
+			return x.MergeRequests
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetMergeRequestCommits() *RecordMergeRequestCommitsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_MergeRequestCommits); ok {
+			return x.MergeRequestCommits
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetMergeRequestNoteEvents() *RecordMergeRequestNoteEventsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_MergeRequestNoteEvents); ok {
+			return x.MergeRequestNoteEvents
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetMetrics() *RecordMetricsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Metrics); ok {
+			return x.Metrics
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetPipelines() *RecordPipelinesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Pipelines); ok {
+			return x.Pipelines
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetProjects() *RecordProjectsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Projects); ok {
+			return x.Projects
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetRunners() *RecordRunnersRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Runners); ok {
+			return x.Runners
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetSections() *RecordSectionsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Sections); ok {
+			return x.Sections
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetTestCases() *RecordTestCasesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_TestCases); ok {
+			return x.TestCases
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetTestReports() *RecordTestReportsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_TestReports); ok {
+			return x.TestReports
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetTestSuites() *RecordTestSuitesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_TestSuites); ok {
+			return x.TestSuites
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetTraces() *RecordTracesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Traces); ok {
+			return x.Traces
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetEnvironments() *RecordEnvironmentsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Environments); ok {
+			return x.Environments
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetJobArtifacts() *RecordJobArtifactsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_JobArtifacts); ok {
+			return x.JobArtifacts
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetTags() *RecordTagsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Tags); ok {
+			return x.Tags
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetReleases() *RecordReleasesRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_Releases); ok {
+			return x.Releases
+		}
+	}
+	return nil
+}
+
+func (x *RecordStreamRequest) GetMergeRequestEvents() *RecordMergeRequestEventsRequest {
+	if x != nil {
+		if x, ok := x.Records.(*RecordStreamRequest_MergeRequestEvents); ok {
+			return x.MergeRequestEvents
+		}
+	}
+	return nil
+}
+
+type isRecordStreamRequest_Records interface {
+	isRecordStreamRequest_Records()
+}
+
+type RecordStreamRequest_Commits struct {
+	Commits *RecordCommitsRequest `protobuf:"bytes,1,opt,name=commits,proto3,oneof"`
+}
+
+type RecordStreamRequest_CoverageReports struct {
+	CoverageReports *RecordCoverageReportsRequest `protobuf:"bytes,2,opt,name=coverage_reports,json=coverageReports,proto3,oneof"`
+}
+
+type RecordStreamRequest_CoveragePackages struct {
+	CoveragePackages *RecordCoveragePackagesRequest `protobuf:"bytes,3,opt,name=coverage_packages,json=coveragePackages,proto3,oneof"`
+}
+
+type RecordStreamRequest_CoverageClasses struct {
+	CoverageClasses *RecordCoverageClassesRequest `protobuf:"bytes,4,opt,name=coverage_classes,json=coverageClasses,proto3,oneof"`
+}
+
+type RecordStreamRequest_CoverageMethods struct {
+	CoverageMethods *RecordCoverageMethodsRequest `protobuf:"bytes,5,opt,name=coverage_methods,json=coverageMethods,proto3,oneof"`
+}
+
+type RecordStreamRequest_Deployments struct {
+	Deployments *RecordDeploymentsRequest `protobuf:"bytes,6,opt,name=deployments,proto3,oneof"`
+}
+
+type RecordStreamRequest_Issues struct {
+	Issues *RecordIssuesRequest `protobuf:"bytes,7,opt,name=issues,proto3,oneof"`
+}
+
+type RecordStreamRequest_Jobs struct {
+	Jobs *RecordJobsRequest `protobuf:"bytes,8,opt,name=jobs,proto3,oneof"`
+}
+
+type RecordStreamRequest_MergeRequests struct {
+	MergeRequests *RecordMergeRequestsRequest `protobuf:"bytes,9,opt,name=merge_requests,json=mergeRequests,proto3,oneof"`
+}
+
+type RecordStreamRequest_MergeRequestCommits struct {
+	MergeRequestCommits *RecordMergeRequestCommitsRequest `protobuf:"bytes,10,opt,name=merge_request_commits,json=mergeRequestCommits,proto3,oneof"`
+}
+
+type RecordStreamRequest_MergeRequestNoteEvents struct {
+	MergeRequestNoteEvents *RecordMergeRequestNoteEventsRequest `protobuf:"bytes,11,opt,name=merge_request_note_events,json=mergeRequestNoteEvents,proto3,oneof"`
+}
+
+type RecordStreamRequest_Metrics struct {
+	Metrics *RecordMetricsRequest `protobuf:"bytes,12,opt,name=metrics,proto3,oneof"`
+}
+
+type RecordStreamRequest_Pipelines struct {
+	Pipelines *RecordPipelinesRequest `protobuf:"bytes,13,opt,name=pipelines,proto3,oneof"`
+}
+
+type RecordStreamRequest_Projects struct {
+	Projects *RecordProjectsRequest `protobuf:"bytes,14,opt,name=projects,proto3,oneof"`
+}
+
+type RecordStreamRequest_Runners struct {
+	Runners *RecordRunnersRequest `protobuf:"bytes,15,opt,name=runners,proto3,oneof"`
+}
+
+type RecordStreamRequest_Sections struct {
+	Sections *RecordSectionsRequest `protobuf:"bytes,16,opt,name=sections,proto3,oneof"`
+}
+
+type RecordStreamRequest_TestCases struct {
+	TestCases *RecordTestCasesRequest `protobuf:"bytes,17,opt,name=test_cases,json=testCases,proto3,oneof"`
+}
+
+type RecordStreamRequest_TestReports struct {
+	TestReports *RecordTestReportsRequest `protobuf:"bytes,18,opt,name=test_reports,json=testReports,proto3,oneof"`
+}
+
+type RecordStreamRequest_TestSuites struct {
+	TestSuites *RecordTestSuitesRequest `protobuf:"bytes,19,opt,name=test_suites,json=testSuites,proto3,oneof"`
+}
+
+type RecordStreamRequest_Traces struct {
+	Traces *RecordTracesRequest `protobuf:"bytes,20,opt,name=traces,proto3,oneof"`
+}
+
+type RecordStreamRequest_Environments struct {
+	Environments *RecordEnvironmentsRequest `protobuf:"bytes,21,opt,name=environments,proto3,oneof"`
+}
+
+type RecordStreamRequest_JobArtifacts struct {
+	JobArtifacts *RecordJobArtifactsRequest `protobuf:"bytes,22,opt,name=job_artifacts,json=jobArtifacts,proto3,oneof"`
+}
+
+type RecordStreamRequest_Tags struct {
+	Tags *RecordTagsRequest `protobuf:"bytes,23,opt,name=tags,proto3,oneof"`
+}
+
+type RecordStreamRequest_Releases struct {
+	Releases *RecordReleasesRequest `protobuf:"bytes,24,opt,name=releases,proto3,oneof"`
+}
+
+type RecordStreamRequest_MergeRequestEvents struct {
+	MergeRequestEvents *RecordMergeRequestEventsRequest `protobuf:"bytes,25,opt,name=merge_request_events,json=mergeRequestEvents,proto3,oneof"`
+}
+
+func (*RecordStreamRequest_Commits) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_CoverageReports) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_CoveragePackages) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_CoverageClasses) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_CoverageMethods) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Deployments) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Issues) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Jobs) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_MergeRequests) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_MergeRequestCommits) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_MergeRequestNoteEvents) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Metrics) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Pipelines) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Projects) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Runners) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Sections) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_TestCases) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_TestReports) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_TestSuites) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Traces) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Environments) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_JobArtifacts) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Tags) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_Releases) isRecordStreamRequest_Records() {}
+
+func (*RecordStreamRequest_MergeRequestEvents) isRecordStreamRequest_Records() {}
+
 var File_gitlabexporter_protobuf_service_service_proto protoreflect.FileDescriptor
 
 const file_gitlabexporter_protobuf_service_service_proto_rawDesc = "" +
 	"\n" +
-	"-gitlabexporter/protobuf/service/service.proto\x12\x1fgitlabexporter.protobuf.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$gitlabexporter/protobuf/commit.proto\x1a&gitlabexporter/protobuf/coverage.proto\x1a(gitlabexporter/protobuf/deployment.proto\x1a#gitlabexporter/protobuf/issue.proto\x1a!gitlabexporter/protobuf/job.proto\x1a+gitlabexporter/protobuf/merge_request.proto\x1a$gitlabexporter/protobuf/metric.proto\x1a&gitlabexporter/protobuf/pipeline.proto\x1a%gitlabexporter/protobuf/project.proto\x1a$gitlabexporter/protobuf/runner.proto\x1a%gitlabexporter/protobuf/section.proto\x1a)gitlabexporter/protobuf/test_report.proto\x1a#gitlabexporter/protobuf/trace.proto\"6\n" +
+	"-gitlabexporter/protobuf/service/service.proto\x12\x1fgitlabexporter.protobuf.service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$gitlabexporter/protobuf/commit.proto\x1a&gitlabexporter/protobuf/coverage.proto\x1a(gitlabexporter/protobuf/deployment.proto\x1a)gitlabexporter/protobuf/environment.proto\x1a#gitlabexporter/protobuf/issue.proto\x1a!gitlabexporter/protobuf/job.proto\x1a+gitlabexporter/protobuf/merge_request.proto\x1a$gitlabexporter/protobuf/metric.proto\x1a&gitlabexporter/protobuf/pipeline.proto\x1a%gitlabexporter/protobuf/project.proto\x1a%gitlabexporter/protobuf/release.proto\x1a$gitlabexporter/protobuf/runner.proto\x1a%gitlabexporter/protobuf/section.proto\x1a)gitlabexporter/protobuf/test_report.proto\x1a#gitlabexporter/protobuf/trace.proto\"u\n" +
+	"\x16GetCapabilitiesRequest\x12[\n" +
+	"\x10protocol_version\x18\x01 \x01(\x0e20.gitlabexporter.protobuf.service.ProtocolVersionR\x0fprotocolVersion\"\xe0\x01\n" +
+	"\fCapabilities\x12[\n" +
+	"\x10protocol_version\x18\x01 \x01(\x0e20.gitlabexporter.protobuf.service.ProtocolVersionR\x0fprotocolVersion\x12N\n" +
+	"\frecord_kinds\x18\x02 \x03(\x0e2+.gitlabexporter.protobuf.service.RecordKindR\vrecordKinds\x12#\n" +
+	"\rrecord_stream\x18\x03 \x01(\bR\frecordStream\"6\n" +
 	"\rRecordSummary\x12%\n" +
 	"\x0erecorded_count\x18\x01 \x01(\x05R\rrecordedCount\"\x8f\x01\n" +
 	"\x15RecordRequestMetadata\x129\n" +
@@ -1030,17 +1974,27 @@ const file_gitlabexporter_protobuf_service_service_proto_rawDesc = "" +
 	"\x1cRecordCoverageMethodsRequest\x12;\n" +
 	"\x04data\x18\x01 \x03(\v2'.gitlabexporter.protobuf.CoverageMethodR\x04data\"S\n" +
 	"\x18RecordDeploymentsRequest\x127\n" +
-	"\x04data\x18\x01 \x03(\v2#.gitlabexporter.protobuf.DeploymentR\x04data\"I\n" +
+	"\x04data\x18\x01 \x03(\v2#.gitlabexporter.protobuf.DeploymentR\x04data\"U\n" +
+	"\x19RecordEnvironmentsRequest\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.gitlabexporter.protobuf.EnvironmentR\x04data\"I\n" +
 	"\x13RecordIssuesRequest\x122\n" +
 	"\x04data\x18\x01 \x03(\v2\x1e.gitlabexporter.protobuf.IssueR\x04data\"E\n" +
 	"\x11RecordJobsRequest\x120\n" +
-	"\x04data\x18\x01 \x03(\v2\x1c.gitlabexporter.protobuf.JobR\x04data\"W\n" +
+	"\x04data\x18\x01 \x03(\v2\x1c.gitlabexporter.protobuf.JobR\x04data\"U\n" +
+	"\x19RecordJobArtifactsRequest\x128\n" +
+	"\x04data\x18\x01 \x03(\v2$.gitlabexporter.protobuf.JobArtifactR\x04data\"E\n" +
+	"\x11RecordTagsRequest\x120\n" +
+	"\x04data\x18\x01 \x03(\v2\x1c.gitlabexporter.protobuf.TagR\x04data\"M\n" +
+	"\x15RecordReleasesRequest\x124\n" +
+	"\x04data\x18\x01 \x03(\v2 .gitlabexporter.protobuf.ReleaseR\x04data\"W\n" +
 	"\x1aRecordMergeRequestsRequest\x129\n" +
 	"\x04data\x18\x01 \x03(\v2%.gitlabexporter.protobuf.MergeRequestR\x04data\"c\n" +
 	" RecordMergeRequestCommitsRequest\x12?\n" +
 	"\x04data\x18\x01 \x03(\v2+.gitlabexporter.protobuf.MergeRequestCommitR\x04data\"i\n" +
 	"#RecordMergeRequestNoteEventsRequest\x12B\n" +
-	"\x04data\x18\x01 \x03(\v2..gitlabexporter.protobuf.MergeRequestNoteEventR\x04data\"K\n" +
+	"\x04data\x18\x01 \x03(\v2..gitlabexporter.protobuf.MergeRequestNoteEventR\x04data\"a\n" +
+	"\x1fRecordMergeRequestEventsRequest\x12>\n" +
+	"\x04data\x18\x01 \x03(\v2*.gitlabexporter.protobuf.MergeRequestEventR\x04data\"K\n" +
 	"\x14RecordMetricsRequest\x123\n" +
 	"\x04data\x18\x01 \x03(\v2\x1f.gitlabexporter.protobuf.MetricR\x04data\"O\n" +
 	"\x16RecordPipelinesRequest\x125\n" +
@@ -1059,29 +2013,99 @@ const file_gitlabexporter_protobuf_service_service_proto_rawDesc = "" +
 	"\x17RecordTestSuitesRequest\x126\n" +
 	"\x04data\x18\x01 \x03(\v2\".gitlabexporter.protobuf.TestSuiteR\x04data\"I\n" +
 	"\x13RecordTracesRequest\x122\n" +
-	"\x04data\x18\x01 \x03(\v2\x1e.gitlabexporter.protobuf.TraceR\x04data2\xbd\x14\n" +
+	"\x04data\x18\x01 \x03(\v2\x1e.gitlabexporter.protobuf.TraceR\x04data\"\xef\x12\n" +
+	"\x13RecordStreamRequest\x12Q\n" +
+	"\acommits\x18\x01 \x01(\v25.gitlabexporter.protobuf.service.RecordCommitsRequestH\x00R\acommits\x12j\n" +
+	"\x10coverage_reports\x18\x02 \x01(\v2=.gitlabexporter.protobuf.service.RecordCoverageReportsRequestH\x00R\x0fcoverageReports\x12m\n" +
+	"\x11coverage_packages\x18\x03 \x01(\v2>.gitlabexporter.protobuf.service.RecordCoveragePackagesRequestH\x00R\x10coveragePackages\x12j\n" +
+	"\x10coverage_classes\x18\x04 \x01(\v2=.gitlabexporter.protobuf.service.RecordCoverageClassesRequestH\x00R\x0fcoverageClasses\x12j\n" +
+	"\x10coverage_methods\x18\x05 \x01(\v2=.gitlabexporter.protobuf.service.RecordCoverageMethodsRequestH\x00R\x0fcoverageMethods\x12]\n" +
+	"\vdeployments\x18\x06 \x01(\v29.gitlabexporter.protobuf.service.RecordDeploymentsRequestH\x00R\vdeployments\x12N\n" +
+	"\x06issues\x18\a \x01(\v24.gitlabexporter.protobuf.service.RecordIssuesRequestH\x00R\x06issues\x12H\n" +
+	"\x04jobs\x18\b \x01(\v22.gitlabexporter.protobuf.service.RecordJobsRequestH\x00R\x04jobs\x12d\n" +
+	"\x0emerge_requests\x18\t \x01(\v2;.gitlabexporter.protobuf.service.RecordMergeRequestsRequestH\x00R\rmergeRequests\x12w\n" +
+	"\x15merge_request_commits\x18\n" +
+	" \x01(\v2A.gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequestH\x00R\x13mergeRequestCommits\x12\x81\x01\n" +
+	"\x19merge_request_note_events\x18\v \x01(\v2D.gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequestH\x00R\x16mergeRequestNoteEvents\x12Q\n" +
+	"\ametrics\x18\f \x01(\v25.gitlabexporter.protobuf.service.RecordMetricsRequestH\x00R\ametrics\x12W\n" +
+	"\tpipelines\x18\r \x01(\v27.gitlabexporter.protobuf.service.RecordPipelinesRequestH\x00R\tpipelines\x12T\n" +
+	"\bprojects\x18\x0e \x01(\v26.gitlabexporter.protobuf.service.RecordProjectsRequestH\x00R\bprojects\x12Q\n" +
+	"\arunners\x18\x0f \x01(\v25.gitlabexporter.protobuf.service.RecordRunnersRequestH\x00R\arunners\x12T\n" +
+	"\bsections\x18\x10 \x01(\v26.gitlabexporter.protobuf.service.RecordSectionsRequestH\x00R\bsections\x12X\n" +
+	"\n" +
+	"test_cases\x18\x11 \x01(\v27.gitlabexporter.protobuf.service.RecordTestCasesRequestH\x00R\ttestCases\x12^\n" +
+	"\ftest_reports\x18\x12 \x01(\v29.gitlabexporter.protobuf.service.RecordTestReportsRequestH\x00R\vtestReports\x12[\n" +
+	"\vtest_suites\x18\x13 \x01(\v28.gitlabexporter.protobuf.service.RecordTestSuitesRequestH\x00R\n" +
+	"testSuites\x12N\n" +
+	"\x06traces\x18\x14 \x01(\v24.gitlabexporter.protobuf.service.RecordTracesRequestH\x00R\x06traces\x12`\n" +
+	"\fenvironments\x18\x15 \x01(\v2:.gitlabexporter.protobuf.service.RecordEnvironmentsRequestH\x00R\fenvironments\x12a\n" +
+	"\rjob_artifacts\x18\x16 \x01(\v2:.gitlabexporter.protobuf.service.RecordJobArtifactsRequestH\x00R\fjobArtifacts\x12H\n" +
+	"\x04tags\x18\x17 \x01(\v22.gitlabexporter.protobuf.service.RecordTagsRequestH\x00R\x04tags\x12T\n" +
+	"\breleases\x18\x18 \x01(\v26.gitlabexporter.protobuf.service.RecordReleasesRequestH\x00R\breleases\x12t\n" +
+	"\x14merge_request_events\x18\x19 \x01(\v2@.gitlabexporter.protobuf.service.RecordMergeRequestEventsRequestH\x00R\x12mergeRequestEventsB\t\n" +
+	"\arecords*K\n" +
+	"\x0fProtocolVersion\x12 \n" +
+	"\x1cPROTOCOL_VERSION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PROTOCOL_VERSION_1\x10\x01*\x8b\x06\n" +
+	"\n" +
+	"RecordKind\x12\x1b\n" +
+	"\x17RECORD_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13RECORD_KIND_COMMITS\x10\x01\x12 \n" +
+	"\x1cRECORD_KIND_COVERAGE_REPORTS\x10\x02\x12!\n" +
+	"\x1dRECORD_KIND_COVERAGE_PACKAGES\x10\x03\x12 \n" +
+	"\x1cRECORD_KIND_COVERAGE_CLASSES\x10\x04\x12 \n" +
+	"\x1cRECORD_KIND_COVERAGE_METHODS\x10\x05\x12\x1b\n" +
+	"\x17RECORD_KIND_DEPLOYMENTS\x10\x06\x12\x16\n" +
+	"\x12RECORD_KIND_ISSUES\x10\a\x12\x14\n" +
+	"\x10RECORD_KIND_JOBS\x10\b\x12\x1e\n" +
+	"\x1aRECORD_KIND_MERGE_REQUESTS\x10\t\x12%\n" +
+	"!RECORD_KIND_MERGE_REQUEST_COMMITS\x10\n" +
+	"\x12)\n" +
+	"%RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS\x10\v\x12\x17\n" +
+	"\x13RECORD_KIND_METRICS\x10\f\x12\x19\n" +
+	"\x15RECORD_KIND_PIPELINES\x10\r\x12\x18\n" +
+	"\x14RECORD_KIND_PROJECTS\x10\x0e\x12\x17\n" +
+	"\x13RECORD_KIND_RUNNERS\x10\x0f\x12\x18\n" +
+	"\x14RECORD_KIND_SECTIONS\x10\x10\x12\x1a\n" +
+	"\x16RECORD_KIND_TEST_CASES\x10\x11\x12\x1c\n" +
+	"\x18RECORD_KIND_TEST_REPORTS\x10\x12\x12\x1b\n" +
+	"\x17RECORD_KIND_TEST_SUITES\x10\x13\x12\x16\n" +
+	"\x12RECORD_KIND_TRACES\x10\x14\x12\x1c\n" +
+	"\x18RECORD_KIND_ENVIRONMENTS\x10\x15\x12\x1d\n" +
+	"\x19RECORD_KIND_JOB_ARTIFACTS\x10\x16\x12\x14\n" +
+	"\x10RECORD_KIND_TAGS\x10\x17\x12\x18\n" +
+	"\x14RECORD_KIND_RELEASES\x10\x18\x12$\n" +
+	" RECORD_KIND_MERGE_REQUEST_EVENTS\x10\x192\xbf\x1b\n" +
 	"\x0eGitLabExporter\x12x\n" +
 	"\rRecordCommits\x125.gitlabexporter.protobuf.service.RecordCommitsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x88\x01\n" +
 	"\x15RecordCoverageReports\x12=.gitlabexporter.protobuf.service.RecordCoverageReportsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x8a\x01\n" +
 	"\x16RecordCoveragePackages\x12>.gitlabexporter.protobuf.service.RecordCoveragePackagesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x88\x01\n" +
 	"\x15RecordCoverageClasses\x12=.gitlabexporter.protobuf.service.RecordCoverageClassesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x88\x01\n" +
 	"\x15RecordCoverageMethods\x12=.gitlabexporter.protobuf.service.RecordCoverageMethodsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x80\x01\n" +
-	"\x11RecordDeployments\x129.gitlabexporter.protobuf.service.RecordDeploymentsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12v\n" +
+	"\x11RecordDeployments\x129.gitlabexporter.protobuf.service.RecordDeploymentsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x82\x01\n" +
+	"\x12RecordEnvironments\x12:.gitlabexporter.protobuf.service.RecordEnvironmentsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12v\n" +
 	"\fRecordIssues\x124.gitlabexporter.protobuf.service.RecordIssuesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12r\n" +
 	"\n" +
-	"RecordJobs\x122.gitlabexporter.protobuf.service.RecordJobsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x84\x01\n" +
+	"RecordJobs\x122.gitlabexporter.protobuf.service.RecordJobsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x82\x01\n" +
+	"\x12RecordJobArtifacts\x12:.gitlabexporter.protobuf.service.RecordJobArtifactsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x84\x01\n" +
 	"\x13RecordMergeRequests\x12;.gitlabexporter.protobuf.service.RecordMergeRequestsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x90\x01\n" +
 	"\x19RecordMergeRequestCommits\x12A.gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x96\x01\n" +
-	"\x1cRecordMergeRequestNoteEvents\x12D.gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12x\n" +
+	"\x1cRecordMergeRequestNoteEvents\x12D.gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x8e\x01\n" +
+	"\x18RecordMergeRequestEvents\x12@.gitlabexporter.protobuf.service.RecordMergeRequestEventsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12x\n" +
 	"\rRecordMetrics\x125.gitlabexporter.protobuf.service.RecordMetricsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12|\n" +
 	"\x0fRecordPipelines\x127.gitlabexporter.protobuf.service.RecordPipelinesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12z\n" +
-	"\x0eRecordProjects\x126.gitlabexporter.protobuf.service.RecordProjectsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12x\n" +
+	"\x0eRecordProjects\x126.gitlabexporter.protobuf.service.RecordProjectsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12z\n" +
+	"\x0eRecordReleases\x126.gitlabexporter.protobuf.service.RecordReleasesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12x\n" +
 	"\rRecordRunners\x125.gitlabexporter.protobuf.service.RecordRunnersRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12z\n" +
-	"\x0eRecordSections\x126.gitlabexporter.protobuf.service.RecordSectionsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12|\n" +
+	"\x0eRecordSections\x126.gitlabexporter.protobuf.service.RecordSectionsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12r\n" +
+	"\n" +
+	"RecordTags\x122.gitlabexporter.protobuf.service.RecordTagsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12|\n" +
 	"\x0fRecordTestCases\x127.gitlabexporter.protobuf.service.RecordTestCasesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12\x80\x01\n" +
 	"\x11RecordTestReports\x129.gitlabexporter.protobuf.service.RecordTestReportsRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12~\n" +
 	"\x10RecordTestSuites\x128.gitlabexporter.protobuf.service.RecordTestSuitesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12v\n" +
-	"\fRecordTraces\x124.gitlabexporter.protobuf.service.RecordTracesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00B2Z0go.cluttr.dev/gitlab-exporter/protobuf/servicepbb\x06proto3"
+	"\fRecordTraces\x124.gitlabexporter.protobuf.service.RecordTracesRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00\x12x\n" +
+	"\fRecordStream\x124.gitlabexporter.protobuf.service.RecordStreamRequest\x1a..gitlabexporter.protobuf.service.RecordSummary\"\x00(\x01\x12{\n" +
+	"\x0fGetCapabilities\x127.gitlabexporter.protobuf.service.GetCapabilitiesRequest\x1a-.gitlabexporter.protobuf.service.Capabilities\"\x00B2Z0go.cluttr.dev/gitlab-exporter/protobuf/servicepbb\x06proto3"
 
 var (
 	file_gitlabexporter_protobuf_service_service_proto_rawDescOnce sync.Once
@@ -1095,121 +2119,184 @@ func file_gitlabexporter_protobuf_service_service_proto_rawDescGZIP() []byte {
 	return file_gitlabexporter_protobuf_service_service_proto_rawDescData
 }
 
-var file_gitlabexporter_protobuf_service_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_gitlabexporter_protobuf_service_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_gitlabexporter_protobuf_service_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_gitlabexporter_protobuf_service_service_proto_goTypes = []any{
-	(*RecordSummary)(nil),                       // 0: gitlabexporter.protobuf.service.RecordSummary
-	(*RecordRequestMetadata)(nil),               // 1: gitlabexporter.protobuf.service.RecordRequestMetadata
-	(*RecordCommitsRequest)(nil),                // 2: gitlabexporter.protobuf.service.RecordCommitsRequest
-	(*RecordCoverageReportsRequest)(nil),        // 3: gitlabexporter.protobuf.service.RecordCoverageReportsRequest
-	(*RecordCoveragePackagesRequest)(nil),       // 4: gitlabexporter.protobuf.service.RecordCoveragePackagesRequest
-	(*RecordCoverageClassesRequest)(nil),        // 5: gitlabexporter.protobuf.service.RecordCoverageClassesRequest
-	(*RecordCoverageMethodsRequest)(nil),        // 6: gitlabexporter.protobuf.service.RecordCoverageMethodsRequest
-	(*RecordDeploymentsRequest)(nil),            // 7: gitlabexporter.protobuf.service.RecordDeploymentsRequest
-	(*RecordIssuesRequest)(nil),                 // 8: gitlabexporter.protobuf.service.RecordIssuesRequest
-	(*RecordJobsRequest)(nil),                   // 9: gitlabexporter.protobuf.service.RecordJobsRequest
-	(*RecordMergeRequestsRequest)(nil),          // 10: gitlabexporter.protobuf.service.RecordMergeRequestsRequest
-	(*RecordMergeRequestCommitsRequest)(nil),    // 11: gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest
-	(*RecordMergeRequestNoteEventsRequest)(nil), // 12: gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest
-	(*RecordMetricsRequest)(nil),                // 13: gitlabexporter.protobuf.service.RecordMetricsRequest
-	(*RecordPipelinesRequest)(nil),              // 14: gitlabexporter.protobuf.service.RecordPipelinesRequest
-	(*RecordProjectsRequest)(nil),               // 15: gitlabexporter.protobuf.service.RecordProjectsRequest
-	(*RecordRunnersRequest)(nil),                // 16: gitlabexporter.protobuf.service.RecordRunnersRequest
-	(*RecordSectionsRequest)(nil),               // 17: gitlabexporter.protobuf.service.RecordSectionsRequest
-	(*RecordTestCasesRequest)(nil),              // 18: gitlabexporter.protobuf.service.RecordTestCasesRequest
-	(*RecordTestReportsRequest)(nil),            // 19: gitlabexporter.protobuf.service.RecordTestReportsRequest
-	(*RecordTestSuitesRequest)(nil),             // 20: gitlabexporter.protobuf.service.RecordTestSuitesRequest
-	(*RecordTracesRequest)(nil),                 // 21: gitlabexporter.protobuf.service.RecordTracesRequest
-	(*timestamppb.Timestamp)(nil),               // 22: google.protobuf.Timestamp
-	(*typespb.Commit)(nil),                      // 23: gitlabexporter.protobuf.Commit
-	(*typespb.CoverageReport)(nil),              // 24: gitlabexporter.protobuf.CoverageReport
-	(*typespb.CoveragePackage)(nil),             // 25: gitlabexporter.protobuf.CoveragePackage
-	(*typespb.CoverageClass)(nil),               // 26: gitlabexporter.protobuf.CoverageClass
-	(*typespb.CoverageMethod)(nil),              // 27: gitlabexporter.protobuf.CoverageMethod
-	(*typespb.Deployment)(nil),                  // 28: gitlabexporter.protobuf.Deployment
-	(*typespb.Issue)(nil),                       // 29: gitlabexporter.protobuf.Issue
-	(*typespb.Job)(nil),                         // 30: gitlabexporter.protobuf.Job
-	(*typespb.MergeRequest)(nil),                // 31: gitlabexporter.protobuf.MergeRequest
-	(*typespb.MergeRequestCommit)(nil),          // 32: gitlabexporter.protobuf.MergeRequestCommit
-	(*typespb.MergeRequestNoteEvent)(nil),       // 33: gitlabexporter.protobuf.MergeRequestNoteEvent
-	(*typespb.Metric)(nil),                      // 34: gitlabexporter.protobuf.Metric
-	(*typespb.Pipeline)(nil),                    // 35: gitlabexporter.protobuf.Pipeline
-	(*typespb.Project)(nil),                     // 36: gitlabexporter.protobuf.Project
-	(*typespb.Runner)(nil),                      // 37: gitlabexporter.protobuf.Runner
-	(*typespb.Section)(nil),                     // 38: gitlabexporter.protobuf.Section
-	(*typespb.TestCase)(nil),                    // 39: gitlabexporter.protobuf.TestCase
-	(*typespb.TestReport)(nil),                  // 40: gitlabexporter.protobuf.TestReport
-	(*typespb.TestSuite)(nil),                   // 41: gitlabexporter.protobuf.TestSuite
-	(*typespb.Trace)(nil),                       // 42: gitlabexporter.protobuf.Trace
+	(ProtocolVersion)(0),                        // 0: gitlabexporter.protobuf.service.ProtocolVersion
+	(RecordKind)(0),                             // 1: gitlabexporter.protobuf.service.RecordKind
+	(*GetCapabilitiesRequest)(nil),              // 2: gitlabexporter.protobuf.service.GetCapabilitiesRequest
+	(*Capabilities)(nil),                        // 3: gitlabexporter.protobuf.service.Capabilities
+	(*RecordSummary)(nil),                       // 4: gitlabexporter.protobuf.service.RecordSummary
+	(*RecordRequestMetadata)(nil),               // 5: gitlabexporter.protobuf.service.RecordRequestMetadata
+	(*RecordCommitsRequest)(nil),                // 6: gitlabexporter.protobuf.service.RecordCommitsRequest
+	(*RecordCoverageReportsRequest)(nil),        // 7: gitlabexporter.protobuf.service.RecordCoverageReportsRequest
+	(*RecordCoveragePackagesRequest)(nil),       // 8: gitlabexporter.protobuf.service.RecordCoveragePackagesRequest
+	(*RecordCoverageClassesRequest)(nil),        // 9: gitlabexporter.protobuf.service.RecordCoverageClassesRequest
+	(*RecordCoverageMethodsRequest)(nil),        // 10: gitlabexporter.protobuf.service.RecordCoverageMethodsRequest
+	(*RecordDeploymentsRequest)(nil),            // 11: gitlabexporter.protobuf.service.RecordDeploymentsRequest
+	(*RecordEnvironmentsRequest)(nil),           // 12: gitlabexporter.protobuf.service.RecordEnvironmentsRequest
+	(*RecordIssuesRequest)(nil),                 // 13: gitlabexporter.protobuf.service.RecordIssuesRequest
+	(*RecordJobsRequest)(nil),                   // 14: gitlabexporter.protobuf.service.RecordJobsRequest
+	(*RecordJobArtifactsRequest)(nil),           // 15: gitlabexporter.protobuf.service.RecordJobArtifactsRequest
+	(*RecordTagsRequest)(nil),                   // 16: gitlabexporter.protobuf.service.RecordTagsRequest
+	(*RecordReleasesRequest)(nil),               // 17: gitlabexporter.protobuf.service.RecordReleasesRequest
+	(*RecordMergeRequestsRequest)(nil),          // 18: gitlabexporter.protobuf.service.RecordMergeRequestsRequest
+	(*RecordMergeRequestCommitsRequest)(nil),    // 19: gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest
+	(*RecordMergeRequestNoteEventsRequest)(nil), // 20: gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest
+	(*RecordMergeRequestEventsRequest)(nil),     // 21: gitlabexporter.protobuf.service.RecordMergeRequestEventsRequest
+	(*RecordMetricsRequest)(nil),                // 22: gitlabexporter.protobuf.service.RecordMetricsRequest
+	(*RecordPipelinesRequest)(nil),              // 23: gitlabexporter.protobuf.service.RecordPipelinesRequest
+	(*RecordProjectsRequest)(nil),               // 24: gitlabexporter.protobuf.service.RecordProjectsRequest
+	(*RecordRunnersRequest)(nil),                // 25: gitlabexporter.protobuf.service.RecordRunnersRequest
+	(*RecordSectionsRequest)(nil),               // 26: gitlabexporter.protobuf.service.RecordSectionsRequest
+	(*RecordTestCasesRequest)(nil),              // 27: gitlabexporter.protobuf.service.RecordTestCasesRequest
+	(*RecordTestReportsRequest)(nil),            // 28: gitlabexporter.protobuf.service.RecordTestReportsRequest
+	(*RecordTestSuitesRequest)(nil),             // 29: gitlabexporter.protobuf.service.RecordTestSuitesRequest
+	(*RecordTracesRequest)(nil),                 // 30: gitlabexporter.protobuf.service.RecordTracesRequest
+	(*RecordStreamRequest)(nil),                 // 31: gitlabexporter.protobuf.service.RecordStreamRequest
+	(*timestamppb.Timestamp)(nil),               // 32: google.protobuf.Timestamp
+	(*typespb.Commit)(nil),                      // 33: gitlabexporter.protobuf.Commit
+	(*typespb.CoverageReport)(nil),              // 34: gitlabexporter.protobuf.CoverageReport
+	(*typespb.CoveragePackage)(nil),             // 35: gitlabexporter.protobuf.CoveragePackage
+	(*typespb.CoverageClass)(nil),               // 36: gitlabexporter.protobuf.CoverageClass
+	(*typespb.CoverageMethod)(nil),              // 37: gitlabexporter.protobuf.CoverageMethod
+	(*typespb.Deployment)(nil),                  // 38: gitlabexporter.protobuf.Deployment
+	(*typespb.Environment)(nil),                 // 39: gitlabexporter.protobuf.Environment
+	(*typespb.Issue)(nil),                       // 40: gitlabexporter.protobuf.Issue
+	(*typespb.Job)(nil),                         // 41: gitlabexporter.protobuf.Job
+	(*typespb.JobArtifact)(nil),                 // 42: gitlabexporter.protobuf.JobArtifact
+	(*typespb.Tag)(nil),                         // 43: gitlabexporter.protobuf.Tag
+	(*typespb.Release)(nil),                     // 44: gitlabexporter.protobuf.Release
+	(*typespb.MergeRequest)(nil),                // 45: gitlabexporter.protobuf.MergeRequest
+	(*typespb.MergeRequestCommit)(nil),          // 46: gitlabexporter.protobuf.MergeRequestCommit
+	(*typespb.MergeRequestNoteEvent)(nil),       // 47: gitlabexporter.protobuf.MergeRequestNoteEvent
+	(*typespb.MergeRequestEvent)(nil),           // 48: gitlabexporter.protobuf.MergeRequestEvent
+	(*typespb.Metric)(nil),                      // 49: gitlabexporter.protobuf.Metric
+	(*typespb.Pipeline)(nil),                    // 50: gitlabexporter.protobuf.Pipeline
+	(*typespb.Project)(nil),                     // 51: gitlabexporter.protobuf.Project
+	(*typespb.Runner)(nil),                      // 52: gitlabexporter.protobuf.Runner
+	(*typespb.Section)(nil),                     // 53: gitlabexporter.protobuf.Section
+	(*typespb.TestCase)(nil),                    // 54: gitlabexporter.protobuf.TestCase
+	(*typespb.TestReport)(nil),                  // 55: gitlabexporter.protobuf.TestReport
+	(*typespb.TestSuite)(nil),                   // 56: gitlabexporter.protobuf.TestSuite
+	(*typespb.Trace)(nil),                       // 57: gitlabexporter.protobuf.Trace
 }
 var file_gitlabexporter_protobuf_service_service_proto_depIdxs = []int32{
-	22, // 0: gitlabexporter.protobuf.service.RecordRequestMetadata.fetched_at:type_name -> google.protobuf.Timestamp
-	22, // 1: gitlabexporter.protobuf.service.RecordRequestMetadata.exported_at:type_name -> google.protobuf.Timestamp
-	23, // 2: gitlabexporter.protobuf.service.RecordCommitsRequest.data:type_name -> gitlabexporter.protobuf.Commit
-	24, // 3: gitlabexporter.protobuf.service.RecordCoverageReportsRequest.data:type_name -> gitlabexporter.protobuf.CoverageReport
-	25, // 4: gitlabexporter.protobuf.service.RecordCoveragePackagesRequest.data:type_name -> gitlabexporter.protobuf.CoveragePackage
-	26, // 5: gitlabexporter.protobuf.service.RecordCoverageClassesRequest.data:type_name -> gitlabexporter.protobuf.CoverageClass
-	27, // 6: gitlabexporter.protobuf.service.RecordCoverageMethodsRequest.data:type_name -> gitlabexporter.protobuf.CoverageMethod
-	28, // 7: gitlabexporter.protobuf.service.RecordDeploymentsRequest.data:type_name -> gitlabexporter.protobuf.Deployment
-	29, // 8: gitlabexporter.protobuf.service.RecordIssuesRequest.data:type_name -> gitlabexporter.protobuf.Issue
-	30, // 9: gitlabexporter.protobuf.service.RecordJobsRequest.data:type_name -> gitlabexporter.protobuf.Job
-	31, // 10: gitlabexporter.protobuf.service.RecordMergeRequestsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequest
-	32, // 11: gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequestCommit
-	33, // 12: gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequestNoteEvent
-	34, // 13: gitlabexporter.protobuf.service.RecordMetricsRequest.data:type_name -> gitlabexporter.protobuf.Metric
-	35, // 14: gitlabexporter.protobuf.service.RecordPipelinesRequest.data:type_name -> gitlabexporter.protobuf.Pipeline
-	36, // 15: gitlabexporter.protobuf.service.RecordProjectsRequest.data:type_name -> gitlabexporter.protobuf.Project
-	37, // 16: gitlabexporter.protobuf.service.RecordRunnersRequest.data:type_name -> gitlabexporter.protobuf.Runner
-	1,  // 17: gitlabexporter.protobuf.service.RecordRunnersRequest.metadata:type_name -> gitlabexporter.protobuf.service.RecordRequestMetadata
-	38, // 18: gitlabexporter.protobuf.service.RecordSectionsRequest.data:type_name -> gitlabexporter.protobuf.Section
-	39, // 19: gitlabexporter.protobuf.service.RecordTestCasesRequest.data:type_name -> gitlabexporter.protobuf.TestCase
-	40, // 20: gitlabexporter.protobuf.service.RecordTestReportsRequest.data:type_name -> gitlabexporter.protobuf.TestReport
-	41, // 21: gitlabexporter.protobuf.service.RecordTestSuitesRequest.data:type_name -> gitlabexporter.protobuf.TestSuite
-	42, // 22: gitlabexporter.protobuf.service.RecordTracesRequest.data:type_name -> gitlabexporter.protobuf.Trace
-	2,  // 23: gitlabexporter.protobuf.service.GitLabExporter.RecordCommits:input_type -> gitlabexporter.protobuf.service.RecordCommitsRequest
-	3,  // 24: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageReports:input_type -> gitlabexporter.protobuf.service.RecordCoverageReportsRequest
-	4,  // 25: gitlabexporter.protobuf.service.GitLabExporter.RecordCoveragePackages:input_type -> gitlabexporter.protobuf.service.RecordCoveragePackagesRequest
-	5,  // 26: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageClasses:input_type -> gitlabexporter.protobuf.service.RecordCoverageClassesRequest
-	6,  // 27: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageMethods:input_type -> gitlabexporter.protobuf.service.RecordCoverageMethodsRequest
-	7,  // 28: gitlabexporter.protobuf.service.GitLabExporter.RecordDeployments:input_type -> gitlabexporter.protobuf.service.RecordDeploymentsRequest
-	8,  // 29: gitlabexporter.protobuf.service.GitLabExporter.RecordIssues:input_type -> gitlabexporter.protobuf.service.RecordIssuesRequest
-	9,  // 30: gitlabexporter.protobuf.service.GitLabExporter.RecordJobs:input_type -> gitlabexporter.protobuf.service.RecordJobsRequest
-	10, // 31: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequests:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestsRequest
-	11, // 32: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestCommits:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest
-	12, // 33: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestNoteEvents:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest
-	13, // 34: gitlabexporter.protobuf.service.GitLabExporter.RecordMetrics:input_type -> gitlabexporter.protobuf.service.RecordMetricsRequest
-	14, // 35: gitlabexporter.protobuf.service.GitLabExporter.RecordPipelines:input_type -> gitlabexporter.protobuf.service.RecordPipelinesRequest
-	15, // 36: gitlabexporter.protobuf.service.GitLabExporter.RecordProjects:input_type -> gitlabexporter.protobuf.service.RecordProjectsRequest
-	16, // 37: gitlabexporter.protobuf.service.GitLabExporter.RecordRunners:input_type -> gitlabexporter.protobuf.service.RecordRunnersRequest
-	17, // 38: gitlabexporter.protobuf.service.GitLabExporter.RecordSections:input_type -> gitlabexporter.protobuf.service.RecordSectionsRequest
-	18, // 39: gitlabexporter.protobuf.service.GitLabExporter.RecordTestCases:input_type -> gitlabexporter.protobuf.service.RecordTestCasesRequest
-	19, // 40: gitlabexporter.protobuf.service.GitLabExporter.RecordTestReports:input_type -> gitlabexporter.protobuf.service.RecordTestReportsRequest
-	20, // 41: gitlabexporter.protobuf.service.GitLabExporter.RecordTestSuites:input_type -> gitlabexporter.protobuf.service.RecordTestSuitesRequest
-	21, // 42: gitlabexporter.protobuf.service.GitLabExporter.RecordTraces:input_type -> gitlabexporter.protobuf.service.RecordTracesRequest
-	0,  // 43: gitlabexporter.protobuf.service.GitLabExporter.RecordCommits:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 44: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageReports:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 45: gitlabexporter.protobuf.service.GitLabExporter.RecordCoveragePackages:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 46: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageClasses:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 47: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageMethods:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 48: gitlabexporter.protobuf.service.GitLabExporter.RecordDeployments:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 49: gitlabexporter.protobuf.service.GitLabExporter.RecordIssues:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 50: gitlabexporter.protobuf.service.GitLabExporter.RecordJobs:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 51: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequests:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 52: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestCommits:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 53: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestNoteEvents:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 54: gitlabexporter.protobuf.service.GitLabExporter.RecordMetrics:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 55: gitlabexporter.protobuf.service.GitLabExporter.RecordPipelines:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 56: gitlabexporter.protobuf.service.GitLabExporter.RecordProjects:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 57: gitlabexporter.protobuf.service.GitLabExporter.RecordRunners:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 58: gitlabexporter.protobuf.service.GitLabExporter.RecordSections:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 59: gitlabexporter.protobuf.service.GitLabExporter.RecordTestCases:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 60: gitlabexporter.protobuf.service.GitLabExporter.RecordTestReports:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 61: gitlabexporter.protobuf.service.GitLabExporter.RecordTestSuites:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	0,  // 62: gitlabexporter.protobuf.service.GitLabExporter.RecordTraces:output_type -> gitlabexporter.protobuf.service.RecordSummary
-	43, // [43:63] is the sub-list for method output_type
-	23, // [23:43] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	0,  // 0: gitlabexporter.protobuf.service.GetCapabilitiesRequest.protocol_version:type_name -> gitlabexporter.protobuf.service.ProtocolVersion
+	0,  // 1: gitlabexporter.protobuf.service.Capabilities.protocol_version:type_name -> gitlabexporter.protobuf.service.ProtocolVersion
+	1,  // 2: gitlabexporter.protobuf.service.Capabilities.record_kinds:type_name -> gitlabexporter.protobuf.service.RecordKind
+	32, // 3: gitlabexporter.protobuf.service.RecordRequestMetadata.fetched_at:type_name -> google.protobuf.Timestamp
+	32, // 4: gitlabexporter.protobuf.service.RecordRequestMetadata.exported_at:type_name -> google.protobuf.Timestamp
+	33, // 5: gitlabexporter.protobuf.service.RecordCommitsRequest.data:type_name -> gitlabexporter.protobuf.Commit
+	34, // 6: gitlabexporter.protobuf.service.RecordCoverageReportsRequest.data:type_name -> gitlabexporter.protobuf.CoverageReport
+	35, // 7: gitlabexporter.protobuf.service.RecordCoveragePackagesRequest.data:type_name -> gitlabexporter.protobuf.CoveragePackage
+	36, // 8: gitlabexporter.protobuf.service.RecordCoverageClassesRequest.data:type_name -> gitlabexporter.protobuf.CoverageClass
+	37, // 9: gitlabexporter.protobuf.service.RecordCoverageMethodsRequest.data:type_name -> gitlabexporter.protobuf.CoverageMethod
+	38, // 10: gitlabexporter.protobuf.service.RecordDeploymentsRequest.data:type_name -> gitlabexporter.protobuf.Deployment
+	39, // 11: gitlabexporter.protobuf.service.RecordEnvironmentsRequest.data:type_name -> gitlabexporter.protobuf.Environment
+	40, // 12: gitlabexporter.protobuf.service.RecordIssuesRequest.data:type_name -> gitlabexporter.protobuf.Issue
+	41, // 13: gitlabexporter.protobuf.service.RecordJobsRequest.data:type_name -> gitlabexporter.protobuf.Job
+	42, // 14: gitlabexporter.protobuf.service.RecordJobArtifactsRequest.data:type_name -> gitlabexporter.protobuf.JobArtifact
+	43, // 15: gitlabexporter.protobuf.service.RecordTagsRequest.data:type_name -> gitlabexporter.protobuf.Tag
+	44, // 16: gitlabexporter.protobuf.service.RecordReleasesRequest.data:type_name -> gitlabexporter.protobuf.Release
+	45, // 17: gitlabexporter.protobuf.service.RecordMergeRequestsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequest
+	46, // 18: gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequestCommit
+	47, // 19: gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequestNoteEvent
+	48, // 20: gitlabexporter.protobuf.service.RecordMergeRequestEventsRequest.data:type_name -> gitlabexporter.protobuf.MergeRequestEvent
+	49, // 21: gitlabexporter.protobuf.service.RecordMetricsRequest.data:type_name -> gitlabexporter.protobuf.Metric
+	50, // 22: gitlabexporter.protobuf.service.RecordPipelinesRequest.data:type_name -> gitlabexporter.protobuf.Pipeline
+	51, // 23: gitlabexporter.protobuf.service.RecordProjectsRequest.data:type_name -> gitlabexporter.protobuf.Project
+	52, // 24: gitlabexporter.protobuf.service.RecordRunnersRequest.data:type_name -> gitlabexporter.protobuf.Runner
+	5,  // 25: gitlabexporter.protobuf.service.RecordRunnersRequest.metadata:type_name -> gitlabexporter.protobuf.service.RecordRequestMetadata
+	53, // 26: gitlabexporter.protobuf.service.RecordSectionsRequest.data:type_name -> gitlabexporter.protobuf.Section
+	54, // 27: gitlabexporter.protobuf.service.RecordTestCasesRequest.data:type_name -> gitlabexporter.protobuf.TestCase
+	55, // 28: gitlabexporter.protobuf.service.RecordTestReportsRequest.data:type_name -> gitlabexporter.protobuf.TestReport
+	56, // 29: gitlabexporter.protobuf.service.RecordTestSuitesRequest.data:type_name -> gitlabexporter.protobuf.TestSuite
+	57, // 30: gitlabexporter.protobuf.service.RecordTracesRequest.data:type_name -> gitlabexporter.protobuf.Trace
+	6,  // 31: gitlabexporter.protobuf.service.RecordStreamRequest.commits:type_name -> gitlabexporter.protobuf.service.RecordCommitsRequest
+	7,  // 32: gitlabexporter.protobuf.service.RecordStreamRequest.coverage_reports:type_name -> gitlabexporter.protobuf.service.RecordCoverageReportsRequest
+	8,  // 33: gitlabexporter.protobuf.service.RecordStreamRequest.coverage_packages:type_name -> gitlabexporter.protobuf.service.RecordCoveragePackagesRequest
+	9,  // 34: gitlabexporter.protobuf.service.RecordStreamRequest.coverage_classes:type_name -> gitlabexporter.protobuf.service.RecordCoverageClassesRequest
+	10, // 35: gitlabexporter.protobuf.service.RecordStreamRequest.coverage_methods:type_name -> gitlabexporter.protobuf.service.RecordCoverageMethodsRequest
+	11, // 36: gitlabexporter.protobuf.service.RecordStreamRequest.deployments:type_name -> gitlabexporter.protobuf.service.RecordDeploymentsRequest
+	13, // 37: gitlabexporter.protobuf.service.RecordStreamRequest.issues:type_name -> gitlabexporter.protobuf.service.RecordIssuesRequest
+	14, // 38: gitlabexporter.protobuf.service.RecordStreamRequest.jobs:type_name -> gitlabexporter.protobuf.service.RecordJobsRequest
+	18, // 39: gitlabexporter.protobuf.service.RecordStreamRequest.merge_requests:type_name -> gitlabexporter.protobuf.service.RecordMergeRequestsRequest
+	19, // 40: gitlabexporter.protobuf.service.RecordStreamRequest.merge_request_commits:type_name -> gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest
+	20, // 41: gitlabexporter.protobuf.service.RecordStreamRequest.merge_request_note_events:type_name -> gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest
+	22, // 42: gitlabexporter.protobuf.service.RecordStreamRequest.metrics:type_name -> gitlabexporter.protobuf.service.RecordMetricsRequest
+	23, // 43: gitlabexporter.protobuf.service.RecordStreamRequest.pipelines:type_name -> gitlabexporter.protobuf.service.RecordPipelinesRequest
+	24, // 44: gitlabexporter.protobuf.service.RecordStreamRequest.projects:type_name -> gitlabexporter.protobuf.service.RecordProjectsRequest
+	25, // 45: gitlabexporter.protobuf.service.RecordStreamRequest.runners:type_name -> gitlabexporter.protobuf.service.RecordRunnersRequest
+	26, // 46: gitlabexporter.protobuf.service.RecordStreamRequest.sections:type_name -> gitlabexporter.protobuf.service.RecordSectionsRequest
+	27, // 47: gitlabexporter.protobuf.service.RecordStreamRequest.test_cases:type_name -> gitlabexporter.protobuf.service.RecordTestCasesRequest
+	28, // 48: gitlabexporter.protobuf.service.RecordStreamRequest.test_reports:type_name -> gitlabexporter.protobuf.service.RecordTestReportsRequest
+	29, // 49: gitlabexporter.protobuf.service.RecordStreamRequest.test_suites:type_name -> gitlabexporter.protobuf.service.RecordTestSuitesRequest
+	30, // 50: gitlabexporter.protobuf.service.RecordStreamRequest.traces:type_name -> gitlabexporter.protobuf.service.RecordTracesRequest
+	12, // 51: gitlabexporter.protobuf.service.RecordStreamRequest.environments:type_name -> gitlabexporter.protobuf.service.RecordEnvironmentsRequest
+	15, // 52: gitlabexporter.protobuf.service.RecordStreamRequest.job_artifacts:type_name -> gitlabexporter.protobuf.service.RecordJobArtifactsRequest
+	16, // 53: gitlabexporter.protobuf.service.RecordStreamRequest.tags:type_name -> gitlabexporter.protobuf.service.RecordTagsRequest
+	17, // 54: gitlabexporter.protobuf.service.RecordStreamRequest.releases:type_name -> gitlabexporter.protobuf.service.RecordReleasesRequest
+	21, // 55: gitlabexporter.protobuf.service.RecordStreamRequest.merge_request_events:type_name -> gitlabexporter.protobuf.service.RecordMergeRequestEventsRequest
+	6,  // 56: gitlabexporter.protobuf.service.GitLabExporter.RecordCommits:input_type -> gitlabexporter.protobuf.service.RecordCommitsRequest
+	7,  // 57: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageReports:input_type -> gitlabexporter.protobuf.service.RecordCoverageReportsRequest
+	8,  // 58: gitlabexporter.protobuf.service.GitLabExporter.RecordCoveragePackages:input_type -> gitlabexporter.protobuf.service.RecordCoveragePackagesRequest
+	9,  // 59: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageClasses:input_type -> gitlabexporter.protobuf.service.RecordCoverageClassesRequest
+	10, // 60: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageMethods:input_type -> gitlabexporter.protobuf.service.RecordCoverageMethodsRequest
+	11, // 61: gitlabexporter.protobuf.service.GitLabExporter.RecordDeployments:input_type -> gitlabexporter.protobuf.service.RecordDeploymentsRequest
+	12, // 62: gitlabexporter.protobuf.service.GitLabExporter.RecordEnvironments:input_type -> gitlabexporter.protobuf.service.RecordEnvironmentsRequest
+	13, // 63: gitlabexporter.protobuf.service.GitLabExporter.RecordIssues:input_type -> gitlabexporter.protobuf.service.RecordIssuesRequest
+	14, // 64: gitlabexporter.protobuf.service.GitLabExporter.RecordJobs:input_type -> gitlabexporter.protobuf.service.RecordJobsRequest
+	15, // 65: gitlabexporter.protobuf.service.GitLabExporter.RecordJobArtifacts:input_type -> gitlabexporter.protobuf.service.RecordJobArtifactsRequest
+	18, // 66: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequests:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestsRequest
+	19, // 67: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestCommits:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestCommitsRequest
+	20, // 68: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestNoteEvents:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestNoteEventsRequest
+	21, // 69: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestEvents:input_type -> gitlabexporter.protobuf.service.RecordMergeRequestEventsRequest
+	22, // 70: gitlabexporter.protobuf.service.GitLabExporter.RecordMetrics:input_type -> gitlabexporter.protobuf.service.RecordMetricsRequest
+	23, // 71: gitlabexporter.protobuf.service.GitLabExporter.RecordPipelines:input_type -> gitlabexporter.protobuf.service.RecordPipelinesRequest
+	24, // 72: gitlabexporter.protobuf.service.GitLabExporter.RecordProjects:input_type -> gitlabexporter.protobuf.service.RecordProjectsRequest
+	17, // 73: gitlabexporter.protobuf.service.GitLabExporter.RecordReleases:input_type -> gitlabexporter.protobuf.service.RecordReleasesRequest
+	25, // 74: gitlabexporter.protobuf.service.GitLabExporter.RecordRunners:input_type -> gitlabexporter.protobuf.service.RecordRunnersRequest
+	26, // 75: gitlabexporter.protobuf.service.GitLabExporter.RecordSections:input_type -> gitlabexporter.protobuf.service.RecordSectionsRequest
+	16, // 76: gitlabexporter.protobuf.service.GitLabExporter.RecordTags:input_type -> gitlabexporter.protobuf.service.RecordTagsRequest
+	27, // 77: gitlabexporter.protobuf.service.GitLabExporter.RecordTestCases:input_type -> gitlabexporter.protobuf.service.RecordTestCasesRequest
+	28, // 78: gitlabexporter.protobuf.service.GitLabExporter.RecordTestReports:input_type -> gitlabexporter.protobuf.service.RecordTestReportsRequest
+	29, // 79: gitlabexporter.protobuf.service.GitLabExporter.RecordTestSuites:input_type -> gitlabexporter.protobuf.service.RecordTestSuitesRequest
+	30, // 80: gitlabexporter.protobuf.service.GitLabExporter.RecordTraces:input_type -> gitlabexporter.protobuf.service.RecordTracesRequest
+	31, // 81: gitlabexporter.protobuf.service.GitLabExporter.RecordStream:input_type -> gitlabexporter.protobuf.service.RecordStreamRequest
+	2,  // 82: gitlabexporter.protobuf.service.GitLabExporter.GetCapabilities:input_type -> gitlabexporter.protobuf.service.GetCapabilitiesRequest
+	4,  // 83: gitlabexporter.protobuf.service.GitLabExporter.RecordCommits:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 84: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageReports:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 85: gitlabexporter.protobuf.service.GitLabExporter.RecordCoveragePackages:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 86: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageClasses:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 87: gitlabexporter.protobuf.service.GitLabExporter.RecordCoverageMethods:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 88: gitlabexporter.protobuf.service.GitLabExporter.RecordDeployments:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 89: gitlabexporter.protobuf.service.GitLabExporter.RecordEnvironments:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 90: gitlabexporter.protobuf.service.GitLabExporter.RecordIssues:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 91: gitlabexporter.protobuf.service.GitLabExporter.RecordJobs:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 92: gitlabexporter.protobuf.service.GitLabExporter.RecordJobArtifacts:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 93: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequests:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 94: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestCommits:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 95: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestNoteEvents:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 96: gitlabexporter.protobuf.service.GitLabExporter.RecordMergeRequestEvents:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 97: gitlabexporter.protobuf.service.GitLabExporter.RecordMetrics:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 98: gitlabexporter.protobuf.service.GitLabExporter.RecordPipelines:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 99: gitlabexporter.protobuf.service.GitLabExporter.RecordProjects:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 100: gitlabexporter.protobuf.service.GitLabExporter.RecordReleases:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 101: gitlabexporter.protobuf.service.GitLabExporter.RecordRunners:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 102: gitlabexporter.protobuf.service.GitLabExporter.RecordSections:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 103: gitlabexporter.protobuf.service.GitLabExporter.RecordTags:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 104: gitlabexporter.protobuf.service.GitLabExporter.RecordTestCases:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 105: gitlabexporter.protobuf.service.GitLabExporter.RecordTestReports:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 106: gitlabexporter.protobuf.service.GitLabExporter.RecordTestSuites:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 107: gitlabexporter.protobuf.service.GitLabExporter.RecordTraces:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	4,  // 108: gitlabexporter.protobuf.service.GitLabExporter.RecordStream:output_type -> gitlabexporter.protobuf.service.RecordSummary
+	3,  // 109: gitlabexporter.protobuf.service.GitLabExporter.GetCapabilities:output_type -> gitlabexporter.protobuf.service.Capabilities
+	83, // [83:110] is the sub-list for method output_type
+	56, // [56:83] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
 }
 
 func init() { file_gitlabexporter_protobuf_service_service_proto_init() }
@@ -1217,18 +2304,46 @@ func file_gitlabexporter_protobuf_service_service_proto_init() {
 	if File_gitlabexporter_protobuf_service_service_proto != nil {
 		return
 	}
+	file_gitlabexporter_protobuf_service_service_proto_msgTypes[29].OneofWrappers = []any{
+		(*RecordStreamRequest_Commits)(nil),
+		(*RecordStreamRequest_CoverageReports)(nil),
+		(*RecordStreamRequest_CoveragePackages)(nil),
+		(*RecordStreamRequest_CoverageClasses)(nil),
+		(*RecordStreamRequest_CoverageMethods)(nil),
+		(*RecordStreamRequest_Deployments)(nil),
+		(*RecordStreamRequest_Issues)(nil),
+		(*RecordStreamRequest_Jobs)(nil),
+		(*RecordStreamRequest_MergeRequests)(nil),
+		(*RecordStreamRequest_MergeRequestCommits)(nil),
+		(*RecordStreamRequest_MergeRequestNoteEvents)(nil),
+		(*RecordStreamRequest_Metrics)(nil),
+		(*RecordStreamRequest_Pipelines)(nil),
+		(*RecordStreamRequest_Projects)(nil),
+		(*RecordStreamRequest_Runners)(nil),
+		(*RecordStreamRequest_Sections)(nil),
+		(*RecordStreamRequest_TestCases)(nil),
+		(*RecordStreamRequest_TestReports)(nil),
+		(*RecordStreamRequest_TestSuites)(nil),
+		(*RecordStreamRequest_Traces)(nil),
+		(*RecordStreamRequest_Environments)(nil),
+		(*RecordStreamRequest_JobArtifacts)(nil),
+		(*RecordStreamRequest_Tags)(nil),
+		(*RecordStreamRequest_Releases)(nil),
+		(*RecordStreamRequest_MergeRequestEvents)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_service_service_proto_rawDesc), len(file_gitlabexporter_protobuf_service_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      2,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_gitlabexporter_protobuf_service_service_proto_goTypes,
 		DependencyIndexes: file_gitlabexporter_protobuf_service_service_proto_depIdxs,
+		EnumInfos:         file_gitlabexporter_protobuf_service_service_proto_enumTypes,
 		MessageInfos:      file_gitlabexporter_protobuf_service_service_proto_msgTypes,
 	}.Build()
 	File_gitlabexporter_protobuf_service_service_proto = out.File
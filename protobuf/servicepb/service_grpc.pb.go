@@ -25,20 +25,27 @@ const (
 	GitLabExporter_RecordCoverageClasses_FullMethodName        = "/gitlabexporter.protobuf.service.GitLabExporter/RecordCoverageClasses"
 	GitLabExporter_RecordCoverageMethods_FullMethodName        = "/gitlabexporter.protobuf.service.GitLabExporter/RecordCoverageMethods"
 	GitLabExporter_RecordDeployments_FullMethodName            = "/gitlabexporter.protobuf.service.GitLabExporter/RecordDeployments"
+	GitLabExporter_RecordEnvironments_FullMethodName           = "/gitlabexporter.protobuf.service.GitLabExporter/RecordEnvironments"
 	GitLabExporter_RecordIssues_FullMethodName                 = "/gitlabexporter.protobuf.service.GitLabExporter/RecordIssues"
 	GitLabExporter_RecordJobs_FullMethodName                   = "/gitlabexporter.protobuf.service.GitLabExporter/RecordJobs"
+	GitLabExporter_RecordJobArtifacts_FullMethodName           = "/gitlabexporter.protobuf.service.GitLabExporter/RecordJobArtifacts"
 	GitLabExporter_RecordMergeRequests_FullMethodName          = "/gitlabexporter.protobuf.service.GitLabExporter/RecordMergeRequests"
 	GitLabExporter_RecordMergeRequestCommits_FullMethodName    = "/gitlabexporter.protobuf.service.GitLabExporter/RecordMergeRequestCommits"
 	GitLabExporter_RecordMergeRequestNoteEvents_FullMethodName = "/gitlabexporter.protobuf.service.GitLabExporter/RecordMergeRequestNoteEvents"
+	GitLabExporter_RecordMergeRequestEvents_FullMethodName     = "/gitlabexporter.protobuf.service.GitLabExporter/RecordMergeRequestEvents"
 	GitLabExporter_RecordMetrics_FullMethodName                = "/gitlabexporter.protobuf.service.GitLabExporter/RecordMetrics"
 	GitLabExporter_RecordPipelines_FullMethodName              = "/gitlabexporter.protobuf.service.GitLabExporter/RecordPipelines"
 	GitLabExporter_RecordProjects_FullMethodName               = "/gitlabexporter.protobuf.service.GitLabExporter/RecordProjects"
+	GitLabExporter_RecordReleases_FullMethodName               = "/gitlabexporter.protobuf.service.GitLabExporter/RecordReleases"
 	GitLabExporter_RecordRunners_FullMethodName                = "/gitlabexporter.protobuf.service.GitLabExporter/RecordRunners"
 	GitLabExporter_RecordSections_FullMethodName               = "/gitlabexporter.protobuf.service.GitLabExporter/RecordSections"
+	GitLabExporter_RecordTags_FullMethodName                   = "/gitlabexporter.protobuf.service.GitLabExporter/RecordTags"
 	GitLabExporter_RecordTestCases_FullMethodName              = "/gitlabexporter.protobuf.service.GitLabExporter/RecordTestCases"
 	GitLabExporter_RecordTestReports_FullMethodName            = "/gitlabexporter.protobuf.service.GitLabExporter/RecordTestReports"
 	GitLabExporter_RecordTestSuites_FullMethodName             = "/gitlabexporter.protobuf.service.GitLabExporter/RecordTestSuites"
 	GitLabExporter_RecordTraces_FullMethodName                 = "/gitlabexporter.protobuf.service.GitLabExporter/RecordTraces"
+	GitLabExporter_RecordStream_FullMethodName                 = "/gitlabexporter.protobuf.service.GitLabExporter/RecordStream"
+	GitLabExporter_GetCapabilities_FullMethodName              = "/gitlabexporter.protobuf.service.GitLabExporter/GetCapabilities"
 )
 
 // GitLabExporterClient is the client API for GitLabExporter service.
@@ -51,20 +58,27 @@ type GitLabExporterClient interface {
 	RecordCoverageClasses(ctx context.Context, in *RecordCoverageClassesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordCoverageMethods(ctx context.Context, in *RecordCoverageMethodsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordDeployments(ctx context.Context, in *RecordDeploymentsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordEnvironments(ctx context.Context, in *RecordEnvironmentsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordIssues(ctx context.Context, in *RecordIssuesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordJobs(ctx context.Context, in *RecordJobsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordJobArtifacts(ctx context.Context, in *RecordJobArtifactsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordMergeRequests(ctx context.Context, in *RecordMergeRequestsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordMergeRequestCommits(ctx context.Context, in *RecordMergeRequestCommitsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordMergeRequestNoteEvents(ctx context.Context, in *RecordMergeRequestNoteEventsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordMergeRequestEvents(ctx context.Context, in *RecordMergeRequestEventsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordMetrics(ctx context.Context, in *RecordMetricsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordPipelines(ctx context.Context, in *RecordPipelinesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordProjects(ctx context.Context, in *RecordProjectsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordReleases(ctx context.Context, in *RecordReleasesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordRunners(ctx context.Context, in *RecordRunnersRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordSections(ctx context.Context, in *RecordSectionsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordTags(ctx context.Context, in *RecordTagsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordTestCases(ctx context.Context, in *RecordTestCasesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordTestReports(ctx context.Context, in *RecordTestReportsRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordTestSuites(ctx context.Context, in *RecordTestSuitesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
 	RecordTraces(ctx context.Context, in *RecordTracesRequest, opts ...grpc.CallOption) (*RecordSummary, error)
+	RecordStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[RecordStreamRequest, RecordSummary], error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error)
 }
 
 type gitLabExporterClient struct {
@@ -135,6 +149,16 @@ func (c *gitLabExporterClient) RecordDeployments(ctx context.Context, in *Record
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordEnvironments(ctx context.Context, in *RecordEnvironmentsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSummary)
+	err := c.cc.Invoke(ctx, GitLabExporter_RecordEnvironments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitLabExporterClient) RecordIssues(ctx context.Context, in *RecordIssuesRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordSummary)
@@ -155,6 +179,16 @@ func (c *gitLabExporterClient) RecordJobs(ctx context.Context, in *RecordJobsReq
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordJobArtifacts(ctx context.Context, in *RecordJobArtifactsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSummary)
+	err := c.cc.Invoke(ctx, GitLabExporter_RecordJobArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitLabExporterClient) RecordMergeRequests(ctx context.Context, in *RecordMergeRequestsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordSummary)
@@ -185,6 +219,16 @@ func (c *gitLabExporterClient) RecordMergeRequestNoteEvents(ctx context.Context,
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordMergeRequestEvents(ctx context.Context, in *RecordMergeRequestEventsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSummary)
+	err := c.cc.Invoke(ctx, GitLabExporter_RecordMergeRequestEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitLabExporterClient) RecordMetrics(ctx context.Context, in *RecordMetricsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordSummary)
@@ -215,6 +259,16 @@ func (c *gitLabExporterClient) RecordProjects(ctx context.Context, in *RecordPro
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordReleases(ctx context.Context, in *RecordReleasesRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSummary)
+	err := c.cc.Invoke(ctx, GitLabExporter_RecordReleases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitLabExporterClient) RecordRunners(ctx context.Context, in *RecordRunnersRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordSummary)
@@ -235,6 +289,16 @@ func (c *gitLabExporterClient) RecordSections(ctx context.Context, in *RecordSec
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordTags(ctx context.Context, in *RecordTagsRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSummary)
+	err := c.cc.Invoke(ctx, GitLabExporter_RecordTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gitLabExporterClient) RecordTestCases(ctx context.Context, in *RecordTestCasesRequest, opts ...grpc.CallOption) (*RecordSummary, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RecordSummary)
@@ -275,6 +339,29 @@ func (c *gitLabExporterClient) RecordTraces(ctx context.Context, in *RecordTrace
 	return out, nil
 }
 
+func (c *gitLabExporterClient) RecordStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[RecordStreamRequest, RecordSummary], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GitLabExporter_ServiceDesc.Streams[0], GitLabExporter_RecordStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RecordStreamRequest, RecordSummary]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GitLabExporter_RecordStreamClient = grpc.ClientStreamingClient[RecordStreamRequest, RecordSummary]
+
+func (c *gitLabExporterClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest, opts ...grpc.CallOption) (*Capabilities, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Capabilities)
+	err := c.cc.Invoke(ctx, GitLabExporter_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GitLabExporterServer is the server API for GitLabExporter service.
 // All implementations must embed UnimplementedGitLabExporterServer
 // for forward compatibility.
@@ -285,20 +372,27 @@ type GitLabExporterServer interface {
 	RecordCoverageClasses(context.Context, *RecordCoverageClassesRequest) (*RecordSummary, error)
 	RecordCoverageMethods(context.Context, *RecordCoverageMethodsRequest) (*RecordSummary, error)
 	RecordDeployments(context.Context, *RecordDeploymentsRequest) (*RecordSummary, error)
+	RecordEnvironments(context.Context, *RecordEnvironmentsRequest) (*RecordSummary, error)
 	RecordIssues(context.Context, *RecordIssuesRequest) (*RecordSummary, error)
 	RecordJobs(context.Context, *RecordJobsRequest) (*RecordSummary, error)
+	RecordJobArtifacts(context.Context, *RecordJobArtifactsRequest) (*RecordSummary, error)
 	RecordMergeRequests(context.Context, *RecordMergeRequestsRequest) (*RecordSummary, error)
 	RecordMergeRequestCommits(context.Context, *RecordMergeRequestCommitsRequest) (*RecordSummary, error)
 	RecordMergeRequestNoteEvents(context.Context, *RecordMergeRequestNoteEventsRequest) (*RecordSummary, error)
+	RecordMergeRequestEvents(context.Context, *RecordMergeRequestEventsRequest) (*RecordSummary, error)
 	RecordMetrics(context.Context, *RecordMetricsRequest) (*RecordSummary, error)
 	RecordPipelines(context.Context, *RecordPipelinesRequest) (*RecordSummary, error)
 	RecordProjects(context.Context, *RecordProjectsRequest) (*RecordSummary, error)
+	RecordReleases(context.Context, *RecordReleasesRequest) (*RecordSummary, error)
 	RecordRunners(context.Context, *RecordRunnersRequest) (*RecordSummary, error)
 	RecordSections(context.Context, *RecordSectionsRequest) (*RecordSummary, error)
+	RecordTags(context.Context, *RecordTagsRequest) (*RecordSummary, error)
 	RecordTestCases(context.Context, *RecordTestCasesRequest) (*RecordSummary, error)
 	RecordTestReports(context.Context, *RecordTestReportsRequest) (*RecordSummary, error)
 	RecordTestSuites(context.Context, *RecordTestSuitesRequest) (*RecordSummary, error)
 	RecordTraces(context.Context, *RecordTracesRequest) (*RecordSummary, error)
+	RecordStream(grpc.ClientStreamingServer[RecordStreamRequest, RecordSummary]) error
+	GetCapabilities(context.Context, *GetCapabilitiesRequest) (*Capabilities, error)
 	mustEmbedUnimplementedGitLabExporterServer()
 }
 
@@ -327,12 +421,18 @@ func (UnimplementedGitLabExporterServer) RecordCoverageMethods(context.Context,
 func (UnimplementedGitLabExporterServer) RecordDeployments(context.Context, *RecordDeploymentsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordDeployments not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordEnvironments(context.Context, *RecordEnvironmentsRequest) (*RecordSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordEnvironments not implemented")
+}
 func (UnimplementedGitLabExporterServer) RecordIssues(context.Context, *RecordIssuesRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordIssues not implemented")
 }
 func (UnimplementedGitLabExporterServer) RecordJobs(context.Context, *RecordJobsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordJobs not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordJobArtifacts(context.Context, *RecordJobArtifactsRequest) (*RecordSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordJobArtifacts not implemented")
+}
 func (UnimplementedGitLabExporterServer) RecordMergeRequests(context.Context, *RecordMergeRequestsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordMergeRequests not implemented")
 }
@@ -342,6 +442,9 @@ func (UnimplementedGitLabExporterServer) RecordMergeRequestCommits(context.Conte
 func (UnimplementedGitLabExporterServer) RecordMergeRequestNoteEvents(context.Context, *RecordMergeRequestNoteEventsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordMergeRequestNoteEvents not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordMergeRequestEvents(context.Context, *RecordMergeRequestEventsRequest) (*RecordSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordMergeRequestEvents not implemented")
+}
 func (UnimplementedGitLabExporterServer) RecordMetrics(context.Context, *RecordMetricsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordMetrics not implemented")
 }
@@ -351,12 +454,18 @@ func (UnimplementedGitLabExporterServer) RecordPipelines(context.Context, *Recor
 func (UnimplementedGitLabExporterServer) RecordProjects(context.Context, *RecordProjectsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordProjects not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordReleases(context.Context, *RecordReleasesRequest) (*RecordSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordReleases not implemented")
+}
 func (UnimplementedGitLabExporterServer) RecordRunners(context.Context, *RecordRunnersRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordRunners not implemented")
 }
 func (UnimplementedGitLabExporterServer) RecordSections(context.Context, *RecordSectionsRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordSections not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordTags(context.Context, *RecordTagsRequest) (*RecordSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTags not implemented")
+}
 func (UnimplementedGitLabExporterServer) RecordTestCases(context.Context, *RecordTestCasesRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordTestCases not implemented")
 }
@@ -369,6 +478,12 @@ func (UnimplementedGitLabExporterServer) RecordTestSuites(context.Context, *Reco
 func (UnimplementedGitLabExporterServer) RecordTraces(context.Context, *RecordTracesRequest) (*RecordSummary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordTraces not implemented")
 }
+func (UnimplementedGitLabExporterServer) RecordStream(grpc.ClientStreamingServer[RecordStreamRequest, RecordSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method RecordStream not implemented")
+}
+func (UnimplementedGitLabExporterServer) GetCapabilities(context.Context, *GetCapabilitiesRequest) (*Capabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedGitLabExporterServer) mustEmbedUnimplementedGitLabExporterServer() {}
 func (UnimplementedGitLabExporterServer) testEmbeddedByValue()                        {}
 
@@ -498,6 +613,24 @@ func _GitLabExporter_RecordDeployments_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).RecordEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_RecordEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).RecordEnvironments(ctx, req.(*RecordEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitLabExporter_RecordIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordIssuesRequest)
 	if err := dec(in); err != nil {
@@ -534,6 +667,24 @@ func _GitLabExporter_RecordJobs_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordJobArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordJobArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).RecordJobArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_RecordJobArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).RecordJobArtifacts(ctx, req.(*RecordJobArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitLabExporter_RecordMergeRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordMergeRequestsRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +739,24 @@ func _GitLabExporter_RecordMergeRequestNoteEvents_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordMergeRequestEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordMergeRequestEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).RecordMergeRequestEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_RecordMergeRequestEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).RecordMergeRequestEvents(ctx, req.(*RecordMergeRequestEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitLabExporter_RecordMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordMetricsRequest)
 	if err := dec(in); err != nil {
@@ -642,6 +811,24 @@ func _GitLabExporter_RecordProjects_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordReleasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).RecordReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_RecordReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).RecordReleases(ctx, req.(*RecordReleasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitLabExporter_RecordRunners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordRunnersRequest)
 	if err := dec(in); err != nil {
@@ -678,6 +865,24 @@ func _GitLabExporter_RecordSections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).RecordTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_RecordTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).RecordTags(ctx, req.(*RecordTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitLabExporter_RecordTestCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RecordTestCasesRequest)
 	if err := dec(in); err != nil {
@@ -750,6 +955,31 @@ func _GitLabExporter_RecordTraces_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GitLabExporter_RecordStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GitLabExporterServer).RecordStream(&grpc.GenericServerStream[RecordStreamRequest, RecordSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GitLabExporter_RecordStreamServer = grpc.ClientStreamingServer[RecordStreamRequest, RecordSummary]
+
+func _GitLabExporter_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitLabExporterServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitLabExporter_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitLabExporterServer).GetCapabilities(ctx, req.(*GetCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GitLabExporter_ServiceDesc is the grpc.ServiceDesc for GitLabExporter service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -781,6 +1011,10 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordDeployments",
 			Handler:    _GitLabExporter_RecordDeployments_Handler,
 		},
+		{
+			MethodName: "RecordEnvironments",
+			Handler:    _GitLabExporter_RecordEnvironments_Handler,
+		},
 		{
 			MethodName: "RecordIssues",
 			Handler:    _GitLabExporter_RecordIssues_Handler,
@@ -789,6 +1023,10 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordJobs",
 			Handler:    _GitLabExporter_RecordJobs_Handler,
 		},
+		{
+			MethodName: "RecordJobArtifacts",
+			Handler:    _GitLabExporter_RecordJobArtifacts_Handler,
+		},
 		{
 			MethodName: "RecordMergeRequests",
 			Handler:    _GitLabExporter_RecordMergeRequests_Handler,
@@ -801,6 +1039,10 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordMergeRequestNoteEvents",
 			Handler:    _GitLabExporter_RecordMergeRequestNoteEvents_Handler,
 		},
+		{
+			MethodName: "RecordMergeRequestEvents",
+			Handler:    _GitLabExporter_RecordMergeRequestEvents_Handler,
+		},
 		{
 			MethodName: "RecordMetrics",
 			Handler:    _GitLabExporter_RecordMetrics_Handler,
@@ -813,6 +1055,10 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordProjects",
 			Handler:    _GitLabExporter_RecordProjects_Handler,
 		},
+		{
+			MethodName: "RecordReleases",
+			Handler:    _GitLabExporter_RecordReleases_Handler,
+		},
 		{
 			MethodName: "RecordRunners",
 			Handler:    _GitLabExporter_RecordRunners_Handler,
@@ -821,6 +1067,10 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordSections",
 			Handler:    _GitLabExporter_RecordSections_Handler,
 		},
+		{
+			MethodName: "RecordTags",
+			Handler:    _GitLabExporter_RecordTags_Handler,
+		},
 		{
 			MethodName: "RecordTestCases",
 			Handler:    _GitLabExporter_RecordTestCases_Handler,
@@ -837,7 +1087,17 @@ var GitLabExporter_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordTraces",
 			Handler:    _GitLabExporter_RecordTraces_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _GitLabExporter_GetCapabilities_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RecordStream",
+			Handler:       _GitLabExporter_RecordStream_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gitlabexporter/protobuf/service/service.proto",
 }
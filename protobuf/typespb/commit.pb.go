@@ -37,12 +37,14 @@ type Commit struct {
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	Title          string                 `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
 	Message        string                 `protobuf:"bytes,13,opt,name=message,proto3" json:"message,omitempty"`
-	Trailers       map[string]string      `protobuf:"bytes,14,rep,name=trailers,proto3" json:"trailers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Trailers       []*CommitTrailer       `protobuf:"bytes,18,rep,name=trailers,proto3" json:"trailers,omitempty"`
 	Stats          *CommitStats           `protobuf:"bytes,15,opt,name=stats,proto3" json:"stats,omitempty"`
 	Status         string                 `protobuf:"bytes,16,opt,name=status,proto3" json:"status,omitempty"`
 	WebUrl         string                 `protobuf:"bytes,17,opt,name=web_url,json=webUrl,proto3" json:"web_url,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Branch the commit was fetched from
+	Ref           string `protobuf:"bytes,19,opt,name=ref,proto3" json:"ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Commit) Reset() {
@@ -166,7 +168,7 @@ func (x *Commit) GetMessage() string {
 	return ""
 }
 
-func (x *Commit) GetTrailers() map[string]string {
+func (x *Commit) GetTrailers() []*CommitTrailer {
 	if x != nil {
 		return x.Trailers
 	}
@@ -194,6 +196,13 @@ func (x *Commit) GetWebUrl() string {
 	return ""
 }
 
+func (x *Commit) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
 type CommitStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Additions     int64                  `protobuf:"varint,1,opt,name=additions,proto3" json:"additions,omitempty"`
@@ -310,7 +319,7 @@ var File_gitlabexporter_protobuf_commit_proto protoreflect.FileDescriptor
 
 const file_gitlabexporter_protobuf_commit_proto_rawDesc = "" +
 	"\n" +
-	"$gitlabexporter/protobuf/commit.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe9\x05\n" +
+	"$gitlabexporter/protobuf/commit.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbd\x05\n" +
 	"\x06Commit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\bshort_id\x18\x02 \x01(\tR\ashortId\x12\x1d\n" +
@@ -329,14 +338,12 @@ const file_gitlabexporter_protobuf_commit_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
 	"\x05title\x18\f \x01(\tR\x05title\x12\x18\n" +
-	"\amessage\x18\r \x01(\tR\amessage\x12I\n" +
-	"\btrailers\x18\x0e \x03(\v2-.gitlabexporter.protobuf.Commit.TrailersEntryR\btrailers\x12:\n" +
+	"\amessage\x18\r \x01(\tR\amessage\x12B\n" +
+	"\btrailers\x18\x12 \x03(\v2&.gitlabexporter.protobuf.CommitTrailerR\btrailers\x12:\n" +
 	"\x05stats\x18\x0f \x01(\v2$.gitlabexporter.protobuf.CommitStatsR\x05stats\x12\x16\n" +
 	"\x06status\x18\x10 \x01(\tR\x06status\x12\x17\n" +
-	"\aweb_url\x18\x11 \x01(\tR\x06webUrl\x1a;\n" +
-	"\rTrailersEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"_\n" +
+	"\aweb_url\x18\x11 \x01(\tR\x06webUrl\x12\x10\n" +
+	"\x03ref\x18\x13 \x01(\tR\x03refJ\x04\b\x0e\x10\x0f\"_\n" +
 	"\vCommitStats\x12\x1c\n" +
 	"\tadditions\x18\x01 \x01(\x03R\tadditions\x12\x1c\n" +
 	"\tdeletions\x18\x02 \x01(\x03R\tdeletions\x12\x14\n" +
@@ -357,19 +364,18 @@ func file_gitlabexporter_protobuf_commit_proto_rawDescGZIP() []byte {
 	return file_gitlabexporter_protobuf_commit_proto_rawDescData
 }
 
-var file_gitlabexporter_protobuf_commit_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gitlabexporter_protobuf_commit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_gitlabexporter_protobuf_commit_proto_goTypes = []any{
 	(*Commit)(nil),                // 0: gitlabexporter.protobuf.Commit
 	(*CommitStats)(nil),           // 1: gitlabexporter.protobuf.CommitStats
 	(*CommitTrailer)(nil),         // 2: gitlabexporter.protobuf.CommitTrailer
-	nil,                           // 3: gitlabexporter.protobuf.Commit.TrailersEntry
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
 }
 var file_gitlabexporter_protobuf_commit_proto_depIdxs = []int32{
-	4, // 0: gitlabexporter.protobuf.Commit.authored_date:type_name -> google.protobuf.Timestamp
-	4, // 1: gitlabexporter.protobuf.Commit.committed_date:type_name -> google.protobuf.Timestamp
-	4, // 2: gitlabexporter.protobuf.Commit.created_at:type_name -> google.protobuf.Timestamp
-	3, // 3: gitlabexporter.protobuf.Commit.trailers:type_name -> gitlabexporter.protobuf.Commit.TrailersEntry
+	3, // 0: gitlabexporter.protobuf.Commit.authored_date:type_name -> google.protobuf.Timestamp
+	3, // 1: gitlabexporter.protobuf.Commit.committed_date:type_name -> google.protobuf.Timestamp
+	3, // 2: gitlabexporter.protobuf.Commit.created_at:type_name -> google.protobuf.Timestamp
+	2, // 3: gitlabexporter.protobuf.Commit.trailers:type_name -> gitlabexporter.protobuf.CommitTrailer
 	1, // 4: gitlabexporter.protobuf.Commit.stats:type_name -> gitlabexporter.protobuf.CommitStats
 	5, // [5:5] is the sub-list for method output_type
 	5, // [5:5] is the sub-list for method input_type
@@ -389,7 +395,7 @@ func file_gitlabexporter_protobuf_commit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_commit_proto_rawDesc), len(file_gitlabexporter_protobuf_commit_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
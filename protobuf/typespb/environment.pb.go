@@ -0,0 +1,351 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.30.2
+// source: gitlabexporter/protobuf/environment.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EnvironmentState int32
+
+const (
+	EnvironmentState_ENVIRONMENT_STATE_UNSPECIFIED EnvironmentState = 0
+	EnvironmentState_ENVIRONMENT_STATE_AVAILABLE   EnvironmentState = 1
+	EnvironmentState_ENVIRONMENT_STATE_STOPPING    EnvironmentState = 2
+	EnvironmentState_ENVIRONMENT_STATE_STOPPED     EnvironmentState = 3
+)
+
+// Enum value maps for EnvironmentState.
+var (
+	EnvironmentState_name = map[int32]string{
+		0: "ENVIRONMENT_STATE_UNSPECIFIED",
+		1: "ENVIRONMENT_STATE_AVAILABLE",
+		2: "ENVIRONMENT_STATE_STOPPING",
+		3: "ENVIRONMENT_STATE_STOPPED",
+	}
+	EnvironmentState_value = map[string]int32{
+		"ENVIRONMENT_STATE_UNSPECIFIED": 0,
+		"ENVIRONMENT_STATE_AVAILABLE":   1,
+		"ENVIRONMENT_STATE_STOPPING":    2,
+		"ENVIRONMENT_STATE_STOPPED":     3,
+	}
+)
+
+func (x EnvironmentState) Enum() *EnvironmentState {
+	p := new(EnvironmentState)
+	*p = x
+	return p
+}
+
+func (x EnvironmentState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnvironmentState) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitlabexporter_protobuf_environment_proto_enumTypes[0].Descriptor()
+}
+
+func (EnvironmentState) Type() protoreflect.EnumType {
+	return &file_gitlabexporter_protobuf_environment_proto_enumTypes[0]
+}
+
+func (x EnvironmentState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnvironmentState.Descriptor instead.
+func (EnvironmentState) EnumDescriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_environment_proto_rawDescGZIP(), []int{0}
+}
+
+type Environment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Project        *ProjectReference      `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug           string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description    string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ExternalUrl    string                 `protobuf:"bytes,6,opt,name=external_url,json=externalUrl,proto3" json:"external_url,omitempty"`
+	State          EnvironmentState       `protobuf:"varint,7,opt,name=state,proto3,enum=gitlabexporter.protobuf.EnvironmentState" json:"state,omitempty"`
+	Tier           DeploymentTier         `protobuf:"varint,8,opt,name=tier,proto3,enum=gitlabexporter.protobuf.DeploymentTier" json:"tier,omitempty"`
+	Timestamps     *EnvironmentTimestamps `protobuf:"bytes,9,opt,name=timestamps,proto3" json:"timestamps,omitempty"`
+	LastDeployment *Deployment            `protobuf:"bytes,10,opt,name=last_deployment,json=lastDeployment,proto3,oneof" json:"last_deployment,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Environment) Reset() {
+	*x = Environment{}
+	mi := &file_gitlabexporter_protobuf_environment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Environment) ProtoMessage() {}
+
+func (x *Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_environment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Environment.ProtoReflect.Descriptor instead.
+func (*Environment) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_environment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Environment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Environment) GetProject() *ProjectReference {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *Environment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Environment) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Environment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Environment) GetExternalUrl() string {
+	if x != nil {
+		return x.ExternalUrl
+	}
+	return ""
+}
+
+func (x *Environment) GetState() EnvironmentState {
+	if x != nil {
+		return x.State
+	}
+	return EnvironmentState_ENVIRONMENT_STATE_UNSPECIFIED
+}
+
+func (x *Environment) GetTier() DeploymentTier {
+	if x != nil {
+		return x.Tier
+	}
+	return DeploymentTier_DEPLOYMENT_TIER_UNSPECIFIED
+}
+
+func (x *Environment) GetTimestamps() *EnvironmentTimestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *Environment) GetLastDeployment() *Deployment {
+	if x != nil {
+		return x.LastDeployment
+	}
+	return nil
+}
+
+type EnvironmentTimestamps struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AutoStopAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=auto_stop_at,json=autoStopAt,proto3" json:"auto_stop_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvironmentTimestamps) Reset() {
+	*x = EnvironmentTimestamps{}
+	mi := &file_gitlabexporter_protobuf_environment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentTimestamps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentTimestamps) ProtoMessage() {}
+
+func (x *EnvironmentTimestamps) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_environment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentTimestamps.ProtoReflect.Descriptor instead.
+func (*EnvironmentTimestamps) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_environment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnvironmentTimestamps) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EnvironmentTimestamps) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EnvironmentTimestamps) GetAutoStopAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AutoStopAt
+	}
+	return nil
+}
+
+var File_gitlabexporter_protobuf_environment_proto protoreflect.FileDescriptor
+
+const file_gitlabexporter_protobuf_environment_proto_rawDesc = "" +
+	"\n" +
+	")gitlabexporter/protobuf/environment.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(gitlabexporter/protobuf/deployment.proto\x1a(gitlabexporter/protobuf/references.proto\"\x84\x04\n" +
+	"\vEnvironment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12C\n" +
+	"\aproject\x18\x02 \x01(\v2).gitlabexporter.protobuf.ProjectReferenceR\aproject\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12!\n" +
+	"\fexternal_url\x18\x06 \x01(\tR\vexternalUrl\x12?\n" +
+	"\x05state\x18\a \x01(\x0e2).gitlabexporter.protobuf.EnvironmentStateR\x05state\x12;\n" +
+	"\x04tier\x18\b \x01(\x0e2'.gitlabexporter.protobuf.DeploymentTierR\x04tier\x12N\n" +
+	"\n" +
+	"timestamps\x18\t \x01(\v2..gitlabexporter.protobuf.EnvironmentTimestampsR\n" +
+	"timestamps\x12Q\n" +
+	"\x0flast_deployment\x18\n" +
+	" \x01(\v2#.gitlabexporter.protobuf.DeploymentH\x00R\x0elastDeployment\x88\x01\x01B\x12\n" +
+	"\x10_last_deployment\"\xcb\x01\n" +
+	"\x15EnvironmentTimestamps\x129\n" +
+	"\n" +
+	"created_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12<\n" +
+	"\fauto_stop_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"autoStopAt*\x95\x01\n" +
+	"\x10EnvironmentState\x12!\n" +
+	"\x1dENVIRONMENT_STATE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bENVIRONMENT_STATE_AVAILABLE\x10\x01\x12\x1e\n" +
+	"\x1aENVIRONMENT_STATE_STOPPING\x10\x02\x12\x1d\n" +
+	"\x19ENVIRONMENT_STATE_STOPPED\x10\x03B0Z.go.cluttr.dev/gitlab-exporter/protobuf/typespbb\x06proto3"
+
+var (
+	file_gitlabexporter_protobuf_environment_proto_rawDescOnce sync.Once
+	file_gitlabexporter_protobuf_environment_proto_rawDescData []byte
+)
+
+func file_gitlabexporter_protobuf_environment_proto_rawDescGZIP() []byte {
+	file_gitlabexporter_protobuf_environment_proto_rawDescOnce.Do(func() {
+		file_gitlabexporter_protobuf_environment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_environment_proto_rawDesc), len(file_gitlabexporter_protobuf_environment_proto_rawDesc)))
+	})
+	return file_gitlabexporter_protobuf_environment_proto_rawDescData
+}
+
+var file_gitlabexporter_protobuf_environment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gitlabexporter_protobuf_environment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_gitlabexporter_protobuf_environment_proto_goTypes = []any{
+	(EnvironmentState)(0),         // 0: gitlabexporter.protobuf.EnvironmentState
+	(*Environment)(nil),           // 1: gitlabexporter.protobuf.Environment
+	(*EnvironmentTimestamps)(nil), // 2: gitlabexporter.protobuf.EnvironmentTimestamps
+	(*ProjectReference)(nil),      // 3: gitlabexporter.protobuf.ProjectReference
+	(DeploymentTier)(0),           // 4: gitlabexporter.protobuf.DeploymentTier
+	(*Deployment)(nil),            // 5: gitlabexporter.protobuf.Deployment
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+}
+var file_gitlabexporter_protobuf_environment_proto_depIdxs = []int32{
+	3, // 0: gitlabexporter.protobuf.Environment.project:type_name -> gitlabexporter.protobuf.ProjectReference
+	0, // 1: gitlabexporter.protobuf.Environment.state:type_name -> gitlabexporter.protobuf.EnvironmentState
+	4, // 2: gitlabexporter.protobuf.Environment.tier:type_name -> gitlabexporter.protobuf.DeploymentTier
+	2, // 3: gitlabexporter.protobuf.Environment.timestamps:type_name -> gitlabexporter.protobuf.EnvironmentTimestamps
+	5, // 4: gitlabexporter.protobuf.Environment.last_deployment:type_name -> gitlabexporter.protobuf.Deployment
+	6, // 5: gitlabexporter.protobuf.EnvironmentTimestamps.created_at:type_name -> google.protobuf.Timestamp
+	6, // 6: gitlabexporter.protobuf.EnvironmentTimestamps.updated_at:type_name -> google.protobuf.Timestamp
+	6, // 7: gitlabexporter.protobuf.EnvironmentTimestamps.auto_stop_at:type_name -> google.protobuf.Timestamp
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_gitlabexporter_protobuf_environment_proto_init() }
+func file_gitlabexporter_protobuf_environment_proto_init() {
+	if File_gitlabexporter_protobuf_environment_proto != nil {
+		return
+	}
+	file_gitlabexporter_protobuf_deployment_proto_init()
+	file_gitlabexporter_protobuf_references_proto_init()
+	file_gitlabexporter_protobuf_environment_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_environment_proto_rawDesc), len(file_gitlabexporter_protobuf_environment_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gitlabexporter_protobuf_environment_proto_goTypes,
+		DependencyIndexes: file_gitlabexporter_protobuf_environment_proto_depIdxs,
+		EnumInfos:         file_gitlabexporter_protobuf_environment_proto_enumTypes,
+		MessageInfos:      file_gitlabexporter_protobuf_environment_proto_msgTypes,
+	}.Build()
+	File_gitlabexporter_protobuf_environment_proto = out.File
+	file_gitlabexporter_protobuf_environment_proto_goTypes = nil
+	file_gitlabexporter_protobuf_environment_proto_depIdxs = nil
+}
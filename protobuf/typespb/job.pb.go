@@ -96,8 +96,13 @@ type Job struct {
 	Kind               JobKind                `protobuf:"varint,20,opt,name=kind,proto3,enum=gitlabexporter.protobuf.JobKind" json:"kind,omitempty"`
 	DownstreamPipeline *PipelineReference     `protobuf:"bytes,21,opt,name=downstream_pipeline,json=downstreamPipeline,proto3,oneof" json:"downstream_pipeline,omitempty"`
 	Runner             *RunnerReference       `protobuf:"bytes,22,opt,name=runner,proto3" json:"runner,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Category of the first failure classification rule matching the log of
+	// a failed job.
+	FailureCategory string `protobuf:"bytes,23,opt,name=failure_category,json=failureCategory,proto3" json:"failure_category,omitempty"`
+	// Log line matched by the failure classification rule.
+	FailureExcerpt string `protobuf:"bytes,24,opt,name=failure_excerpt,json=failureExcerpt,proto3" json:"failure_excerpt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Job) Reset() {
@@ -284,6 +289,20 @@ func (x *Job) GetRunner() *RunnerReference {
 	return nil
 }
 
+func (x *Job) GetFailureCategory() string {
+	if x != nil {
+		return x.FailureCategory
+	}
+	return ""
+}
+
+func (x *Job) GetFailureExcerpt() string {
+	if x != nil {
+		return x.FailureExcerpt
+	}
+	return ""
+}
+
 type JobTimestamps struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -412,11 +431,114 @@ func (x *JobProperty) GetValue() string {
 	return ""
 }
 
+type JobArtifact struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Job      *JobReference          `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`
+	FileType string                 `protobuf:"bytes,3,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	Name     string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Size of the artifact in bytes.
+	Size     int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	ExpireAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	// Whether the artifact is kept past its expiry date, e.g. because it
+	// belongs to the latest pipeline of a ref.
+	Locked        bool                   `protobuf:"varint,7,opt,name=locked,proto3" json:"locked,omitempty"`
+	JobFinishedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=job_finished_at,json=jobFinishedAt,proto3" json:"job_finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobArtifact) Reset() {
+	*x = JobArtifact{}
+	mi := &file_gitlabexporter_protobuf_job_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobArtifact) ProtoMessage() {}
+
+func (x *JobArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_job_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobArtifact.ProtoReflect.Descriptor instead.
+func (*JobArtifact) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_job_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JobArtifact) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JobArtifact) GetJob() *JobReference {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *JobArtifact) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *JobArtifact) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *JobArtifact) GetExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+func (x *JobArtifact) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *JobArtifact) GetJobFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JobFinishedAt
+	}
+	return nil
+}
+
 var File_gitlabexporter_protobuf_job_proto protoreflect.FileDescriptor
 
 const file_gitlabexporter_protobuf_job_proto_rawDesc = "" +
 	"\n" +
-	"!gitlabexporter/protobuf/job.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a(gitlabexporter/protobuf/references.proto\"\xb0\a\n" +
+	"!gitlabexporter/protobuf/job.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a(gitlabexporter/protobuf/references.proto\"\x84\b\n" +
 	"\x03Job\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12F\n" +
@@ -444,7 +566,9 @@ const file_gitlabexporter_protobuf_job_proto_rawDesc = "" +
 	"\tretryable\x18\x13 \x01(\bR\tretryable\x124\n" +
 	"\x04kind\x18\x14 \x01(\x0e2 .gitlabexporter.protobuf.JobKindR\x04kind\x12`\n" +
 	"\x13downstream_pipeline\x18\x15 \x01(\v2*.gitlabexporter.protobuf.PipelineReferenceH\x00R\x12downstreamPipeline\x88\x01\x01\x12@\n" +
-	"\x06runner\x18\x16 \x01(\v2(.gitlabexporter.protobuf.RunnerReferenceR\x06runnerB\x16\n" +
+	"\x06runner\x18\x16 \x01(\v2(.gitlabexporter.protobuf.RunnerReferenceR\x06runner\x12)\n" +
+	"\x10failure_category\x18\x17 \x01(\tR\x0ffailureCategory\x12'\n" +
+	"\x0ffailure_excerpt\x18\x18 \x01(\tR\x0efailureExcerptB\x16\n" +
 	"\x14_downstream_pipeline\"\xb4\x02\n" +
 	"\rJobTimestamps\x129\n" +
 	"\n" +
@@ -457,7 +581,16 @@ const file_gitlabexporter_protobuf_job_proto_rawDesc = "" +
 	"\terased_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\berasedAt\"7\n" +
 	"\vJobProperty\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value*I\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xb0\x02\n" +
+	"\vJobArtifact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x127\n" +
+	"\x03job\x18\x02 \x01(\v2%.gitlabexporter.protobuf.JobReferenceR\x03job\x12\x1b\n" +
+	"\tfile_type\x18\x03 \x01(\tR\bfileType\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x127\n" +
+	"\texpire_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bexpireAt\x12\x16\n" +
+	"\x06locked\x18\a \x01(\bR\x06locked\x12B\n" +
+	"\x0fjob_finished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rjobFinishedAt*I\n" +
 	"\aJobKind\x12\x17\n" +
 	"\x13JOBKIND_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rJOBKIND_BUILD\x10\x01\x12\x12\n" +
@@ -476,36 +609,41 @@ func file_gitlabexporter_protobuf_job_proto_rawDescGZIP() []byte {
 }
 
 var file_gitlabexporter_protobuf_job_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gitlabexporter_protobuf_job_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_gitlabexporter_protobuf_job_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_gitlabexporter_protobuf_job_proto_goTypes = []any{
 	(JobKind)(0),                  // 0: gitlabexporter.protobuf.JobKind
 	(*Job)(nil),                   // 1: gitlabexporter.protobuf.Job
 	(*JobTimestamps)(nil),         // 2: gitlabexporter.protobuf.JobTimestamps
 	(*JobProperty)(nil),           // 3: gitlabexporter.protobuf.JobProperty
-	(*PipelineReference)(nil),     // 4: gitlabexporter.protobuf.PipelineReference
-	(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
-	(*RunnerReference)(nil),       // 6: gitlabexporter.protobuf.RunnerReference
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*JobArtifact)(nil),           // 4: gitlabexporter.protobuf.JobArtifact
+	(*PipelineReference)(nil),     // 5: gitlabexporter.protobuf.PipelineReference
+	(*durationpb.Duration)(nil),   // 6: google.protobuf.Duration
+	(*RunnerReference)(nil),       // 7: gitlabexporter.protobuf.RunnerReference
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*JobReference)(nil),          // 9: gitlabexporter.protobuf.JobReference
 }
 var file_gitlabexporter_protobuf_job_proto_depIdxs = []int32{
-	4,  // 0: gitlabexporter.protobuf.Job.pipeline:type_name -> gitlabexporter.protobuf.PipelineReference
+	5,  // 0: gitlabexporter.protobuf.Job.pipeline:type_name -> gitlabexporter.protobuf.PipelineReference
 	2,  // 1: gitlabexporter.protobuf.Job.timestamps:type_name -> gitlabexporter.protobuf.JobTimestamps
-	5,  // 2: gitlabexporter.protobuf.Job.queued_duration:type_name -> google.protobuf.Duration
-	5,  // 3: gitlabexporter.protobuf.Job.duration:type_name -> google.protobuf.Duration
+	6,  // 2: gitlabexporter.protobuf.Job.queued_duration:type_name -> google.protobuf.Duration
+	6,  // 3: gitlabexporter.protobuf.Job.duration:type_name -> google.protobuf.Duration
 	3,  // 4: gitlabexporter.protobuf.Job.properties:type_name -> gitlabexporter.protobuf.JobProperty
 	0,  // 5: gitlabexporter.protobuf.Job.kind:type_name -> gitlabexporter.protobuf.JobKind
-	4,  // 6: gitlabexporter.protobuf.Job.downstream_pipeline:type_name -> gitlabexporter.protobuf.PipelineReference
-	6,  // 7: gitlabexporter.protobuf.Job.runner:type_name -> gitlabexporter.protobuf.RunnerReference
-	7,  // 8: gitlabexporter.protobuf.JobTimestamps.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 9: gitlabexporter.protobuf.JobTimestamps.queued_at:type_name -> google.protobuf.Timestamp
-	7,  // 10: gitlabexporter.protobuf.JobTimestamps.started_at:type_name -> google.protobuf.Timestamp
-	7,  // 11: gitlabexporter.protobuf.JobTimestamps.finished_at:type_name -> google.protobuf.Timestamp
-	7,  // 12: gitlabexporter.protobuf.JobTimestamps.erased_at:type_name -> google.protobuf.Timestamp
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	5,  // 6: gitlabexporter.protobuf.Job.downstream_pipeline:type_name -> gitlabexporter.protobuf.PipelineReference
+	7,  // 7: gitlabexporter.protobuf.Job.runner:type_name -> gitlabexporter.protobuf.RunnerReference
+	8,  // 8: gitlabexporter.protobuf.JobTimestamps.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 9: gitlabexporter.protobuf.JobTimestamps.queued_at:type_name -> google.protobuf.Timestamp
+	8,  // 10: gitlabexporter.protobuf.JobTimestamps.started_at:type_name -> google.protobuf.Timestamp
+	8,  // 11: gitlabexporter.protobuf.JobTimestamps.finished_at:type_name -> google.protobuf.Timestamp
+	8,  // 12: gitlabexporter.protobuf.JobTimestamps.erased_at:type_name -> google.protobuf.Timestamp
+	9,  // 13: gitlabexporter.protobuf.JobArtifact.job:type_name -> gitlabexporter.protobuf.JobReference
+	8,  // 14: gitlabexporter.protobuf.JobArtifact.expire_at:type_name -> google.protobuf.Timestamp
+	8,  // 15: gitlabexporter.protobuf.JobArtifact.job_finished_at:type_name -> google.protobuf.Timestamp
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_gitlabexporter_protobuf_job_proto_init() }
@@ -521,7 +659,7 @@ func file_gitlabexporter_protobuf_job_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_job_proto_rawDesc), len(file_gitlabexporter_protobuf_job_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,6 +22,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MergeRequestEventKind int32
+
+const (
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_UNSPECIFIED MergeRequestEventKind = 0
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_STATE       MergeRequestEventKind = 1
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_LABEL       MergeRequestEventKind = 2
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_MILESTONE   MergeRequestEventKind = 3
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_APPROVAL    MergeRequestEventKind = 4
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_REVIEW      MergeRequestEventKind = 5
+	MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_DRAFT       MergeRequestEventKind = 6
+)
+
+// Enum value maps for MergeRequestEventKind.
+var (
+	MergeRequestEventKind_name = map[int32]string{
+		0: "MERGE_REQUEST_EVENT_KIND_UNSPECIFIED",
+		1: "MERGE_REQUEST_EVENT_KIND_STATE",
+		2: "MERGE_REQUEST_EVENT_KIND_LABEL",
+		3: "MERGE_REQUEST_EVENT_KIND_MILESTONE",
+		4: "MERGE_REQUEST_EVENT_KIND_APPROVAL",
+		5: "MERGE_REQUEST_EVENT_KIND_REVIEW",
+		6: "MERGE_REQUEST_EVENT_KIND_DRAFT",
+	}
+	MergeRequestEventKind_value = map[string]int32{
+		"MERGE_REQUEST_EVENT_KIND_UNSPECIFIED": 0,
+		"MERGE_REQUEST_EVENT_KIND_STATE":       1,
+		"MERGE_REQUEST_EVENT_KIND_LABEL":       2,
+		"MERGE_REQUEST_EVENT_KIND_MILESTONE":   3,
+		"MERGE_REQUEST_EVENT_KIND_APPROVAL":    4,
+		"MERGE_REQUEST_EVENT_KIND_REVIEW":      5,
+		"MERGE_REQUEST_EVENT_KIND_DRAFT":       6,
+	}
+)
+
+func (x MergeRequestEventKind) Enum() *MergeRequestEventKind {
+	p := new(MergeRequestEventKind)
+	*p = x
+	return p
+}
+
+func (x MergeRequestEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeRequestEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_gitlabexporter_protobuf_merge_request_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeRequestEventKind) Type() protoreflect.EnumType {
+	return &file_gitlabexporter_protobuf_merge_request_proto_enumTypes[0]
+}
+
+func (x MergeRequestEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeRequestEventKind.Descriptor instead.
+func (MergeRequestEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_merge_request_proto_rawDescGZIP(), []int{0}
+}
+
 type MergeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// ID of the merge request.
@@ -879,6 +940,115 @@ func (x *MergeRequestNoteEvent) GetResolver() *UserReference {
 	return nil
 }
 
+// MergeRequestEvent is an entry of the timeline of a merge request. The id is
+// unique per kind only.
+type MergeRequestEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MergeRequest *MergeRequestReference `protobuf:"bytes,2,opt,name=merge_request,json=mergeRequest,proto3" json:"merge_request,omitempty"`
+	Kind         MergeRequestEventKind  `protobuf:"varint,3,opt,name=kind,proto3,enum=gitlabexporter.protobuf.MergeRequestEventKind" json:"kind,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User         *UserReference         `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	// state: opened, closed, reopened, merged, locked
+	// label, milestone: add, remove
+	// approval: approved, unapproved
+	// review: requested, request_removed, changes_requested
+	// draft: draft, ready
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// Only set for label events
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// Only set for milestone events
+	Milestone     *MilestoneReference `protobuf:"bytes,8,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeRequestEvent) Reset() {
+	*x = MergeRequestEvent{}
+	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeRequestEvent) ProtoMessage() {}
+
+func (x *MergeRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeRequestEvent.ProtoReflect.Descriptor instead.
+func (*MergeRequestEvent) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_merge_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MergeRequestEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeRequestEvent) GetMergeRequest() *MergeRequestReference {
+	if x != nil {
+		return x.MergeRequest
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetKind() MergeRequestEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *MergeRequestEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetUser() *UserReference {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MergeRequestEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MergeRequestEvent) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MergeRequestEvent) GetMilestone() *MilestoneReference {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
 type Milestone struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -899,7 +1069,7 @@ type Milestone struct {
 
 func (x *Milestone) Reset() {
 	*x = Milestone{}
-	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[8]
+	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -911,7 +1081,7 @@ func (x *Milestone) String() string {
 func (*Milestone) ProtoMessage() {}
 
 func (x *Milestone) ProtoReflect() protoreflect.Message {
-	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[8]
+	mi := &file_gitlabexporter_protobuf_merge_request_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1094,7 @@ func (x *Milestone) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Milestone.ProtoReflect.Descriptor instead.
 func (*Milestone) Descriptor() ([]byte, []int) {
-	return file_gitlabexporter_protobuf_merge_request_proto_rawDescGZIP(), []int{8}
+	return file_gitlabexporter_protobuf_merge_request_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Milestone) GetId() int64 {
@@ -1108,7 +1278,17 @@ const file_gitlabexporter_protobuf_merge_request_proto_rawDesc = "" +
 	"\x06author\x18\v \x01(\v2&.gitlabexporter.protobuf.UserReferenceR\x06author\x12 \n" +
 	"\vresolveable\x18\f \x01(\bR\vresolveable\x12\x1a\n" +
 	"\bresolved\x18\r \x01(\bR\bresolved\x12B\n" +
-	"\bresolver\x18\x0e \x01(\v2&.gitlabexporter.protobuf.UserReferenceR\bresolver\"\xae\x03\n" +
+	"\bresolver\x18\x0e \x01(\v2&.gitlabexporter.protobuf.UserReferenceR\bresolver\"\xac\x03\n" +
+	"\x11MergeRequestEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12S\n" +
+	"\rmerge_request\x18\x02 \x01(\v2..gitlabexporter.protobuf.MergeRequestReferenceR\fmergeRequest\x12B\n" +
+	"\x04kind\x18\x03 \x01(\x0e2..gitlabexporter.protobuf.MergeRequestEventKindR\x04kind\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12:\n" +
+	"\x04user\x18\x05 \x01(\v2&.gitlabexporter.protobuf.UserReferenceR\x04user\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x14\n" +
+	"\x05label\x18\a \x01(\tR\x05label\x12I\n" +
+	"\tmilestone\x18\b \x01(\v2+.gitlabexporter.protobuf.MilestoneReferenceR\tmilestone\"\xae\x03\n" +
 	"\tMilestone\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
 	"\x03iid\x18\x02 \x01(\x03R\x03iid\x12\x1d\n" +
@@ -1126,7 +1306,15 @@ const file_gitlabexporter_protobuf_merge_request_proto_rawDesc = "" +
 	"\x05state\x18\n" +
 	" \x01(\tR\x05state\x12\x18\n" +
 	"\aexpired\x18\v \x01(\bR\aexpired\x12\x17\n" +
-	"\aweb_url\x18\f \x01(\tR\x06webUrlB0Z.go.cluttr.dev/gitlab-exporter/protobuf/typespbb\x06proto3"
+	"\aweb_url\x18\f \x01(\tR\x06webUrl*\xa1\x02\n" +
+	"\x15MergeRequestEventKind\x12(\n" +
+	"$MERGE_REQUEST_EVENT_KIND_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eMERGE_REQUEST_EVENT_KIND_STATE\x10\x01\x12\"\n" +
+	"\x1eMERGE_REQUEST_EVENT_KIND_LABEL\x10\x02\x12&\n" +
+	"\"MERGE_REQUEST_EVENT_KIND_MILESTONE\x10\x03\x12%\n" +
+	"!MERGE_REQUEST_EVENT_KIND_APPROVAL\x10\x04\x12#\n" +
+	"\x1fMERGE_REQUEST_EVENT_KIND_REVIEW\x10\x05\x12\"\n" +
+	"\x1eMERGE_REQUEST_EVENT_KIND_DRAFT\x10\x06B0Z.go.cluttr.dev/gitlab-exporter/protobuf/typespbb\x06proto3"
 
 var (
 	file_gitlabexporter_protobuf_merge_request_proto_rawDescOnce sync.Once
@@ -1140,61 +1328,69 @@ func file_gitlabexporter_protobuf_merge_request_proto_rawDescGZIP() []byte {
 	return file_gitlabexporter_protobuf_merge_request_proto_rawDescData
 }
 
-var file_gitlabexporter_protobuf_merge_request_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_gitlabexporter_protobuf_merge_request_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_gitlabexporter_protobuf_merge_request_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_gitlabexporter_protobuf_merge_request_proto_goTypes = []any{
-	(*MergeRequest)(nil),             // 0: gitlabexporter.protobuf.MergeRequest
-	(*MergeRequestTimestamps)(nil),   // 1: gitlabexporter.protobuf.MergeRequestTimestamps
-	(*MergeRequestDiffStats)(nil),    // 2: gitlabexporter.protobuf.MergeRequestDiffStats
-	(*MergeRequestDiffRefs)(nil),     // 3: gitlabexporter.protobuf.MergeRequestDiffRefs
-	(*MergeRequestParticipants)(nil), // 4: gitlabexporter.protobuf.MergeRequestParticipants
-	(*MergeRequestFlags)(nil),        // 5: gitlabexporter.protobuf.MergeRequestFlags
-	(*MergeRequestCommit)(nil),       // 6: gitlabexporter.protobuf.MergeRequestCommit
-	(*MergeRequestNoteEvent)(nil),    // 7: gitlabexporter.protobuf.MergeRequestNoteEvent
-	(*Milestone)(nil),                // 8: gitlabexporter.protobuf.Milestone
-	(*ProjectReference)(nil),         // 9: gitlabexporter.protobuf.ProjectReference
-	(*MilestoneReference)(nil),       // 10: gitlabexporter.protobuf.MilestoneReference
-	(*timestamppb.Timestamp)(nil),    // 11: google.protobuf.Timestamp
-	(*UserReference)(nil),            // 12: gitlabexporter.protobuf.UserReference
-	(*MergeRequestReference)(nil),    // 13: gitlabexporter.protobuf.MergeRequestReference
-	(*CommitTrailer)(nil),            // 14: gitlabexporter.protobuf.CommitTrailer
+	(MergeRequestEventKind)(0),       // 0: gitlabexporter.protobuf.MergeRequestEventKind
+	(*MergeRequest)(nil),             // 1: gitlabexporter.protobuf.MergeRequest
+	(*MergeRequestTimestamps)(nil),   // 2: gitlabexporter.protobuf.MergeRequestTimestamps
+	(*MergeRequestDiffStats)(nil),    // 3: gitlabexporter.protobuf.MergeRequestDiffStats
+	(*MergeRequestDiffRefs)(nil),     // 4: gitlabexporter.protobuf.MergeRequestDiffRefs
+	(*MergeRequestParticipants)(nil), // 5: gitlabexporter.protobuf.MergeRequestParticipants
+	(*MergeRequestFlags)(nil),        // 6: gitlabexporter.protobuf.MergeRequestFlags
+	(*MergeRequestCommit)(nil),       // 7: gitlabexporter.protobuf.MergeRequestCommit
+	(*MergeRequestNoteEvent)(nil),    // 8: gitlabexporter.protobuf.MergeRequestNoteEvent
+	(*MergeRequestEvent)(nil),        // 9: gitlabexporter.protobuf.MergeRequestEvent
+	(*Milestone)(nil),                // 10: gitlabexporter.protobuf.Milestone
+	(*ProjectReference)(nil),         // 11: gitlabexporter.protobuf.ProjectReference
+	(*MilestoneReference)(nil),       // 12: gitlabexporter.protobuf.MilestoneReference
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+	(*UserReference)(nil),            // 14: gitlabexporter.protobuf.UserReference
+	(*MergeRequestReference)(nil),    // 15: gitlabexporter.protobuf.MergeRequestReference
+	(*CommitTrailer)(nil),            // 16: gitlabexporter.protobuf.CommitTrailer
 }
 var file_gitlabexporter_protobuf_merge_request_proto_depIdxs = []int32{
-	9,  // 0: gitlabexporter.protobuf.MergeRequest.project:type_name -> gitlabexporter.protobuf.ProjectReference
-	1,  // 1: gitlabexporter.protobuf.MergeRequest.timestamps:type_name -> gitlabexporter.protobuf.MergeRequestTimestamps
-	2,  // 2: gitlabexporter.protobuf.MergeRequest.diff_stats:type_name -> gitlabexporter.protobuf.MergeRequestDiffStats
-	3,  // 3: gitlabexporter.protobuf.MergeRequest.diff_refs:type_name -> gitlabexporter.protobuf.MergeRequestDiffRefs
-	4,  // 4: gitlabexporter.protobuf.MergeRequest.participants:type_name -> gitlabexporter.protobuf.MergeRequestParticipants
-	5,  // 5: gitlabexporter.protobuf.MergeRequest.flags:type_name -> gitlabexporter.protobuf.MergeRequestFlags
-	10, // 6: gitlabexporter.protobuf.MergeRequest.milestone:type_name -> gitlabexporter.protobuf.MilestoneReference
-	11, // 7: gitlabexporter.protobuf.MergeRequestTimestamps.created_at:type_name -> google.protobuf.Timestamp
-	11, // 8: gitlabexporter.protobuf.MergeRequestTimestamps.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 9: gitlabexporter.protobuf.MergeRequestTimestamps.merged_at:type_name -> google.protobuf.Timestamp
-	11, // 10: gitlabexporter.protobuf.MergeRequestTimestamps.closed_at:type_name -> google.protobuf.Timestamp
-	12, // 11: gitlabexporter.protobuf.MergeRequestParticipants.author:type_name -> gitlabexporter.protobuf.UserReference
-	12, // 12: gitlabexporter.protobuf.MergeRequestParticipants.assignees:type_name -> gitlabexporter.protobuf.UserReference
-	12, // 13: gitlabexporter.protobuf.MergeRequestParticipants.reviewers:type_name -> gitlabexporter.protobuf.UserReference
-	12, // 14: gitlabexporter.protobuf.MergeRequestParticipants.approvers:type_name -> gitlabexporter.protobuf.UserReference
-	12, // 15: gitlabexporter.protobuf.MergeRequestParticipants.merge_user:type_name -> gitlabexporter.protobuf.UserReference
-	13, // 16: gitlabexporter.protobuf.MergeRequestCommit.merge_request:type_name -> gitlabexporter.protobuf.MergeRequestReference
-	14, // 17: gitlabexporter.protobuf.MergeRequestCommit.trailers:type_name -> gitlabexporter.protobuf.CommitTrailer
-	12, // 18: gitlabexporter.protobuf.MergeRequestCommit.author:type_name -> gitlabexporter.protobuf.UserReference
-	11, // 19: gitlabexporter.protobuf.MergeRequestCommit.authored_date:type_name -> google.protobuf.Timestamp
-	11, // 20: gitlabexporter.protobuf.MergeRequestCommit.committed_date:type_name -> google.protobuf.Timestamp
-	13, // 21: gitlabexporter.protobuf.MergeRequestNoteEvent.merge_request:type_name -> gitlabexporter.protobuf.MergeRequestReference
-	11, // 22: gitlabexporter.protobuf.MergeRequestNoteEvent.created_at:type_name -> google.protobuf.Timestamp
-	11, // 23: gitlabexporter.protobuf.MergeRequestNoteEvent.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 24: gitlabexporter.protobuf.MergeRequestNoteEvent.resolved_at:type_name -> google.protobuf.Timestamp
-	12, // 25: gitlabexporter.protobuf.MergeRequestNoteEvent.author:type_name -> gitlabexporter.protobuf.UserReference
-	12, // 26: gitlabexporter.protobuf.MergeRequestNoteEvent.resolver:type_name -> gitlabexporter.protobuf.UserReference
-	11, // 27: gitlabexporter.protobuf.Milestone.created_at:type_name -> google.protobuf.Timestamp
-	11, // 28: gitlabexporter.protobuf.Milestone.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 29: gitlabexporter.protobuf.Milestone.start_date:type_name -> google.protobuf.Timestamp
-	11, // 30: gitlabexporter.protobuf.Milestone.due_date:type_name -> google.protobuf.Timestamp
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	11, // 0: gitlabexporter.protobuf.MergeRequest.project:type_name -> gitlabexporter.protobuf.ProjectReference
+	2,  // 1: gitlabexporter.protobuf.MergeRequest.timestamps:type_name -> gitlabexporter.protobuf.MergeRequestTimestamps
+	3,  // 2: gitlabexporter.protobuf.MergeRequest.diff_stats:type_name -> gitlabexporter.protobuf.MergeRequestDiffStats
+	4,  // 3: gitlabexporter.protobuf.MergeRequest.diff_refs:type_name -> gitlabexporter.protobuf.MergeRequestDiffRefs
+	5,  // 4: gitlabexporter.protobuf.MergeRequest.participants:type_name -> gitlabexporter.protobuf.MergeRequestParticipants
+	6,  // 5: gitlabexporter.protobuf.MergeRequest.flags:type_name -> gitlabexporter.protobuf.MergeRequestFlags
+	12, // 6: gitlabexporter.protobuf.MergeRequest.milestone:type_name -> gitlabexporter.protobuf.MilestoneReference
+	13, // 7: gitlabexporter.protobuf.MergeRequestTimestamps.created_at:type_name -> google.protobuf.Timestamp
+	13, // 8: gitlabexporter.protobuf.MergeRequestTimestamps.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 9: gitlabexporter.protobuf.MergeRequestTimestamps.merged_at:type_name -> google.protobuf.Timestamp
+	13, // 10: gitlabexporter.protobuf.MergeRequestTimestamps.closed_at:type_name -> google.protobuf.Timestamp
+	14, // 11: gitlabexporter.protobuf.MergeRequestParticipants.author:type_name -> gitlabexporter.protobuf.UserReference
+	14, // 12: gitlabexporter.protobuf.MergeRequestParticipants.assignees:type_name -> gitlabexporter.protobuf.UserReference
+	14, // 13: gitlabexporter.protobuf.MergeRequestParticipants.reviewers:type_name -> gitlabexporter.protobuf.UserReference
+	14, // 14: gitlabexporter.protobuf.MergeRequestParticipants.approvers:type_name -> gitlabexporter.protobuf.UserReference
+	14, // 15: gitlabexporter.protobuf.MergeRequestParticipants.merge_user:type_name -> gitlabexporter.protobuf.UserReference
+	15, // 16: gitlabexporter.protobuf.MergeRequestCommit.merge_request:type_name -> gitlabexporter.protobuf.MergeRequestReference
+	16, // 17: gitlabexporter.protobuf.MergeRequestCommit.trailers:type_name -> gitlabexporter.protobuf.CommitTrailer
+	14, // 18: gitlabexporter.protobuf.MergeRequestCommit.author:type_name -> gitlabexporter.protobuf.UserReference
+	13, // 19: gitlabexporter.protobuf.MergeRequestCommit.authored_date:type_name -> google.protobuf.Timestamp
+	13, // 20: gitlabexporter.protobuf.MergeRequestCommit.committed_date:type_name -> google.protobuf.Timestamp
+	15, // 21: gitlabexporter.protobuf.MergeRequestNoteEvent.merge_request:type_name -> gitlabexporter.protobuf.MergeRequestReference
+	13, // 22: gitlabexporter.protobuf.MergeRequestNoteEvent.created_at:type_name -> google.protobuf.Timestamp
+	13, // 23: gitlabexporter.protobuf.MergeRequestNoteEvent.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 24: gitlabexporter.protobuf.MergeRequestNoteEvent.resolved_at:type_name -> google.protobuf.Timestamp
+	14, // 25: gitlabexporter.protobuf.MergeRequestNoteEvent.author:type_name -> gitlabexporter.protobuf.UserReference
+	14, // 26: gitlabexporter.protobuf.MergeRequestNoteEvent.resolver:type_name -> gitlabexporter.protobuf.UserReference
+	15, // 27: gitlabexporter.protobuf.MergeRequestEvent.merge_request:type_name -> gitlabexporter.protobuf.MergeRequestReference
+	0,  // 28: gitlabexporter.protobuf.MergeRequestEvent.kind:type_name -> gitlabexporter.protobuf.MergeRequestEventKind
+	13, // 29: gitlabexporter.protobuf.MergeRequestEvent.created_at:type_name -> google.protobuf.Timestamp
+	14, // 30: gitlabexporter.protobuf.MergeRequestEvent.user:type_name -> gitlabexporter.protobuf.UserReference
+	12, // 31: gitlabexporter.protobuf.MergeRequestEvent.milestone:type_name -> gitlabexporter.protobuf.MilestoneReference
+	13, // 32: gitlabexporter.protobuf.Milestone.created_at:type_name -> google.protobuf.Timestamp
+	13, // 33: gitlabexporter.protobuf.Milestone.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 34: gitlabexporter.protobuf.Milestone.start_date:type_name -> google.protobuf.Timestamp
+	13, // 35: gitlabexporter.protobuf.Milestone.due_date:type_name -> google.protobuf.Timestamp
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_gitlabexporter_protobuf_merge_request_proto_init() }
@@ -1209,13 +1405,14 @@ func file_gitlabexporter_protobuf_merge_request_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_merge_request_proto_rawDesc), len(file_gitlabexporter_protobuf_merge_request_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_gitlabexporter_protobuf_merge_request_proto_goTypes,
 		DependencyIndexes: file_gitlabexporter_protobuf_merge_request_proto_depIdxs,
+		EnumInfos:         file_gitlabexporter_protobuf_merge_request_proto_enumTypes,
 		MessageInfos:      file_gitlabexporter_protobuf_merge_request_proto_msgTypes,
 	}.Build()
 	File_gitlabexporter_protobuf_merge_request_proto = out.File
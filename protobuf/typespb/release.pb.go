@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v6.30.2
+// source: gitlabexporter/protobuf/release.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tag struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Project   *ProjectReference      `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	CommitSha string                 `protobuf:"bytes,3,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	// The tag object for annotated tags, otherwise the commit
+	Target    string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Message   string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Protected bool   `protobuf:"varint,6,opt,name=protected,proto3" json:"protected,omitempty"`
+	// Only set for annotated tags
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CommittedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=committed_at,json=committedAt,proto3" json:"committed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_release_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tag) GetProject() *ProjectReference {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *Tag) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *Tag) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Tag) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Tag) GetProtected() bool {
+	if x != nil {
+		return x.Protected
+	}
+	return false
+}
+
+func (x *Tag) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Tag) GetCommittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommittedAt
+	}
+	return nil
+}
+
+type Release struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TagName         string                 `protobuf:"bytes,1,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	Project         *ProjectReference      `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReleasedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	UpcomingRelease bool                   `protobuf:"varint,7,opt,name=upcoming_release,json=upcomingRelease,proto3" json:"upcoming_release,omitempty"`
+	Author          *UserReference         `protobuf:"bytes,8,opt,name=author,proto3" json:"author,omitempty"`
+	CommitSha       string                 `protobuf:"bytes,9,opt,name=commit_sha,json=commitSha,proto3" json:"commit_sha,omitempty"`
+	Milestones      []*ReleaseMilestone    `protobuf:"bytes,10,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	Evidences       []*ReleaseEvidence     `protobuf:"bytes,11,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	// The latest pipeline that ran for the commit of the tag, if any
+	Pipeline      *PipelineReference `protobuf:"bytes,12,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_release_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Release) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *Release) GetProject() *ProjectReference {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *Release) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Release) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Release) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Release) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *Release) GetUpcomingRelease() bool {
+	if x != nil {
+		return x.UpcomingRelease
+	}
+	return false
+}
+
+func (x *Release) GetAuthor() *UserReference {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *Release) GetCommitSha() string {
+	if x != nil {
+		return x.CommitSha
+	}
+	return ""
+}
+
+func (x *Release) GetMilestones() []*ReleaseMilestone {
+	if x != nil {
+		return x.Milestones
+	}
+	return nil
+}
+
+func (x *Release) GetEvidences() []*ReleaseEvidence {
+	if x != nil {
+		return x.Evidences
+	}
+	return nil
+}
+
+func (x *Release) GetPipeline() *PipelineReference {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+type ReleaseMilestone struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Milestone     *MilestoneReference    `protobuf:"bytes,1,opt,name=milestone,proto3" json:"milestone,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseMilestone) Reset() {
+	*x = ReleaseMilestone{}
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseMilestone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseMilestone) ProtoMessage() {}
+
+func (x *ReleaseMilestone) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseMilestone.ProtoReflect.Descriptor instead.
+func (*ReleaseMilestone) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_release_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseMilestone) GetMilestone() *MilestoneReference {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
+func (x *ReleaseMilestone) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ReleaseMilestone) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ReleaseEvidence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sha           string                 `protobuf:"bytes,1,opt,name=sha,proto3" json:"sha,omitempty"`
+	Filepath      string                 `protobuf:"bytes,2,opt,name=filepath,proto3" json:"filepath,omitempty"`
+	CollectedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseEvidence) Reset() {
+	*x = ReleaseEvidence{}
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseEvidence) ProtoMessage() {}
+
+func (x *ReleaseEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_gitlabexporter_protobuf_release_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseEvidence.ProtoReflect.Descriptor instead.
+func (*ReleaseEvidence) Descriptor() ([]byte, []int) {
+	return file_gitlabexporter_protobuf_release_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleaseEvidence) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *ReleaseEvidence) GetFilepath() string {
+	if x != nil {
+		return x.Filepath
+	}
+	return ""
+}
+
+func (x *ReleaseEvidence) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+var File_gitlabexporter_protobuf_release_proto protoreflect.FileDescriptor
+
+const file_gitlabexporter_protobuf_release_proto_rawDesc = "" +
+	"\n" +
+	"%gitlabexporter/protobuf/release.proto\x12\x17gitlabexporter.protobuf\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(gitlabexporter/protobuf/references.proto\"\xc7\x02\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\aproject\x18\x02 \x01(\v2).gitlabexporter.protobuf.ProjectReferenceR\aproject\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\x03 \x01(\tR\tcommitSha\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1c\n" +
+	"\tprotected\x18\x06 \x01(\bR\tprotected\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcommitted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcommittedAt\"\xfc\x04\n" +
+	"\aRelease\x12\x19\n" +
+	"\btag_name\x18\x01 \x01(\tR\atagName\x12C\n" +
+	"\aproject\x18\x02 \x01(\v2).gitlabexporter.protobuf.ProjectReferenceR\aproject\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreleased_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\x12)\n" +
+	"\x10upcoming_release\x18\a \x01(\bR\x0fupcomingRelease\x12>\n" +
+	"\x06author\x18\b \x01(\v2&.gitlabexporter.protobuf.UserReferenceR\x06author\x12\x1d\n" +
+	"\n" +
+	"commit_sha\x18\t \x01(\tR\tcommitSha\x12I\n" +
+	"\n" +
+	"milestones\x18\n" +
+	" \x03(\v2).gitlabexporter.protobuf.ReleaseMilestoneR\n" +
+	"milestones\x12F\n" +
+	"\tevidences\x18\v \x03(\v2(.gitlabexporter.protobuf.ReleaseEvidenceR\tevidences\x12F\n" +
+	"\bpipeline\x18\f \x01(\v2*.gitlabexporter.protobuf.PipelineReferenceR\bpipeline\"\x89\x01\n" +
+	"\x10ReleaseMilestone\x12I\n" +
+	"\tmilestone\x18\x01 \x01(\v2+.gitlabexporter.protobuf.MilestoneReferenceR\tmilestone\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"~\n" +
+	"\x0fReleaseEvidence\x12\x10\n" +
+	"\x03sha\x18\x01 \x01(\tR\x03sha\x12\x1a\n" +
+	"\bfilepath\x18\x02 \x01(\tR\bfilepath\x12=\n" +
+	"\fcollected_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAtB0Z.go.cluttr.dev/gitlab-exporter/protobuf/typespbb\x06proto3"
+
+var (
+	file_gitlabexporter_protobuf_release_proto_rawDescOnce sync.Once
+	file_gitlabexporter_protobuf_release_proto_rawDescData []byte
+)
+
+func file_gitlabexporter_protobuf_release_proto_rawDescGZIP() []byte {
+	file_gitlabexporter_protobuf_release_proto_rawDescOnce.Do(func() {
+		file_gitlabexporter_protobuf_release_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_release_proto_rawDesc), len(file_gitlabexporter_protobuf_release_proto_rawDesc)))
+	})
+	return file_gitlabexporter_protobuf_release_proto_rawDescData
+}
+
+var file_gitlabexporter_protobuf_release_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_gitlabexporter_protobuf_release_proto_goTypes = []any{
+	(*Tag)(nil),                   // 0: gitlabexporter.protobuf.Tag
+	(*Release)(nil),               // 1: gitlabexporter.protobuf.Release
+	(*ReleaseMilestone)(nil),      // 2: gitlabexporter.protobuf.ReleaseMilestone
+	(*ReleaseEvidence)(nil),       // 3: gitlabexporter.protobuf.ReleaseEvidence
+	(*ProjectReference)(nil),      // 4: gitlabexporter.protobuf.ProjectReference
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*UserReference)(nil),         // 6: gitlabexporter.protobuf.UserReference
+	(*PipelineReference)(nil),     // 7: gitlabexporter.protobuf.PipelineReference
+	(*MilestoneReference)(nil),    // 8: gitlabexporter.protobuf.MilestoneReference
+}
+var file_gitlabexporter_protobuf_release_proto_depIdxs = []int32{
+	4,  // 0: gitlabexporter.protobuf.Tag.project:type_name -> gitlabexporter.protobuf.ProjectReference
+	5,  // 1: gitlabexporter.protobuf.Tag.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 2: gitlabexporter.protobuf.Tag.committed_at:type_name -> google.protobuf.Timestamp
+	4,  // 3: gitlabexporter.protobuf.Release.project:type_name -> gitlabexporter.protobuf.ProjectReference
+	5,  // 4: gitlabexporter.protobuf.Release.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: gitlabexporter.protobuf.Release.released_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: gitlabexporter.protobuf.Release.author:type_name -> gitlabexporter.protobuf.UserReference
+	2,  // 7: gitlabexporter.protobuf.Release.milestones:type_name -> gitlabexporter.protobuf.ReleaseMilestone
+	3,  // 8: gitlabexporter.protobuf.Release.evidences:type_name -> gitlabexporter.protobuf.ReleaseEvidence
+	7,  // 9: gitlabexporter.protobuf.Release.pipeline:type_name -> gitlabexporter.protobuf.PipelineReference
+	8,  // 10: gitlabexporter.protobuf.ReleaseMilestone.milestone:type_name -> gitlabexporter.protobuf.MilestoneReference
+	5,  // 11: gitlabexporter.protobuf.ReleaseEvidence.collected_at:type_name -> google.protobuf.Timestamp
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_gitlabexporter_protobuf_release_proto_init() }
+func file_gitlabexporter_protobuf_release_proto_init() {
+	if File_gitlabexporter_protobuf_release_proto != nil {
+		return
+	}
+	file_gitlabexporter_protobuf_references_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gitlabexporter_protobuf_release_proto_rawDesc), len(file_gitlabexporter_protobuf_release_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_gitlabexporter_protobuf_release_proto_goTypes,
+		DependencyIndexes: file_gitlabexporter_protobuf_release_proto_depIdxs,
+		MessageInfos:      file_gitlabexporter_protobuf_release_proto_msgTypes,
+	}.Build()
+	File_gitlabexporter_protobuf_release_proto = out.File
+	file_gitlabexporter_protobuf_release_proto_goTypes = nil
+	file_gitlabexporter_protobuf_release_proto_depIdxs = nil
+}
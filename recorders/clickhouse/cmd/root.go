@@ -97,6 +97,7 @@ func loadConfig(filename string, flags *flag.FlagSet, cfg *config.Config) error
 func writeConfig(out io.Writer, cfg config.Config) {
 	_cfg := cfg
 	_cfg.ClickHouse.Password = fmt.Sprintf("%x", sha256String(cfg.ClickHouse.Password))
+	_cfg.Server.Token = fmt.Sprintf("%x", sha256String(cfg.Server.Token))
 
 	b, err := json.MarshalIndent(_cfg, "", "  ")
 	if err != nil {
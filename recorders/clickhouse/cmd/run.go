@@ -124,7 +124,11 @@ func (c *RunConfig) Exec(ctx context.Context, args []string) error {
 	rec := recorder.New(client)
 
 	// create grpc server
-	grpcServer := server.New(rec)
+	serverOpts, err := serverOptions(cfg.Server)
+	if err != nil {
+		return fmt.Errorf("error configuring grpc server: %w", err)
+	}
+	grpcServer := server.New(rec, serverOpts...)
 
 	// setup run group
 	g := &run.Group{}
@@ -206,6 +210,31 @@ func (c *RunConfig) Exec(ctx context.Context, args []string) error {
 	return g.Run()
 }
 
+func serverOptions(cfg config.Server) ([]server.Option, error) {
+	var opts []server.Option
+
+	if cfg.TLS.Enabled {
+		creds, err := server.NewTLSCredentials(server.TLSConfig{
+			CertFile:     cfg.TLS.CertFile,
+			KeyFile:      cfg.TLS.KeyFile,
+			ClientCAFile: cfg.TLS.ClientCAFile,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		opts = append(opts, server.WithCredentials(creds))
+	}
+
+	if cfg.Token != "" {
+		if !cfg.TLS.Enabled {
+			return nil, fmt.Errorf("token requires tls to be enabled")
+		}
+		opts = append(opts, server.WithToken(cfg.Token))
+	}
+
+	return opts, nil
+}
+
 func (c *RunConfig) checkSchemaVersion(ctx context.Context, ch *clickhouse.Client) error {
 	schemaVersion, dirty, err := clickhouse.GetSchemaVersion(ch, ctx)
 	if err != nil {
@@ -0,0 +1,7 @@
+-- jobs
+ALTER TABLE jobs DROP COLUMN IF EXISTS failure_excerpt;
+ALTER TABLE jobs DROP COLUMN IF EXISTS failure_category;
+
+-- jobs_in
+DROP TABLE IF EXISTS jobs_in;
+CREATE TABLE IF NOT EXISTS jobs_in AS jobs ENGINE = Null;
@@ -0,0 +1,7 @@
+-- jobs
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS failure_category String AFTER failure_reason;
+ALTER TABLE jobs ADD COLUMN IF NOT EXISTS failure_excerpt String AFTER failure_category;
+
+-- jobs_in
+DROP TABLE IF EXISTS jobs_in;
+CREATE TABLE IF NOT EXISTS jobs_in AS jobs ENGINE = Null;
@@ -0,0 +1,8 @@
+-- environments_mv
+DROP TABLE IF EXISTS environments_mv;
+
+-- environments_in
+DROP TABLE IF EXISTS environments_in;
+
+-- environments
+DROP TABLE IF EXISTS environments;
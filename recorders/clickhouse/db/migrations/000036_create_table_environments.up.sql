@@ -0,0 +1,40 @@
+-- environments
+CREATE TABLE IF NOT EXISTS environments (
+    id Int64,
+    project_id Int64,
+
+    name String,
+    slug String,
+    description String,
+    external_url String,
+
+    state String,
+    tier String,
+
+    created_at Float64,
+    updated_at Float64,
+    auto_stop_at Float64,
+
+    last_deployment_id Int64,
+    last_deployment_iid Int64,
+    last_deployment_job_id Int64,
+    last_deployment_pipeline_id Int64,
+    last_deployment_status String,
+    last_deployment_ref String,
+    last_deployment_sha String,
+    last_deployment_finished_at Float64,
+)
+ENGINE ReplacingMergeTree(updated_at)
+ORDER BY (project_id, id)
+;
+
+-- environments_in
+CREATE TABLE IF NOT EXISTS environments_in AS environments ENGINE = Null;
+
+-- environments_mv
+-- Environments are exported as snapshots, so rows that are not older replace
+-- existing ones to pick up a new last deployment.
+CREATE MATERIALIZED VIEW IF NOT EXISTS environments_mv TO environments AS
+    SELECT environments_in.* FROM environments_in LEFT OUTER JOIN environments ON environments_in.id = environments.id
+    WHERE environments_in.updated_at >= environments.updated_at
+;
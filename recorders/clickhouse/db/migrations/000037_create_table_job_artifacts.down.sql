@@ -0,0 +1,11 @@
+-- project_job_artifacts_view
+DROP VIEW IF EXISTS project_job_artifacts_view;
+
+-- job_artifacts_mv
+DROP TABLE IF EXISTS job_artifacts_mv;
+
+-- job_artifacts_in
+DROP TABLE IF EXISTS job_artifacts_in;
+
+-- job_artifacts
+DROP TABLE IF EXISTS job_artifacts;
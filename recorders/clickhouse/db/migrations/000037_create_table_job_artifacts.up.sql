@@ -0,0 +1,56 @@
+-- job_artifacts
+CREATE TABLE IF NOT EXISTS job_artifacts (
+    id Int64,
+    job_id Int64,
+    job_name String,
+    pipeline_id Int64,
+    project_id Int64,
+
+    file_type String,
+    name String,
+    size Int64,
+
+    expire_at Float64,
+    locked Bool,
+
+    job_finished_at Float64,
+)
+ENGINE ReplacingMergeTree()
+ORDER BY (project_id, id)
+;
+
+-- job_artifacts_in
+CREATE TABLE IF NOT EXISTS job_artifacts_in AS job_artifacts ENGINE = Null;
+
+-- job_artifacts_mv
+-- Artifacts are recorded again when they expire or get locked, the latest
+-- row replaces the existing one.
+CREATE MATERIALIZED VIEW IF NOT EXISTS job_artifacts_mv TO job_artifacts AS
+    SELECT * FROM job_artifacts_in
+;
+
+-- project_job_artifacts_view
+-- Rolls up the recorded artifacts of each project by file type next to the
+-- artifacts size reported by the project statistics.
+CREATE VIEW IF NOT EXISTS project_job_artifacts_view AS
+    SELECT
+        a.project_id AS project_id,
+        a.file_type AS file_type,
+        a.artifacts_count AS artifacts_count,
+        a.artifacts_size AS artifacts_size,
+        a.locked_size AS locked_size,
+        p.job_artifacts_size AS project_job_artifacts_size
+    FROM (
+        SELECT
+            project_id,
+            file_type,
+            count() AS artifacts_count,
+            sum(size) AS artifacts_size,
+            sumIf(size, locked) AS locked_size
+        FROM job_artifacts FINAL
+        GROUP BY project_id, file_type
+    ) AS a
+    LEFT OUTER JOIN (
+        SELECT id, job_artifacts_size FROM projects FINAL
+    ) AS p ON p.id = a.project_id
+;
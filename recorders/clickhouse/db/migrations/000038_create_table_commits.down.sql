@@ -0,0 +1,8 @@
+-- commits_mv
+DROP TABLE IF EXISTS commits_mv;
+
+-- commits_in
+DROP TABLE IF EXISTS commits_in;
+
+-- commits
+DROP TABLE IF EXISTS commits;
@@ -0,0 +1,40 @@
+-- commits
+CREATE TABLE IF NOT EXISTS commits (
+    id String,
+    short_id String,
+    parent_ids Array(String),
+    project_id Int64,
+    ref String,
+
+    author_name String,
+    author_email String,
+    authored_date Float64,
+    committer_name String,
+    committer_email String,
+    committed_date Float64,
+    created_at Float64,
+
+    title String,
+    message String,
+    trailers Array(Tuple(key String, value String)),
+
+    stats_additions Int64,
+    stats_deletions Int64,
+    stats_total Int64,
+
+    status String,
+    web_url String,
+)
+ENGINE ReplacingMergeTree()
+ORDER BY (project_id, id)
+;
+
+-- commits_in
+CREATE TABLE IF NOT EXISTS commits_in AS commits ENGINE = Null;
+
+-- commits_mv
+-- Commits do not change, rows of commits that are fetched again replace the
+-- existing ones.
+CREATE MATERIALIZED VIEW IF NOT EXISTS commits_mv TO commits AS
+    SELECT * FROM commits_in
+;
@@ -0,0 +1,17 @@
+-- releases_mv
+DROP TABLE IF EXISTS releases_mv;
+
+-- releases_in
+DROP TABLE IF EXISTS releases_in;
+
+-- releases
+DROP TABLE IF EXISTS releases;
+
+-- tags_mv
+DROP TABLE IF EXISTS tags_mv;
+
+-- tags_in
+DROP TABLE IF EXISTS tags_in;
+
+-- tags
+DROP TABLE IF EXISTS tags;
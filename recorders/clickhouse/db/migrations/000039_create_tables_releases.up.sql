@@ -0,0 +1,64 @@
+-- tags
+CREATE TABLE IF NOT EXISTS tags (
+    project_id Int64,
+    name String,
+
+    commit_sha String,
+    target String,
+    message String,
+    protected Bool,
+
+    created_at Float64,
+    committed_at Float64,
+)
+ENGINE ReplacingMergeTree()
+ORDER BY (project_id, name)
+;
+
+-- tags_in
+CREATE TABLE IF NOT EXISTS tags_in AS tags ENGINE = Null;
+
+-- tags_mv
+-- Tags are recorded again on every export, the latest row replaces the
+-- existing one, e.g. when a tag was recreated for another commit.
+CREATE MATERIALIZED VIEW IF NOT EXISTS tags_mv TO tags AS
+    SELECT * FROM tags_in
+;
+
+-- releases
+CREATE TABLE IF NOT EXISTS releases (
+    project_id Int64,
+    tag_name String,
+
+    name String,
+    description String,
+
+    created_at Float64,
+    released_at Float64,
+    upcoming_release Bool,
+
+    author_id Int64,
+    author_username String,
+    commit_sha String,
+
+    milestone_ids Array(Int64),
+    milestone_iids Array(Int64),
+    milestone_titles Array(String),
+
+    evidence_shas Array(String),
+    evidence_collected_at Array(Float64),
+
+    pipeline_id Int64,
+    pipeline_iid Int64,
+)
+ENGINE ReplacingMergeTree()
+ORDER BY (project_id, tag_name)
+;
+
+-- releases_in
+CREATE TABLE IF NOT EXISTS releases_in AS releases ENGINE = Null;
+
+-- releases_mv
+CREATE MATERIALIZED VIEW IF NOT EXISTS releases_mv TO releases AS
+    SELECT * FROM releases_in
+;
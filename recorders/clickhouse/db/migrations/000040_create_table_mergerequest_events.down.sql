@@ -0,0 +1,8 @@
+-- mergerequest_events_mv
+DROP TABLE IF EXISTS mergerequest_events_mv;
+
+-- mergerequest_events_in
+DROP TABLE IF EXISTS mergerequest_events_in;
+
+-- mergerequest_events
+DROP TABLE IF EXISTS mergerequest_events;
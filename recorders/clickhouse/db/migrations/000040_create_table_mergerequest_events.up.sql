@@ -0,0 +1,32 @@
+-- mergerequest_events
+CREATE TABLE IF NOT EXISTS mergerequest_events (
+    kind String,
+    id Int64,
+    mergerequest_id Int64,
+    mergerequest_iid Int64,
+    mergerequest_project_id Int64,
+
+    created_at Float64,
+
+    user_id Int64,
+    user_username String,
+    user_name String,
+
+    action String,
+
+    label String,
+    milestone_id Int64,
+    milestone_iid Int64,
+)
+ENGINE ReplacingMergeTree()
+ORDER BY (mergerequest_project_id, mergerequest_id, kind, id)
+;
+
+-- mergerequest_events_in
+CREATE TABLE IF NOT EXISTS mergerequest_events_in AS mergerequest_events ENGINE = Null;
+
+-- mergerequest_events_mv
+-- Events do not change, recording them again only replaces identical rows.
+CREATE MATERIALIZED VIEW IF NOT EXISTS mergerequest_events_mv TO mergerequest_events AS
+    SELECT * FROM mergerequest_events_in
+;
@@ -0,0 +1,22 @@
+CREATE OR REPLACE VIEW project_job_artifacts_view AS
+    SELECT
+        a.project_id AS project_id,
+        a.file_type AS file_type,
+        a.artifacts_count AS artifacts_count,
+        a.artifacts_size AS artifacts_size,
+        a.locked_size AS locked_size,
+        p.job_artifacts_size AS project_job_artifacts_size
+    FROM (
+        SELECT
+            project_id,
+            file_type,
+            count() AS artifacts_count,
+            sum(size) AS artifacts_size,
+            sumIf(size, locked) AS locked_size
+        FROM job_artifacts FINAL
+        GROUP BY project_id, file_type
+    ) AS a
+    LEFT OUTER JOIN (
+        SELECT id, job_artifacts_size FROM projects FINAL
+    ) AS p ON p.id = a.project_id
+;
@@ -34,20 +34,26 @@ const (
 
 var tables = []string{
 	"bridges",
+	"commits",
 	"coverage_reports",
 	"coverage_packages",
 	"coverage_classes",
 	"coverage_methods",
 	"deployments",
+	"environments",
 	"issues",
+	"job_artifacts",
 	"jobs",
 	"mergerequest_commits",
+	"mergerequest_events",
 	"mergerequest_noteevents",
 	"mergerequests",
 	"metrics",
 	"pipelines",
 	"projects",
+	"releases",
 	"sections",
+	"tags",
 	"testcases",
 	"testreports",
 	"testsuites",
@@ -0,0 +1,64 @@
+package migrations_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func Test000041Up(t *testing.T) {
+	client := testClient(t)
+	ctx := t.Context()
+
+	// Prepare
+	if err := client.Migration.Migrate(40); err != nil {
+		t.Fatalf("failed to migrate to version 40: %v", err)
+	}
+
+	expired := time.Now().Add(-time.Hour).Unix()
+	unexpired := time.Now().Add(time.Hour).Unix()
+	query := fmt.Sprintf(`
+	INSERT INTO job_artifacts (id, job_id, pipeline_id, project_id, file_type, size, expire_at, locked) VALUES
+		(1, 1001, 1, 1, 'trace', 1, %[1]d, false),
+		(2, 1001, 1, 1, 'trace', 20, %[1]d, true),
+		(3, 1001, 1, 1, 'trace', 300, %[2]d, false),
+		(4, 1001, 1, 1, 'trace', 4000, 0, false)
+	`, expired, unexpired)
+	if err := client.Conn.Exec(ctx, query); err != nil {
+		t.Fatalf("failed to insert job_artifacts data: %v", err)
+	}
+
+	// Migrate
+	if err := client.Migration.Migrate(41); err != nil {
+		t.Fatalf("failed to migrate to version 41: %v", err)
+	}
+
+	// Check
+	type result struct {
+		ArtifactsCount uint64 `ch:"artifacts_count"`
+		ArtifactsSize  int64  `ch:"artifacts_size"`
+		LockedSize     int64  `ch:"locked_size"`
+	}
+
+	var results []result
+	query = "SELECT artifacts_count, artifacts_size, locked_size FROM project_job_artifacts_view WHERE project_id = 1 AND file_type = 'trace'"
+	if err := client.Conn.Select(ctx, &results, query); err != nil {
+		t.Fatalf("failed to query project_job_artifacts_view: %v", err)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("expected 1 rollup, got: %d", len(results))
+	}
+
+	// the expired artifact that is not locked is left out
+	r := results[0]
+	if r.ArtifactsCount != 3 {
+		t.Errorf("expected artifacts_count = 3, got: %d", r.ArtifactsCount)
+	}
+	if r.ArtifactsSize != 4320 {
+		t.Errorf("expected artifacts_size = 4320, got: %d", r.ArtifactsSize)
+	}
+	if r.LockedSize != 20 {
+		t.Errorf("expected locked_size = 20, got: %d", r.LockedSize)
+	}
+}
@@ -19,21 +19,27 @@ import (
 
 const (
 	BridgesTable                string = "bridges"
+	CommitsTable                string = "commits"
 	CoverageReportsTable        string = "coverage_reports"
 	CoveragePackagesTable       string = "coverage_packages"
 	CoverageClassesTable        string = "coverage_classes"
 	CoverageMethodsTable        string = "coverage_methods"
 	DeploymentsTable            string = "deployments"
+	EnvironmentsTable           string = "environments"
 	IssuesTable                 string = "issues"
 	JobsTable                   string = "jobs"
+	JobArtifactsTable           string = "job_artifacts"
 	MergeRequestCommitsTable    string = "mergerequest_commits"
+	MergeRequestEventsTable     string = "mergerequest_events"
 	MergeRequestNoteEventsTable string = "mergerequest_noteevents"
 	MergeRequestsTable          string = "mergerequests"
 	MetricsTable                string = "metrics"
 	PipelinesTable              string = "pipelines"
 	ProjectsTable               string = "projects"
+	ReleasesTable               string = "releases"
 	RunnersTable                string = "runners"
 	SectionsTable               string = "sections"
+	TagsTable                   string = "tags"
 	TestCasesTable              string = "testcases"
 	TestReportsTable            string = "testreports"
 	TestSuitesTable             string = "testsuites"
@@ -219,12 +225,14 @@ func InsertJobs(c *Client, ctx context.Context, jobs []*typespb.Job) (int, error
 			PipelineId: j.Pipeline.GetId(),
 			ProjectId:  j.Pipeline.GetProject().GetId(),
 
-			Name:          j.Name,
-			Ref:           j.Ref,
-			RefPath:       j.RefPath,
-			Status:        j.Status,
-			FailureReason: j.FailureReason,
-			ExitCode:      j.ExitCode,
+			Name:            j.Name,
+			Ref:             j.Ref,
+			RefPath:         j.RefPath,
+			Status:          j.Status,
+			FailureReason:   j.FailureReason,
+			FailureCategory: j.FailureCategory,
+			FailureExcerpt:  j.FailureExcerpt,
+			ExitCode:        j.ExitCode,
 
 			CreatedAt:  convertTimestamp(j.Timestamps.GetCreatedAt()),
 			QueuedAt:   convertTimestamp(j.Timestamps.GetQueuedAt()),
@@ -646,6 +654,65 @@ func InsertMergeRequests(c *Client, ctx context.Context, mrs []*typespb.MergeReq
 	return n, nil
 }
 
+func InsertCommits(c *Client, ctx context.Context, commits []*typespb.Commit) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": CommitsTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
+
+	for _, commit := range commits {
+		err = batch.AppendStruct(&Commit{
+			Id:        commit.GetId(),
+			ShortId:   commit.GetShortId(),
+			ParentIds: commit.GetParentIds(),
+			ProjectId: commit.GetProjectId(),
+			Ref:       commit.GetRef(),
+
+			AuthorName:     commit.GetAuthorName(),
+			AuthorEmail:    commit.GetAuthorEmail(),
+			AuthoredDate:   convertTimestamp(commit.GetAuthoredDate()),
+			CommitterName:  commit.GetCommitterName(),
+			CommitterEmail: commit.GetCommitterEmail(),
+			CommittedDate:  convertTimestamp(commit.GetCommittedDate()),
+			CreatedAt:      convertTimestamp(commit.GetCreatedAt()),
+
+			Title:    commit.GetTitle(),
+			Message:  commit.GetMessage(),
+			Trailers: convertCommitTrailers(commit.GetTrailers()),
+
+			StatsAdditions: commit.GetStats().GetAdditions(),
+			StatsDeletions: commit.GetStats().GetDeletions(),
+			StatsTotal:     commit.GetStats().GetTotal(),
+
+			Status: commit.GetStatus(),
+			WebUrl: commit.GetWebUrl(),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded commits", "received", len(commits), "inserted", n)
+
+	return n, nil
+}
+
 func InsertMergeRequestCommits(c *Client, ctx context.Context, commits []*typespb.MergeRequestCommit) (int, error) {
 	if c == nil {
 		return 0, errors.New("nil client")
@@ -718,6 +785,78 @@ func convertUserReferences(users []*typespb.UserReference) ([]int64, []string, [
 	return ids, usernames, names
 }
 
+func InsertMergeRequestEvents(c *Client, ctx context.Context, events []*typespb.MergeRequestEvent) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": MergeRequestEventsTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
+
+	for _, event := range events {
+		err = batch.AppendStruct(&MergeRequestEvent{
+			Kind:                  convertMergeRequestEventKind(event.GetKind()),
+			Id:                    event.GetId(),
+			MergeRequestId:        event.GetMergeRequest().GetId(),
+			MergeRequestIid:       event.GetMergeRequest().GetIid(),
+			MergeRequestProjectId: event.GetMergeRequest().GetProject().GetId(),
+
+			CreatedAt: convertTimestamp(event.GetCreatedAt()),
+
+			UserId:       event.GetUser().GetId(),
+			UserUsername: event.GetUser().GetUsername(),
+			UserName:     event.GetUser().GetName(),
+
+			Action: event.GetAction(),
+
+			Label:        event.GetLabel(),
+			MilestoneId:  event.GetMilestone().GetId(),
+			MilestoneIid: event.GetMilestone().GetIid(),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded mergerequest_events", "received", len(events), "inserted", n)
+
+	return n, nil
+}
+
+func convertMergeRequestEventKind(kind typespb.MergeRequestEventKind) string {
+	switch kind {
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_UNSPECIFIED:
+		return "unspecified"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_STATE:
+		return "state"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_LABEL:
+		return "label"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_MILESTONE:
+		return "milestone"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_APPROVAL:
+		return "approval"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_REVIEW:
+		return "review"
+	case typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_DRAFT:
+		return "draft"
+	}
+	return ""
+}
+
 func InsertMergeRequestNoteEvents(c *Client, ctx context.Context, mres []*typespb.MergeRequestNoteEvent) (int, error) {
 	if c == nil {
 		return 0, errors.New("nil client")
@@ -1156,14 +1295,14 @@ func InsertCoverageMethods(c *Client, ctx context.Context, mtds []*typespb.Cover
 	return n, nil
 }
 
-func InsertDeployments(c *Client, ctx context.Context, deployments []*typespb.Deployment) (int, error) {
+func InsertJobArtifacts(c *Client, ctx context.Context, artifacts []*typespb.JobArtifact) (int, error) {
 	if c == nil {
 		return 0, errors.New("nil client")
 	}
 	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
 	var params = map[string]string{
 		"db":    c.dbName,
-		"table": DeploymentsTable + "_in",
+		"table": JobArtifactsTable + "_in",
 	}
 
 	ctx = WithParameters(ctx, params)
@@ -1173,50 +1312,183 @@ func InsertDeployments(c *Client, ctx context.Context, deployments []*typespb.De
 		return 0, fmt.Errorf("prepare batch: %w", err)
 	}
 
-	for _, deployment := range deployments {
-		var environmentTier string
-		switch deployment.GetEnvironment().GetTier() {
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_UNSPECIFIED:
-			environmentTier = "unspecified"
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_PRODUCTION:
-			environmentTier = "production"
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_STAGING:
-			environmentTier = "staging"
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_TESTING:
-			environmentTier = "testing"
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_DEVELOPMENT:
-			environmentTier = "development"
-		case typespb.DeploymentTier_DEPLOYMENT_TIER_OTHER:
-			environmentTier = "other"
+	for _, artifact := range artifacts {
+		err = batch.AppendStruct(&JobArtifact{
+			Id:         artifact.Id,
+			JobId:      artifact.GetJob().GetId(),
+			JobName:    artifact.GetJob().GetName(),
+			PipelineId: artifact.GetJob().GetPipeline().GetId(),
+			ProjectId:  artifact.GetJob().GetPipeline().GetProject().GetId(),
+
+			FileType: artifact.FileType,
+			Name:     artifact.Name,
+			Size:     artifact.Size,
+
+			ExpireAt: convertTimestamp(artifact.ExpireAt),
+			Locked:   artifact.Locked,
+
+			JobFinishedAt: convertTimestamp(artifact.JobFinishedAt),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
 		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded job artifacts", "received", len(artifacts))
+
+	return n, nil
+}
+
+func InsertTags(c *Client, ctx context.Context, tags []*typespb.Tag) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": TagsTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
 
-		var deploymentStatus string
-		switch deployment.Status {
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED:
-			deploymentStatus = "unspecified"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_CREATED:
-			deploymentStatus = "created"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_RUNNING:
-			deploymentStatus = "running"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS:
-			deploymentStatus = "success"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_FAILED:
-			deploymentStatus = "failed"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_CANCELED:
-			deploymentStatus = "canceled"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SKIPPED:
-			deploymentStatus = "skipped"
-		case typespb.DeploymentStatus_DEPLOYMENT_STATUS_BLOCKED:
-			deploymentStatus = "blocked"
+	for _, tag := range tags {
+		err = batch.AppendStruct(&Tag{
+			ProjectId: tag.GetProject().GetId(),
+			Name:      tag.GetName(),
+
+			CommitSha: tag.GetCommitSha(),
+			Target:    tag.GetTarget(),
+			Message:   tag.GetMessage(),
+			Protected: tag.GetProtected(),
+
+			CreatedAt:   convertTimestamp(tag.GetCreatedAt()),
+			CommittedAt: convertTimestamp(tag.GetCommittedAt()),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded tags", "received", len(tags), "inserted", n)
+
+	return n, nil
+}
+
+func InsertReleases(c *Client, ctx context.Context, releases []*typespb.Release) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": ReleasesTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
+
+	for _, release := range releases {
+		var (
+			milestoneIds        = make([]int64, 0, len(release.GetMilestones()))
+			milestoneIids       = make([]int64, 0, len(release.GetMilestones()))
+			milestoneTitles     = make([]string, 0, len(release.GetMilestones()))
+			evidenceShas        = make([]string, 0, len(release.GetEvidences()))
+			evidenceCollectedAt = make([]float64, 0, len(release.GetEvidences()))
+		)
+		for _, m := range release.GetMilestones() {
+			milestoneIds = append(milestoneIds, m.GetMilestone().GetId())
+			milestoneIids = append(milestoneIids, m.GetMilestone().GetIid())
+			milestoneTitles = append(milestoneTitles, m.GetTitle())
+		}
+		for _, e := range release.GetEvidences() {
+			evidenceShas = append(evidenceShas, e.GetSha())
+			evidenceCollectedAt = append(evidenceCollectedAt, convertTimestamp(e.GetCollectedAt()))
+		}
+
+		err = batch.AppendStruct(&Release{
+			ProjectId: release.GetProject().GetId(),
+			TagName:   release.GetTagName(),
+
+			Name:        release.GetName(),
+			Description: release.GetDescription(),
+
+			CreatedAt:       convertTimestamp(release.GetCreatedAt()),
+			ReleasedAt:      convertTimestamp(release.GetReleasedAt()),
+			UpcomingRelease: release.GetUpcomingRelease(),
+
+			AuthorId:       release.GetAuthor().GetId(),
+			AuthorUsername: release.GetAuthor().GetUsername(),
+			CommitSha:      release.GetCommitSha(),
+
+			MilestoneIds:    milestoneIds,
+			MilestoneIids:   milestoneIids,
+			MilestoneTitles: milestoneTitles,
+
+			EvidenceShas:        evidenceShas,
+			EvidenceCollectedAt: evidenceCollectedAt,
+
+			PipelineId:  release.GetPipeline().GetId(),
+			PipelineIid: release.GetPipeline().GetIid(),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
 		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded releases", "received", len(releases), "inserted", n)
 
+	return n, nil
+}
+
+func InsertDeployments(c *Client, ctx context.Context, deployments []*typespb.Deployment) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": DeploymentsTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
+
+	for _, deployment := range deployments {
 		err = batch.AppendStruct(&Deployment{
 			Id:  deployment.Id,
 			Iid: deployment.Iid,
 
 			EnvironmentId:   deployment.GetEnvironment().GetId(),
 			EnvironmentName: deployment.GetEnvironment().GetName(),
-			EnvironmentTier: environmentTier,
+			EnvironmentTier: convertDeploymentTier(deployment.GetEnvironment().GetTier()),
 
 			ProjectId: deployment.GetEnvironment().GetProject().GetId(),
 
@@ -1231,7 +1503,7 @@ func InsertDeployments(c *Client, ctx context.Context, deployments []*typespb.De
 			FinishedAt: convertTimestamp(deployment.Timestamps.GetFinishedAt()),
 			UpdatedAt:  convertTimestamp(deployment.Timestamps.GetUpdatedAt()),
 
-			Status: deploymentStatus,
+			Status: convertDeploymentStatus(deployment.Status),
 			Ref:    deployment.Ref,
 			Sha:    deployment.Sha,
 		})
@@ -1250,6 +1522,121 @@ func InsertDeployments(c *Client, ctx context.Context, deployments []*typespb.De
 	return n, nil
 }
 
+func convertDeploymentTier(tier typespb.DeploymentTier) string {
+	switch tier {
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_UNSPECIFIED:
+		return "unspecified"
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_PRODUCTION:
+		return "production"
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_STAGING:
+		return "staging"
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_TESTING:
+		return "testing"
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_DEVELOPMENT:
+		return "development"
+	case typespb.DeploymentTier_DEPLOYMENT_TIER_OTHER:
+		return "other"
+	}
+	return ""
+}
+
+func convertDeploymentStatus(status typespb.DeploymentStatus) string {
+	switch status {
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED:
+		return "unspecified"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_CREATED:
+		return "created"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_RUNNING:
+		return "running"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS:
+		return "success"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_FAILED:
+		return "failed"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_CANCELED:
+		return "canceled"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SKIPPED:
+		return "skipped"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_BLOCKED:
+		return "blocked"
+	}
+	return ""
+}
+
+func InsertEnvironments(c *Client, ctx context.Context, environments []*typespb.Environment) (int, error) {
+	if c == nil {
+		return 0, errors.New("nil client")
+	}
+	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
+	var params = map[string]string{
+		"db":    c.dbName,
+		"table": EnvironmentsTable + "_in",
+	}
+
+	ctx = WithParameters(ctx, params)
+
+	batch, err := c.PrepareBatch(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("prepare batch: %w", err)
+	}
+
+	for _, environment := range environments {
+		var environmentState string
+		switch environment.State {
+		case typespb.EnvironmentState_ENVIRONMENT_STATE_UNSPECIFIED:
+			environmentState = "unspecified"
+		case typespb.EnvironmentState_ENVIRONMENT_STATE_AVAILABLE:
+			environmentState = "available"
+		case typespb.EnvironmentState_ENVIRONMENT_STATE_STOPPING:
+			environmentState = "stopping"
+		case typespb.EnvironmentState_ENVIRONMENT_STATE_STOPPED:
+			environmentState = "stopped"
+		}
+
+		var lastDeploymentStatus string
+		if d := environment.GetLastDeployment(); d != nil {
+			lastDeploymentStatus = convertDeploymentStatus(d.Status)
+		}
+
+		err = batch.AppendStruct(&Environment{
+			Id:        environment.Id,
+			ProjectId: environment.GetProject().GetId(),
+
+			Name:        environment.Name,
+			Slug:        environment.Slug,
+			Description: environment.Description,
+			ExternalUrl: environment.ExternalUrl,
+
+			State: environmentState,
+			Tier:  convertDeploymentTier(environment.Tier),
+
+			CreatedAt:  convertTimestamp(environment.GetTimestamps().GetCreatedAt()),
+			UpdatedAt:  convertTimestamp(environment.GetTimestamps().GetUpdatedAt()),
+			AutoStopAt: convertTimestamp(environment.GetTimestamps().GetAutoStopAt()),
+
+			LastDeploymentId:         environment.GetLastDeployment().GetId(),
+			LastDeploymentIid:        environment.GetLastDeployment().GetIid(),
+			LastDeploymentJobId:      environment.GetLastDeployment().GetJob().GetId(),
+			LastDeploymentPipelineId: environment.GetLastDeployment().GetJob().GetPipeline().GetId(),
+			LastDeploymentStatus:     lastDeploymentStatus,
+			LastDeploymentRef:        environment.GetLastDeployment().GetRef(),
+			LastDeploymentSha:        environment.GetLastDeployment().GetSha(),
+			LastDeploymentFinishedAt: convertTimestamp(environment.GetLastDeployment().GetTimestamps().GetFinishedAt()),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("append batch: %w", err)
+		}
+	}
+
+	if err := batch.Send(); err != nil {
+		return -1, fmt.Errorf("send batch: %w", err)
+	}
+
+	n := batch.Rows()
+	slog.Debug("Recorded environments", "received", len(environments))
+
+	return n, nil
+}
+
 func InsertTraces(c *Client, ctx context.Context, traces []*typespb.Trace) (int, error) {
 	const query string = `INSERT INTO {db:Identifier}.{table:Identifier} SETTINGS async_insert=1`
 	var params = map[string]string{
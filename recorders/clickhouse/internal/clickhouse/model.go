@@ -108,12 +108,14 @@ type Job struct {
 	PipelineId int64 `ch:"pipeline_id"`
 	ProjectId  int64 `ch:"project_id"`
 
-	Name          string `ch:"name"`
-	Ref           string `ch:"ref"`
-	RefPath       string `ch:"ref_path"`
-	Status        string `ch:"status"`
-	FailureReason string `ch:"failure_reason"`
-	ExitCode      int64  `ch:"exit_code"`
+	Name            string `ch:"name"`
+	Ref             string `ch:"ref"`
+	RefPath         string `ch:"ref_path"`
+	Status          string `ch:"status"`
+	FailureReason   string `ch:"failure_reason"`
+	FailureCategory string `ch:"failure_category"`
+	FailureExcerpt  string `ch:"failure_excerpt"`
+	ExitCode        int64  `ch:"exit_code"`
 
 	CreatedAt  float64 `ch:"created_at"`
 	QueuedAt   float64 `ch:"queued_at"`
@@ -294,6 +296,33 @@ type MergeRequest struct {
 	MilestoneProjectId int64 `ch:"milestone_project_id"`
 }
 
+type Commit struct {
+	Id        string   `ch:"id"`
+	ShortId   string   `ch:"short_id"`
+	ParentIds []string `ch:"parent_ids"`
+	ProjectId int64    `ch:"project_id"`
+	Ref       string   `ch:"ref"`
+
+	AuthorName     string  `ch:"author_name"`
+	AuthorEmail    string  `ch:"author_email"`
+	AuthoredDate   float64 `ch:"authored_date"`
+	CommitterName  string  `ch:"committer_name"`
+	CommitterEmail string  `ch:"committer_email"`
+	CommittedDate  float64 `ch:"committed_date"`
+	CreatedAt      float64 `ch:"created_at"`
+
+	Title    string     `ch:"title"`
+	Message  string     `ch:"message"`
+	Trailers [][]string `ch:"trailers"`
+
+	StatsAdditions int64 `ch:"stats_additions"`
+	StatsDeletions int64 `ch:"stats_deletions"`
+	StatsTotal     int64 `ch:"stats_total"`
+
+	Status string `ch:"status"`
+	WebUrl string `ch:"web_url"`
+}
+
 type MergeRequestCommit struct {
 	Id              string `ch:"id"`
 	MergeRequestId  int64  `ch:"mergerequest_id"`
@@ -318,6 +347,26 @@ type MergeRequestCommit struct {
 	CommitterEmail string `ch:"committer_email"`
 }
 
+type MergeRequestEvent struct {
+	Kind                  string `ch:"kind"`
+	Id                    int64  `ch:"id"`
+	MergeRequestId        int64  `ch:"mergerequest_id"`
+	MergeRequestIid       int64  `ch:"mergerequest_iid"`
+	MergeRequestProjectId int64  `ch:"mergerequest_project_id"`
+
+	CreatedAt float64 `ch:"created_at"`
+
+	UserId       int64  `ch:"user_id"`
+	UserUsername string `ch:"user_username"`
+	UserName     string `ch:"user_name"`
+
+	Action string `ch:"action"`
+
+	Label        string `ch:"label"`
+	MilestoneId  int64  `ch:"milestone_id"`
+	MilestoneIid int64  `ch:"milestone_iid"`
+}
+
 type MergeRequestNoteEvent struct {
 	Id                    int64 `ch:"id"`
 	MergeRequestId        int64 `ch:"mergerequest_id"`
@@ -415,6 +464,62 @@ type CoverageMethod struct {
 	Complexity float32 `ch:"complexity"`
 }
 
+type JobArtifact struct {
+	Id         int64  `ch:"id"`
+	JobId      int64  `ch:"job_id"`
+	JobName    string `ch:"job_name"`
+	PipelineId int64  `ch:"pipeline_id"`
+	ProjectId  int64  `ch:"project_id"`
+
+	FileType string `ch:"file_type"`
+	Name     string `ch:"name"`
+	Size     int64  `ch:"size"`
+
+	ExpireAt float64 `ch:"expire_at"`
+	Locked   bool    `ch:"locked"`
+
+	JobFinishedAt float64 `ch:"job_finished_at"`
+}
+
+type Tag struct {
+	ProjectId int64  `ch:"project_id"`
+	Name      string `ch:"name"`
+
+	CommitSha string `ch:"commit_sha"`
+	Target    string `ch:"target"`
+	Message   string `ch:"message"`
+	Protected bool   `ch:"protected"`
+
+	CreatedAt   float64 `ch:"created_at"`
+	CommittedAt float64 `ch:"committed_at"`
+}
+
+type Release struct {
+	ProjectId int64  `ch:"project_id"`
+	TagName   string `ch:"tag_name"`
+
+	Name        string `ch:"name"`
+	Description string `ch:"description"`
+
+	CreatedAt       float64 `ch:"created_at"`
+	ReleasedAt      float64 `ch:"released_at"`
+	UpcomingRelease bool    `ch:"upcoming_release"`
+
+	AuthorId       int64  `ch:"author_id"`
+	AuthorUsername string `ch:"author_username"`
+	CommitSha      string `ch:"commit_sha"`
+
+	MilestoneIds    []int64  `ch:"milestone_ids"`
+	MilestoneIids   []int64  `ch:"milestone_iids"`
+	MilestoneTitles []string `ch:"milestone_titles"`
+
+	EvidenceShas        []string  `ch:"evidence_shas"`
+	EvidenceCollectedAt []float64 `ch:"evidence_collected_at"`
+
+	PipelineId  int64 `ch:"pipeline_id"`
+	PipelineIid int64 `ch:"pipeline_iid"`
+}
+
 type Deployment struct {
 	Id  int64 `ch:"id"`
 	Iid int64 `ch:"iid"`
@@ -441,6 +546,32 @@ type Deployment struct {
 	Sha    string `ch:"sha"`
 }
 
+type Environment struct {
+	Id        int64 `ch:"id"`
+	ProjectId int64 `ch:"project_id"`
+
+	Name        string `ch:"name"`
+	Slug        string `ch:"slug"`
+	Description string `ch:"description"`
+	ExternalUrl string `ch:"external_url"`
+
+	State string `ch:"state"`
+	Tier  string `ch:"tier"`
+
+	CreatedAt  float64 `ch:"created_at"`
+	UpdatedAt  float64 `ch:"updated_at"`
+	AutoStopAt float64 `ch:"auto_stop_at"`
+
+	LastDeploymentId         int64   `ch:"last_deployment_id"`
+	LastDeploymentIid        int64   `ch:"last_deployment_iid"`
+	LastDeploymentJobId      int64   `ch:"last_deployment_job_id"`
+	LastDeploymentPipelineId int64   `ch:"last_deployment_pipeline_id"`
+	LastDeploymentStatus     string  `ch:"last_deployment_status"`
+	LastDeploymentRef        string  `ch:"last_deployment_ref"`
+	LastDeploymentSha        string  `ch:"last_deployment_sha"`
+	LastDeploymentFinishedAt float64 `ch:"last_deployment_finished_at"`
+}
+
 type Runner struct {
 	Id          int64  `ch:"id"`
 	ShortSha    string `ch:"short_sha"`
@@ -28,6 +28,16 @@ type ClickHouseClient struct {
 type Server struct {
 	Host string `default:"0.0.0.0" yaml:"host"`
 	Port string `default:"0" yaml:"port"`
+
+	TLS   ServerTLS `default:"{}" yaml:"tls"`
+	Token string    `default:"" yaml:"token"`
+}
+
+type ServerTLS struct {
+	Enabled      bool   `default:"false" yaml:"enabled"`
+	CertFile     string `default:"" yaml:"cert_file"`
+	KeyFile      string `default:"" yaml:"key_file"`
+	ClientCAFile string `default:"" yaml:"client_ca_file"`
 }
 
 type HTTP struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
 	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
 
@@ -87,14 +88,26 @@ func (s *ClickHouseRecorder) RecordTestCases(ctx context.Context, r *servicepb.R
 	return record[typespb.TestCase](s, ctx, r.Data, clickhouse.InsertTestCases)
 }
 
+func (s *ClickHouseRecorder) RecordJobArtifacts(ctx context.Context, r *servicepb.RecordJobArtifactsRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.JobArtifact](s, ctx, r.Data, clickhouse.InsertJobArtifacts)
+}
+
 func (s *ClickHouseRecorder) RecordMergeRequests(ctx context.Context, r *servicepb.RecordMergeRequestsRequest) (*servicepb.RecordSummary, error) {
 	return record[typespb.MergeRequest](s, ctx, r.Data, clickhouse.InsertMergeRequests)
 }
 
+func (s *ClickHouseRecorder) RecordCommits(ctx context.Context, r *servicepb.RecordCommitsRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.Commit](s, ctx, r.Data, clickhouse.InsertCommits)
+}
+
 func (s *ClickHouseRecorder) RecordMergeRequestCommits(ctx context.Context, r *servicepb.RecordMergeRequestCommitsRequest) (*servicepb.RecordSummary, error) {
 	return record[typespb.MergeRequestCommit](s, ctx, r.Data, clickhouse.InsertMergeRequestCommits)
 }
 
+func (s *ClickHouseRecorder) RecordMergeRequestEvents(ctx context.Context, r *servicepb.RecordMergeRequestEventsRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.MergeRequestEvent](s, ctx, r.Data, clickhouse.InsertMergeRequestEvents)
+}
+
 func (s *ClickHouseRecorder) RecordMergeRequestNoteEvents(ctx context.Context, r *servicepb.RecordMergeRequestNoteEventsRequest) (*servicepb.RecordSummary, error) {
 	return record[typespb.MergeRequestNoteEvent](s, ctx, r.Data, clickhouse.InsertMergeRequestNoteEvents)
 }
@@ -123,6 +136,10 @@ func (s *ClickHouseRecorder) RecordDeployments(ctx context.Context, r *servicepb
 	return record[typespb.Deployment](s, ctx, r.Data, clickhouse.InsertDeployments)
 }
 
+func (s *ClickHouseRecorder) RecordEnvironments(ctx context.Context, r *servicepb.RecordEnvironmentsRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.Environment](s, ctx, r.Data, clickhouse.InsertEnvironments)
+}
+
 func (s *ClickHouseRecorder) RecordIssues(ctx context.Context, r *servicepb.RecordIssuesRequest) (*servicepb.RecordSummary, error) {
 	return record[typespb.Issue](s, ctx, r.Data, clickhouse.InsertIssues)
 }
@@ -150,3 +167,49 @@ func (s *ClickHouseRecorder) RecordRunners(ctx context.Context, r *servicepb.Rec
 		RecordedCount: int32(n),
 	}, nil
 }
+
+func (s *ClickHouseRecorder) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(s, stream)
+}
+
+func (s *ClickHouseRecorder) RecordTags(ctx context.Context, r *servicepb.RecordTagsRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.Tag](s, ctx, r.Data, clickhouse.InsertTags)
+}
+
+func (s *ClickHouseRecorder) RecordReleases(ctx context.Context, r *servicepb.RecordReleasesRequest) (*servicepb.RecordSummary, error) {
+	return record[typespb.Release](s, ctx, r.Data, clickhouse.InsertReleases)
+}
+
+func (s *ClickHouseRecorder) GetCapabilities(ctx context.Context, r *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	return &servicepb.Capabilities{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+		RecordKinds: []servicepb.RecordKind{
+			servicepb.RecordKind_RECORD_KIND_COMMITS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_PACKAGES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_CLASSES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_METHODS,
+			servicepb.RecordKind_RECORD_KIND_DEPLOYMENTS,
+			servicepb.RecordKind_RECORD_KIND_ENVIRONMENTS,
+			servicepb.RecordKind_RECORD_KIND_ISSUES,
+			servicepb.RecordKind_RECORD_KIND_JOBS,
+			servicepb.RecordKind_RECORD_KIND_JOB_ARTIFACTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUESTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_COMMITS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_METRICS,
+			servicepb.RecordKind_RECORD_KIND_PIPELINES,
+			servicepb.RecordKind_RECORD_KIND_PROJECTS,
+			servicepb.RecordKind_RECORD_KIND_RELEASES,
+			servicepb.RecordKind_RECORD_KIND_RUNNERS,
+			servicepb.RecordKind_RECORD_KIND_SECTIONS,
+			servicepb.RecordKind_RECORD_KIND_TAGS,
+			servicepb.RecordKind_RECORD_KIND_TEST_CASES,
+			servicepb.RecordKind_RECORD_KIND_TEST_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_TEST_SUITES,
+			servicepb.RecordKind_RECORD_KIND_TRACES,
+		},
+		RecordStream: true,
+	}, nil
+}
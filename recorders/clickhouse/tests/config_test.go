@@ -141,3 +141,31 @@ func TestLoad_DataWithDefaults(t *testing.T) {
 
 	checkConfig(t, expected, cfg)
 }
+
+func TestLoad_WithServerTLS(t *testing.T) {
+	data := []byte(`
+    server:
+      tls:
+        enabled: true
+        cert_file: /etc/recorder/server.pem
+        key_file: /etc/recorder/server-key.pem
+        client_ca_file: /etc/recorder/ca.pem
+      token: s3cr3t
+    `)
+
+	expected := defaultConfig()
+	expected.Server.TLS = config.ServerTLS{
+		Enabled:      true,
+		CertFile:     "/etc/recorder/server.pem",
+		KeyFile:      "/etc/recorder/server-key.pem",
+		ClientCAFile: "/etc/recorder/ca.pem",
+	}
+	expected.Server.Token = "s3cr3t"
+
+	cfg := defaultConfig()
+	if err := config.Load(data, &cfg); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+
+	checkConfig(t, expected, cfg)
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+)
+
+// updatedAtColumn is the column that decides whether a recorded row replaces
+// an existing one.
+const updatedAtColumn = "updated_at"
+
+// tableColumns holds the columns of a model as given by its db tags.
+type tableColumns struct {
+	names []string
+	keys  []string
+}
+
+// columnsOf returns the columns of the model struct type.
+func columnsOf(t reflect.Type) tableColumns {
+	var cols tableColumns
+	for _, field := range reflect.VisibleFields(t) {
+		if !field.IsExported() {
+			continue
+		}
+		tag, ok := field.Tag.Lookup("db")
+		if !ok {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		cols.names = append(cols.names, name)
+		if opts == "key" {
+			cols.keys = append(cols.keys, name)
+		}
+	}
+	return cols
+}
+
+// values returns the values of the model struct in column order.
+func values(s any) []any {
+	v := reflect.Indirect(reflect.ValueOf(s))
+
+	var vals []any
+	for _, field := range reflect.VisibleFields(v.Type()) {
+		if !field.IsExported() {
+			continue
+		}
+		if _, ok := field.Tag.Lookup("db"); !ok {
+			continue
+		}
+		vals = append(vals, v.FieldByIndex(field.Index).Interface())
+	}
+	return vals
+}
+
+// upsertQuery returns the statement that moves the rows from the staging
+// table into the target table. Rows with the same key are collapsed into the
+// most recently updated one, and existing rows are only replaced by rows that
+// are not older.
+func upsertQuery(table string, staging string, cols tableColumns) string {
+	names := strings.Join(cols.names, ", ")
+	keys := strings.Join(cols.keys, ", ")
+
+	var sets []string
+	for _, name := range cols.names {
+		if !slices.Contains(cols.keys, name) {
+			sets = append(sets, fmt.Sprintf("%s = EXCLUDED.%s", name, name))
+		}
+	}
+
+	order := keys
+	if slices.Contains(cols.names, updatedAtColumn) {
+		order += ", " + updatedAtColumn + " DESC NULLS LAST"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "INSERT INTO %s AS t (%s) ", table, names)
+	fmt.Fprintf(&b, "SELECT DISTINCT ON (%s) %s FROM %s ORDER BY %s ", keys, names, staging, order)
+	if len(sets) == 0 {
+		fmt.Fprintf(&b, "ON CONFLICT (%s) DO NOTHING", keys)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "ON CONFLICT (%s) DO UPDATE SET %s", keys, strings.Join(sets, ", "))
+	if slices.Contains(cols.names, updatedAtColumn) {
+		fmt.Fprintf(&b, " WHERE t.%[1]s IS NULL OR EXCLUDED.%[1]s >= t.%[1]s", updatedAtColumn)
+	}
+	return b.String()
+}
+
+// record is a generic function to record protobuf messages into a specified table.
+// It takes a conversion function to transform protobuf messages into the appropriate struct type.
+func record[P proto.Message, T any](ctx context.Context, pool *pgxpool.Pool, table string, msgs []P, convert func(p P) (T, error)) (int32, error) {
+	rows := make([]T, 0, len(msgs))
+	for _, msg := range msgs {
+		row, err := convert(msg)
+		if err != nil {
+			return 0, fmt.Errorf("convert message: %w", err)
+		}
+		rows = append(rows, row)
+	}
+	return upsert(ctx, pool, table, rows)
+}
+
+// upsert bulk inserts the rows into the table. The rows are copied into a
+// temporary staging table first and then merged into the target table.
+func upsert[T any](ctx context.Context, pool *pgxpool.Pool, table string, rows []T) (int32, error) {
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	cols := columnsOf(reflect.TypeFor[T]())
+	if len(cols.keys) == 0 {
+		return 0, fmt.Errorf("no key columns for table %s", table)
+	}
+
+	vals := make([][]any, 0, len(rows))
+	for _, row := range rows {
+		vals = append(vals, values(row))
+	}
+
+	staging := "staging_" + table
+	err := withTransaction(ctx, pool, func(ctx context.Context, tx pgx.Tx) error {
+		query := fmt.Sprintf("CREATE TEMP TABLE %s (LIKE %s INCLUDING DEFAULTS) ON COMMIT DROP", staging, table)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("create staging table: %w", err)
+		}
+
+		if _, err := tx.CopyFrom(ctx, pgx.Identifier{staging}, cols.names, pgx.CopyFromRows(vals)); err != nil {
+			return fmt.Errorf("copy: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, upsertQuery(table, staging, cols)); err != nil {
+			return fmt.Errorf("upsert: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(len(rows)), nil
+}
+
+func withTransaction(ctx context.Context, pool *pgxpool.Pool, query func(context.Context, pgx.Tx) error) error {
+	// cancel after 30 seconds
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// begin transaction
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	// run transaction
+	if err := query(ctx, tx); err != nil {
+		if rerr := tx.Rollback(ctx); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("rollback transaction: %w", rerr))
+		}
+		return fmt.Errorf("run transaction: %w", err)
+	}
+
+	// end transaction
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
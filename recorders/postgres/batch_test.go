@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestColumnsOf(t *testing.T) {
+	cols := columnsOf(reflect.TypeFor[MergeRequestCommit]())
+
+	wantNames := []string{"id", "merge_request_id", "merge_request_iid", "merge_request_project_id", "updated_at", "data"}
+	if !slices.Equal(cols.names, wantNames) {
+		t.Errorf("names = %v, want %v", cols.names, wantNames)
+	}
+	wantKeys := []string{"id", "merge_request_id"}
+	if !slices.Equal(cols.keys, wantKeys) {
+		t.Errorf("keys = %v, want %v", cols.keys, wantKeys)
+	}
+}
+
+func TestValues(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	row := Pipeline{Id: 1, Iid: 2, ProjectId: 3, UpdatedAt: &updatedAt, Data: []byte("{}")}
+
+	vals := values(row)
+	if len(vals) != len(columnsOf(reflect.TypeFor[Pipeline]()).names) {
+		t.Fatalf("got %d values, want one per column", len(vals))
+	}
+	if vals[0] != int64(1) || vals[1] != int64(2) || vals[2] != int64(3) {
+		t.Errorf("unexpected id values: %v", vals[:3])
+	}
+	if vals[3] != &updatedAt {
+		t.Errorf("updated_at = %v, want %v", vals[3], &updatedAt)
+	}
+}
+
+func TestUpsertQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		cols  tableColumns
+		want  string
+	}{
+		{
+			name:  "with updated_at",
+			table: "pipelines",
+			cols:  columnsOf(reflect.TypeFor[Pipeline]()),
+			want: "INSERT INTO pipelines AS t (id, iid, project_id, updated_at, data) " +
+				"SELECT DISTINCT ON (id) id, iid, project_id, updated_at, data FROM staging_pipelines ORDER BY id, updated_at DESC NULLS LAST " +
+				"ON CONFLICT (id) DO UPDATE SET iid = EXCLUDED.iid, project_id = EXCLUDED.project_id, updated_at = EXCLUDED.updated_at, data = EXCLUDED.data " +
+				"WHERE t.updated_at IS NULL OR EXCLUDED.updated_at >= t.updated_at",
+		},
+		{
+			name:  "without updated_at",
+			table: "spans",
+			cols:  tableColumns{names: []string{"trace_id", "span_id", "name"}, keys: []string{"trace_id", "span_id"}},
+			want: "INSERT INTO spans AS t (trace_id, span_id, name) " +
+				"SELECT DISTINCT ON (trace_id, span_id) trace_id, span_id, name FROM staging_spans ORDER BY trace_id, span_id " +
+				"ON CONFLICT (trace_id, span_id) DO UPDATE SET name = EXCLUDED.name",
+		},
+		{
+			name:  "only keys",
+			table: "ids",
+			cols:  tableColumns{names: []string{"id"}, keys: []string{"id"}},
+			want: "INSERT INTO ids AS t (id) " +
+				"SELECT DISTINCT ON (id) id FROM staging_ids ORDER BY id " +
+				"ON CONFLICT (id) DO NOTHING",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := upsertQuery(tt.table, "staging_"+tt.table, tt.cols); got != tt.want {
+				t.Errorf("upsertQuery() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"gopkg.in/yaml.v3"
+
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
+	"go.cluttr.dev/gitlab-exporter/recorders/postgres"
+)
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("Fatal error", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	var (
+		address      string
+		configPath   string
+		configSchema bool
+
+		tlsConfig server.TLSConfig
+		tokenFile string
+	)
+
+	flag.StringVar(&address, "address", "", "Address to listen on (e.g., unix:///tmp/recorder.sock or :9090)")
+	flag.StringVar(&configPath, "config", "", "Path to configuration file")
+	flag.BoolVar(&configSchema, "config-schema", false, "Print the JSON schema of the configuration and exit")
+	flag.StringVar(&tlsConfig.CertFile, "tls-cert-file", "", "Path to the TLS server certificate, enables TLS")
+	flag.StringVar(&tlsConfig.KeyFile, "tls-key-file", "", "Path to the TLS server key")
+	flag.StringVar(&tlsConfig.ClientCAFile, "tls-client-ca-file", "", "Path to the CA bundle to verify client certificates, enables mutual TLS")
+	flag.StringVar(&tokenFile, "token-file", "", "Path to a file containing the bearer token clients must send, requires TLS")
+	flag.Parse()
+
+	if configSchema {
+		fmt.Println(postgres.SettingsSchema)
+		return nil
+	}
+
+	if address == "" {
+		return fmt.Errorf("--address is required")
+	}
+
+	// Create context that cancels on interrupt signals
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Create recorder instance
+	rec := postgres.New(address)
+
+	// Load and apply configuration, without a config file the default
+	// settings are used
+	var settings postgres.Settings
+	if configPath != "" {
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			return fmt.Errorf("read config file: %w", err)
+		}
+		if err := yaml.Unmarshal(data, &settings); err != nil {
+			return fmt.Errorf("unmarshal config: %w", err)
+		}
+	}
+
+	if err := rec.Initialize(ctx, settings); err != nil {
+		return fmt.Errorf("initialize recorder: %w", err)
+	}
+
+	if err := rec.Start(ctx); err != nil {
+		return fmt.Errorf("start recorder: %w", err)
+	}
+	defer func() {
+		if err := rec.Stop(context.Background()); err != nil {
+			slog.Error("Error stopping recorder", "error", err)
+		}
+	}()
+
+	if err := rec.CheckHealth(ctx); err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+
+	// Create and start gRPC server
+	opts, err := server.OptionsFromFiles(tlsConfig, tokenFile)
+	if err != nil {
+		return fmt.Errorf("configure server: %w", err)
+	}
+	srv := server.New(rec, opts...)
+
+	slog.Info("Starting PostgreSQL recorder", "address", address)
+
+	return srv.ListenAndServe(ctx, address)
+}
@@ -0,0 +1,559 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	otlp_comonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	otlp_tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+var marshalOptions = protojson.MarshalOptions{
+	UseProtoNames: true,
+}
+
+// marshal returns the JSON encoding of the message, which is stored in the
+// data column.
+func marshal(msg proto.Message) ([]byte, error) {
+	return marshalOptions.Marshal(msg)
+}
+
+// timestamp converts ts to a time that is nil if ts is not set.
+func timestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// latest returns the latest of the given timestamps, or nil if none is set.
+func latest(tss ...*timestamppb.Timestamp) *time.Time {
+	var t *time.Time
+	for _, ts := range tss {
+		if ts == nil {
+			continue
+		}
+		if tt := ts.AsTime(); t == nil || tt.After(*t) {
+			t = &tt
+		}
+	}
+	return t
+}
+
+func ConvertCommit(msg *typespb.Commit) (Commit, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Commit{}, err
+	}
+
+	return Commit{
+		Id:        msg.GetId(),
+		ProjectId: msg.GetProjectId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertCoverageClass(msg *typespb.CoverageClass) (CoverageClass, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return CoverageClass{}, err
+	}
+
+	return CoverageClass{
+		Id:        msg.GetId(),
+		PackageId: msg.GetPackage().GetId(),
+		ReportId:  msg.GetPackage().GetReport().GetId(),
+
+		JobId:      msg.GetPackage().GetReport().GetJob().GetId(),
+		PipelineId: msg.GetPackage().GetReport().GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetPackage().GetReport().GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertCoverageMethod(msg *typespb.CoverageMethod) (CoverageMethod, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return CoverageMethod{}, err
+	}
+
+	return CoverageMethod{
+		Id:        msg.GetId(),
+		ClassId:   msg.GetClass().GetId(),
+		PackageId: msg.GetClass().GetPackage().GetId(),
+		ReportId:  msg.GetClass().GetPackage().GetReport().GetId(),
+
+		JobId:      msg.GetClass().GetPackage().GetReport().GetJob().GetId(),
+		PipelineId: msg.GetClass().GetPackage().GetReport().GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetClass().GetPackage().GetReport().GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertCoveragePackage(msg *typespb.CoveragePackage) (CoveragePackage, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return CoveragePackage{}, err
+	}
+
+	return CoveragePackage{
+		Id:       msg.GetId(),
+		ReportId: msg.GetReport().GetId(),
+
+		JobId:      msg.GetReport().GetJob().GetId(),
+		PipelineId: msg.GetReport().GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetReport().GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertCoverageReport(msg *typespb.CoverageReport) (CoverageReport, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return CoverageReport{}, err
+	}
+
+	return CoverageReport{
+		Id:         msg.GetId(),
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertJobArtifact(msg *typespb.JobArtifact) (JobArtifact, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return JobArtifact{}, err
+	}
+
+	return JobArtifact{
+		Id: msg.GetId(),
+
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		FileType: msg.GetFileType(),
+		Size:     msg.GetSize(),
+		Locked:   msg.GetLocked(),
+		ExpireAt: timestamp(msg.GetExpireAt()),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertDeployment(msg *typespb.Deployment) (Deployment, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Deployment{}, err
+	}
+
+	return Deployment{
+		Id:            msg.GetId(),
+		Iid:           msg.GetIid(),
+		EnvironmentId: msg.GetEnvironment().GetId(),
+
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertEnvironment(msg *typespb.Environment) (Environment, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	return Environment{
+		Id:               msg.GetId(),
+		ProjectId:        msg.GetProject().GetId(),
+		LastDeploymentId: msg.GetLastDeployment().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertIssue(msg *typespb.Issue) (Issue, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Issue{}, err
+	}
+
+	return Issue{
+		Id:        msg.GetId(),
+		Iid:       msg.GetIid(),
+		ProjectId: msg.GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertJob(msg *typespb.Job) (Job, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Job{}, err
+	}
+
+	return Job{
+		Id:         msg.GetId(),
+		PipelineId: msg.GetPipeline().GetId(),
+		ProjectId:  msg.GetPipeline().GetProject().GetId(),
+
+		UpdatedAt: latest(
+			msg.GetTimestamps().GetCreatedAt(),
+			msg.GetTimestamps().GetQueuedAt(),
+			msg.GetTimestamps().GetStartedAt(),
+			msg.GetTimestamps().GetFinishedAt(),
+			msg.GetTimestamps().GetErasedAt(),
+		),
+		Data: data,
+	}, nil
+}
+
+func ConvertMergeRequest(msg *typespb.MergeRequest) (MergeRequest, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return MergeRequest{}, err
+	}
+
+	return MergeRequest{
+		Id:        msg.GetId(),
+		Iid:       msg.GetIid(),
+		ProjectId: msg.GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertMergeRequestCommit(msg *typespb.MergeRequestCommit) (MergeRequestCommit, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return MergeRequestCommit{}, err
+	}
+
+	return MergeRequestCommit{
+		Id:                    msg.GetId(),
+		MergeRequestId:        msg.GetMergeRequest().GetId(),
+		MergeRequestIid:       msg.GetMergeRequest().GetIid(),
+		MergeRequestProjectId: msg.GetMergeRequest().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertMergeRequestEvent(msg *typespb.MergeRequestEvent) (MergeRequestEvent, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return MergeRequestEvent{}, err
+	}
+
+	return MergeRequestEvent{
+		Kind:                  int32(msg.GetKind()),
+		Id:                    msg.GetId(),
+		MergeRequestId:        msg.GetMergeRequest().GetId(),
+		MergeRequestIid:       msg.GetMergeRequest().GetIid(),
+		MergeRequestProjectId: msg.GetMergeRequest().GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetCreatedAt()), // events do not change
+		Data:      data,
+	}, nil
+}
+
+func ConvertMergeRequestNoteEvent(msg *typespb.MergeRequestNoteEvent) (MergeRequestNoteEvent, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return MergeRequestNoteEvent{}, err
+	}
+
+	return MergeRequestNoteEvent{
+		Id:                    msg.GetId(),
+		MergeRequestId:        msg.GetMergeRequest().GetId(),
+		MergeRequestIid:       msg.GetMergeRequest().GetIid(),
+		MergeRequestProjectId: msg.GetMergeRequest().GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertMetric(msg *typespb.Metric) (Metric, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Metric{}, err
+	}
+
+	return Metric{
+		Id:         string(msg.GetId()),
+		Iid:        msg.GetIid(),
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertPipeline(msg *typespb.Pipeline) (Pipeline, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Pipeline{}, err
+	}
+
+	return Pipeline{
+		Id:        msg.GetId(),
+		Iid:       msg.GetIid(),
+		ProjectId: msg.GetProject().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertProject(msg *typespb.Project) (Project, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Project{}, err
+	}
+
+	return Project{
+		Id:          msg.GetId(),
+		NamespaceId: msg.GetNamespace().GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetUpdatedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertRelease(msg *typespb.Release) (Release, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Release{}, err
+	}
+
+	return Release{
+		ProjectId:  msg.GetProject().GetId(),
+		TagName:    msg.GetTagName(),
+		CommitSha:  msg.GetCommitSha(),
+		PipelineId: msg.GetPipeline().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertRunner(msg *typespb.Runner) (Runner, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Runner{}, err
+	}
+
+	return Runner{
+		Id: msg.GetId(),
+
+		UpdatedAt: timestamp(msg.GetTimestamps().GetContactedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertSection(msg *typespb.Section) (Section, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Section{}, err
+	}
+
+	return Section{
+		Id:         msg.GetId(),
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		UpdatedAt: latest(msg.GetStartedAt(), msg.GetFinishedAt()),
+		Data:      data,
+	}, nil
+}
+
+func ConvertTag(msg *typespb.Tag) (Tag, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	return Tag{
+		ProjectId: msg.GetProject().GetId(),
+		Name:      msg.GetName(),
+		CommitSha: msg.GetCommitSha(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertTestCase(msg *typespb.TestCase) (TestCase, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return TestCase{}, err
+	}
+
+	return TestCase{
+		Id:           msg.GetId(),
+		TestSuiteId:  msg.GetTestSuite().GetId(),
+		TestReportId: msg.GetTestSuite().GetTestReport().GetId(),
+
+		JobId:      msg.GetTestSuite().GetTestReport().GetJob().GetId(),
+		PipelineId: msg.GetTestSuite().GetTestReport().GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetTestSuite().GetTestReport().GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertTestReport(msg *typespb.TestReport) (TestReport, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return TestReport{}, err
+	}
+
+	return TestReport{
+		Id: msg.GetId(),
+
+		JobId:      msg.GetJob().GetId(),
+		PipelineId: msg.GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertTestSuite(msg *typespb.TestSuite) (TestSuite, error) {
+	data, err := marshal(msg)
+	if err != nil {
+		return TestSuite{}, err
+	}
+
+	return TestSuite{
+		Id:           msg.GetId(),
+		TestReportId: msg.GetTestReport().GetId(),
+
+		JobId:      msg.GetTestReport().GetJob().GetId(),
+		PipelineId: msg.GetTestReport().GetJob().GetPipeline().GetId(),
+		ProjectId:  msg.GetTestReport().GetJob().GetPipeline().GetProject().GetId(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertTrace(msg *typespb.Trace) ([]TraceSpan, error) {
+	var spans []TraceSpan
+
+	for _, resourceSpans := range msg.Data.ResourceSpans {
+		resourceAttrs := convertAttributes(resourceSpans.Resource.Attributes)
+		serviceName := resourceAttrs["service.name"]
+		resourceAttrsData, err := json.Marshal(resourceAttrs)
+		if err != nil {
+			return nil, fmt.Errorf("convert resource attributes: %w", err)
+		}
+
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			scopeName := scopeSpans.Scope.Name
+			scopeVersion := scopeSpans.Scope.Version
+			for _, span := range scopeSpans.Spans {
+				spanAttrs := convertAttributes(span.Attributes)
+				spanAttrsData, err := json.Marshal(spanAttrs)
+				if err != nil {
+					return nil, fmt.Errorf("convert span attributes: %w", err)
+				}
+
+				spanEvents := convertEvents(span.Events)
+				spanEventsData, err := json.Marshal(spanEvents)
+				if err != nil {
+					return nil, fmt.Errorf("convert span events: %w", err)
+				}
+
+				spanLinks := convertLinks(span.Links)
+				spanLinksData, err := json.Marshal(spanLinks)
+				if err != nil {
+					return nil, fmt.Errorf("convert span links: %w", err)
+				}
+
+				spans = append(spans, TraceSpan{
+					Timestamp:          time.Unix(0, int64(span.StartTimeUnixNano)).UTC(),
+					TraceId:            string(span.TraceId),
+					SpanId:             string(span.SpanId),
+					ParentSpanId:       string(span.ParentSpanId),
+					TraceState:         span.TraceState,
+					SpanName:           span.Name,
+					SpanKind:           span.Kind.String(),
+					ServiceName:        serviceName,
+					ResourceAttributes: resourceAttrsData,
+					ScopeName:          scopeName,
+					ScopeVersion:       scopeVersion,
+					SpanAttributes:     spanAttrsData,
+					Duration:           int64(span.EndTimeUnixNano) - int64(span.StartTimeUnixNano),
+					StatusCode:         int32(span.GetStatus().GetCode()),
+					StatusMessage:      span.GetStatus().GetMessage(),
+					Events:             spanEventsData,
+					Links:              spanLinksData,
+				})
+			}
+		}
+	}
+
+	return spans, nil
+}
+
+func convertAttributes(list []*otlp_comonpb.KeyValue) map[string]string {
+	attrs := make(map[string]string)
+
+	for _, attr := range list {
+		value, ok := attr.GetValue().Value.(*otlp_comonpb.AnyValue_StringValue)
+		if ok {
+			attrs[attr.Key] = value.StringValue
+		}
+	}
+
+	return attrs
+}
+
+func convertEvents(events []*otlp_tracepb.Span_Event) []map[string]any {
+	var eventMaps []map[string]any
+	for _, event := range events {
+		eventMaps = append(eventMaps, map[string]any{
+			"Timestamp":  event.TimeUnixNano,
+			"Name":       event.Name,
+			"Attributes": convertAttributes(event.Attributes),
+		})
+	}
+	return eventMaps
+}
+
+func convertLinks(links []*otlp_tracepb.Span_Link) []map[string]any {
+	var linkMaps []map[string]any
+	for _, link := range links {
+		linkMaps = append(linkMaps, map[string]any{
+			"TraceId":    string(link.TraceId),
+			"SpanId":     string(link.SpanId),
+			"TraceState": link.TraceState,
+			"Attributes": convertAttributes(link.Attributes),
+		})
+	}
+	return linkMaps
+}
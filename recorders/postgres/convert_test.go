@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	otlp_commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	otlp_resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	otlp_tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func TestConvertPipeline(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	msg := &typespb.Pipeline{
+		Id:  789,
+		Iid: 42,
+		Project: &typespb.ProjectReference{
+			Id:       123,
+			FullPath: "group/project",
+		},
+		Timestamps: &typespb.PipelineTimestamps{
+			UpdatedAt: timestamppb.New(updatedAt),
+		},
+	}
+
+	result, err := ConvertPipeline(msg)
+	if err != nil {
+		t.Fatalf("ConvertPipeline() error = %v", err)
+	}
+
+	if result.Id != 789 || result.Iid != 42 || result.ProjectId != 123 {
+		t.Errorf("unexpected ids: %+v", result)
+	}
+	if result.UpdatedAt == nil || !result.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("UpdatedAt = %v, want %v", result.UpdatedAt, updatedAt)
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(result.Data, &data); err != nil {
+		t.Fatalf("Failed to unmarshal Data: %v", err)
+	}
+	if data["iid"] != "42" {
+		t.Errorf("data uses unexpected field names: %s", result.Data)
+	}
+}
+
+func TestConvertJob_UpdatedAt(t *testing.T) {
+	started := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	finished := started.Add(time.Minute)
+
+	result, err := ConvertJob(&typespb.Job{
+		Id: 1,
+		Timestamps: &typespb.JobTimestamps{
+			CreatedAt:  timestamppb.New(started.Add(-time.Minute)),
+			FinishedAt: timestamppb.New(finished),
+			StartedAt:  timestamppb.New(started),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertJob() error = %v", err)
+	}
+	if result.UpdatedAt == nil || !result.UpdatedAt.Equal(finished) {
+		t.Errorf("UpdatedAt = %v, want %v", result.UpdatedAt, finished)
+	}
+
+	result, err = ConvertJob(&typespb.Job{Id: 2})
+	if err != nil {
+		t.Fatalf("ConvertJob() error = %v", err)
+	}
+	if result.UpdatedAt != nil {
+		t.Errorf("UpdatedAt = %v, want nil", result.UpdatedAt)
+	}
+}
+
+func TestConvertTrace(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	msg := &typespb.Trace{
+		Data: &otlp_tracepb.TracesData{
+			ResourceSpans: []*otlp_tracepb.ResourceSpans{{
+				Resource: &otlp_resourcepb.Resource{
+					Attributes: []*otlp_commonpb.KeyValue{{
+						Key:   "service.name",
+						Value: &otlp_commonpb.AnyValue{Value: &otlp_commonpb.AnyValue_StringValue{StringValue: "group/project"}},
+					}},
+				},
+				ScopeSpans: []*otlp_tracepb.ScopeSpans{{
+					Scope: &otlp_commonpb.InstrumentationScope{Name: "gitlab-exporter"},
+					Spans: []*otlp_tracepb.Span{{
+						TraceId:           []byte("1"),
+						SpanId:            []byte("2"),
+						Name:              "pipeline",
+						StartTimeUnixNano: uint64(start.UnixNano()),
+						EndTimeUnixNano:   uint64(start.Add(time.Second).UnixNano()),
+					}},
+				}},
+			}},
+		},
+	}
+
+	spans, err := ConvertTrace(msg)
+	if err != nil {
+		t.Fatalf("ConvertTrace() error = %v", err)
+	}
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+
+	span := spans[0]
+	if span.TraceId != "1" || span.SpanId != "2" {
+		t.Errorf("unexpected ids: %q, %q", span.TraceId, span.SpanId)
+	}
+	if !span.Timestamp.Equal(start) {
+		t.Errorf("Timestamp = %v, want %v", span.Timestamp, start)
+	}
+	if span.Duration != int64(time.Second) {
+		t.Errorf("Duration = %d, want %d", span.Duration, time.Second)
+	}
+	if span.ServiceName != "group/project" {
+		t.Errorf("ServiceName = %s, want group/project", span.ServiceName)
+	}
+}
@@ -0,0 +1,21 @@
+DROP VIEW IF EXISTS trace_view;
+DROP TABLE IF EXISTS traces;
+DROP TABLE IF EXISTS runners;
+DROP TABLE IF EXISTS merge_request_note_events;
+DROP TABLE IF EXISTS merge_request_commits;
+DROP TABLE IF EXISTS merge_requests;
+DROP TABLE IF EXISTS issues;
+DROP TABLE IF EXISTS deployments;
+DROP TABLE IF EXISTS test_cases;
+DROP TABLE IF EXISTS test_suites;
+DROP TABLE IF EXISTS test_reports;
+DROP TABLE IF EXISTS coverage_methods;
+DROP TABLE IF EXISTS coverage_classes;
+DROP TABLE IF EXISTS coverage_packages;
+DROP TABLE IF EXISTS coverage_reports;
+DROP TABLE IF EXISTS metrics;
+DROP TABLE IF EXISTS sections;
+DROP TABLE IF EXISTS jobs;
+DROP TABLE IF EXISTS pipelines;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS commits;
@@ -0,0 +1,333 @@
+-- commits
+CREATE TABLE IF NOT EXISTS commits (
+    id TEXT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id, project_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_commits_project ON commits(project_id);
+
+-- projects
+CREATE TABLE IF NOT EXISTS projects (
+    id BIGINT NOT NULL,
+    namespace_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_projects_namespace ON projects(namespace_id);
+
+-- pipelines
+CREATE TABLE IF NOT EXISTS pipelines (
+    id BIGINT NOT NULL,
+    iid BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pipelines_project ON pipelines(project_id);
+
+-- jobs
+CREATE TABLE IF NOT EXISTS jobs (
+    id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_pipeline ON jobs(project_id, pipeline_id);
+
+-- sections
+CREATE TABLE IF NOT EXISTS sections (
+    id BIGINT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sections_job ON sections(project_id, pipeline_id, job_id);
+
+-- metrics
+CREATE TABLE IF NOT EXISTS metrics (
+    id TEXT NOT NULL,
+    iid BIGINT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_metrics_job ON metrics(project_id, pipeline_id, job_id);
+
+-- coverage_reports
+CREATE TABLE IF NOT EXISTS coverage_reports (
+    id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coverage_reports_job ON coverage_reports(project_id, pipeline_id, job_id);
+
+-- coverage_packages
+CREATE TABLE IF NOT EXISTS coverage_packages (
+    id TEXT NOT NULL,
+    report_id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coverage_packages_job ON coverage_packages(project_id, pipeline_id, job_id);
+CREATE INDEX IF NOT EXISTS idx_coverage_packages_report ON coverage_packages(report_id);
+
+-- coverage_classes
+CREATE TABLE IF NOT EXISTS coverage_classes (
+    id TEXT NOT NULL,
+    package_id TEXT NOT NULL,
+    report_id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coverage_classes_job ON coverage_classes(project_id, pipeline_id, job_id);
+CREATE INDEX IF NOT EXISTS idx_coverage_classes_package ON coverage_classes(report_id, package_id);
+
+-- coverage_methods
+CREATE TABLE IF NOT EXISTS coverage_methods (
+    id TEXT NOT NULL,
+    class_id TEXT NOT NULL,
+    package_id TEXT NOT NULL,
+    report_id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_coverage_methods_job ON coverage_methods(project_id, pipeline_id, job_id);
+CREATE INDEX IF NOT EXISTS idx_coverage_methods_class ON coverage_methods(report_id, class_id);
+CREATE INDEX IF NOT EXISTS idx_coverage_methods_package ON coverage_methods(report_id, package_id);
+
+-- test_reports
+CREATE TABLE IF NOT EXISTS test_reports (
+    id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_reports_job ON test_reports(project_id, pipeline_id, job_id);
+
+-- test_suites
+CREATE TABLE IF NOT EXISTS test_suites (
+    id TEXT NOT NULL,
+    test_report_id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_suites_job ON test_suites(project_id, pipeline_id, job_id);
+CREATE INDEX IF NOT EXISTS idx_test_suites_report ON test_suites(test_report_id);
+
+-- test_cases
+CREATE TABLE IF NOT EXISTS test_cases (
+    id TEXT NOT NULL,
+    test_suite_id TEXT NOT NULL,
+    test_report_id TEXT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_test_cases_job ON test_cases(project_id, pipeline_id, job_id);
+CREATE INDEX IF NOT EXISTS idx_test_cases_suite ON test_cases(test_report_id, test_suite_id);
+
+-- deployments
+CREATE TABLE IF NOT EXISTS deployments (
+    id BIGINT NOT NULL,
+    iid BIGINT NOT NULL,
+    environment_id BIGINT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deployments_project ON deployments(project_id);
+CREATE INDEX IF NOT EXISTS idx_deployments_environment ON deployments(project_id, environment_id);
+CREATE INDEX IF NOT EXISTS idx_deployments_job ON deployments(project_id, pipeline_id, job_id);
+
+-- issues
+CREATE TABLE IF NOT EXISTS issues (
+    id BIGINT NOT NULL,
+    iid BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_issues_project ON issues(project_id);
+
+-- merge_requests
+CREATE TABLE IF NOT EXISTS merge_requests (
+    id BIGINT NOT NULL,
+    iid BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merge_requests_project ON merge_requests(project_id);
+
+-- merge_request_commits
+CREATE TABLE IF NOT EXISTS merge_request_commits (
+    id TEXT NOT NULL,
+    merge_request_id BIGINT NOT NULL,
+    merge_request_iid BIGINT NOT NULL,
+    merge_request_project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id, merge_request_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merge_request_commits_mr ON merge_request_commits(merge_request_project_id, merge_request_id);
+
+-- merge_request_note_events
+CREATE TABLE IF NOT EXISTS merge_request_note_events (
+    id BIGINT NOT NULL,
+    merge_request_id BIGINT NOT NULL,
+    merge_request_iid BIGINT NOT NULL,
+    merge_request_project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merge_request_note_events_mr ON merge_request_note_events(merge_request_project_id, merge_request_id);
+
+-- runners
+CREATE TABLE IF NOT EXISTS runners (
+    id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+-- traces (OpenTelemetry)
+CREATE TABLE IF NOT EXISTS traces (
+    timestamp TIMESTAMPTZ NOT NULL,
+    trace_id TEXT NOT NULL,
+    span_id TEXT NOT NULL,
+    parent_span_id TEXT NOT NULL,
+    trace_state TEXT NOT NULL,
+    span_name TEXT NOT NULL,
+    span_kind TEXT NOT NULL,
+    service_name TEXT NOT NULL,
+    resource_attributes JSONB NOT NULL, -- JSON object
+    scope_name TEXT NOT NULL,
+    scope_version TEXT NOT NULL,
+    span_attributes JSONB NOT NULL, -- JSON object
+    duration BIGINT NOT NULL, -- nanoseconds
+    status_code INTEGER NOT NULL,
+    status_message TEXT NOT NULL,
+    events JSONB NOT NULL, -- JSON array of {Timestamp, Name, Attributes}
+    links JSONB NOT NULL, -- JSON array of {TraceId, SpanId, TraceState, Attributes}
+
+    PRIMARY KEY (trace_id, span_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_traces_service ON traces(service_name);
+CREATE INDEX IF NOT EXISTS idx_traces_timestamp ON traces(timestamp);
+
+-- trace_view (Grafana)
+CREATE OR REPLACE VIEW trace_view AS
+SELECT
+    trace_id AS "traceID",
+    span_id AS "spanID",
+    span_name AS "operationName",
+    parent_span_id AS "parentSpanID",
+    service_name AS "serviceName",
+    duration / 1000000.0 AS "duration",
+    timestamp AS "startTime",
+    span_attributes AS "tags",
+    resource_attributes AS "serviceTags",
+    links AS "references"
+FROM traces
+;
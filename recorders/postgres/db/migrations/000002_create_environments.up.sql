@@ -0,0 +1,13 @@
+-- environments
+CREATE TABLE IF NOT EXISTS environments (
+    id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+    last_deployment_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_environments_project ON environments(project_id);
@@ -0,0 +1,40 @@
+-- job_artifacts
+CREATE TABLE IF NOT EXISTS job_artifacts (
+    id BIGINT NOT NULL,
+    job_id BIGINT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+    project_id BIGINT NOT NULL,
+    file_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    locked BOOLEAN NOT NULL,
+
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_artifacts_project ON job_artifacts(project_id);
+CREATE INDEX IF NOT EXISTS idx_job_artifacts_job ON job_artifacts(project_id, pipeline_id, job_id);
+
+-- project_job_artifacts_view rolls up the recorded artifacts of each project
+-- by file type next to the artifacts size reported by the project statistics.
+CREATE OR REPLACE VIEW project_job_artifacts_view AS
+SELECT
+    a.project_id AS project_id,
+    a.file_type AS file_type,
+    a.artifacts_count AS artifacts_count,
+    a.artifacts_size AS artifacts_size,
+    a.locked_size AS locked_size,
+    (p.data->'statistics'->>'job_artifacts_size')::BIGINT AS project_job_artifacts_size
+FROM (
+    SELECT
+        project_id,
+        file_type,
+        COUNT(*) AS artifacts_count,
+        SUM(size) AS artifacts_size,
+        SUM(CASE WHEN locked THEN size ELSE 0 END) AS locked_size
+    FROM job_artifacts
+    GROUP BY project_id, file_type
+) a
+LEFT JOIN projects p ON p.id = a.project_id
+;
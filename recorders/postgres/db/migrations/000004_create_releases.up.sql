@@ -0,0 +1,28 @@
+-- tags
+CREATE TABLE IF NOT EXISTS tags (
+    project_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    commit_sha TEXT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (project_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tags_commit ON tags(project_id, commit_sha);
+
+-- releases
+CREATE TABLE IF NOT EXISTS releases (
+    project_id BIGINT NOT NULL,
+    tag_name TEXT NOT NULL,
+    commit_sha TEXT NOT NULL,
+    pipeline_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (project_id, tag_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_releases_pipeline ON releases(pipeline_id);
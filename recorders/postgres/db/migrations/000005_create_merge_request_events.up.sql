@@ -0,0 +1,15 @@
+-- merge_request_events
+CREATE TABLE IF NOT EXISTS merge_request_events (
+    kind INTEGER NOT NULL,
+    id BIGINT NOT NULL,
+    merge_request_id BIGINT NOT NULL,
+    merge_request_iid BIGINT NOT NULL,
+    merge_request_project_id BIGINT NOT NULL,
+
+    updated_at TIMESTAMPTZ,
+    data JSONB NOT NULL,
+
+    PRIMARY KEY (kind, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merge_request_events_mr ON merge_request_events(merge_request_project_id, merge_request_id);
@@ -0,0 +1,28 @@
+-- job_artifacts
+ALTER TABLE job_artifacts ADD COLUMN IF NOT EXISTS expire_at TIMESTAMPTZ;
+
+UPDATE job_artifacts SET expire_at = (data->>'expire_at')::TIMESTAMPTZ WHERE data ? 'expire_at';
+
+-- project_job_artifacts_view
+-- A NULL expire_at means artifacts are kept until they are deleted.
+CREATE OR REPLACE VIEW project_job_artifacts_view AS
+SELECT
+    a.project_id AS project_id,
+    a.file_type AS file_type,
+    a.artifacts_count AS artifacts_count,
+    a.artifacts_size AS artifacts_size,
+    a.locked_size AS locked_size,
+    (p.data->'statistics'->>'job_artifacts_size')::BIGINT AS project_job_artifacts_size
+FROM (
+    SELECT
+        project_id,
+        file_type,
+        COUNT(*) AS artifacts_count,
+        SUM(size) AS artifacts_size,
+        SUM(CASE WHEN locked THEN size ELSE 0 END) AS locked_size
+    FROM job_artifacts
+    WHERE locked OR expire_at IS NULL OR expire_at > now()
+    GROUP BY project_id, file_type
+) a
+LEFT JOIN projects p ON p.id = a.project_id
+;
@@ -0,0 +1,282 @@
+package postgres
+
+import (
+	"time"
+)
+
+// The models map to the table columns by their db tags. Columns tagged with
+// `key` form the table's primary key. The `updated_at` column, if present,
+// decides whether a recorded row replaces an existing one.
+
+type Commit struct {
+	Id        string `db:"id,key"`
+	ProjectId int64  `db:"project_id,key"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Project struct {
+	Id          int64 `db:"id,key"`
+	NamespaceId int64 `db:"namespace_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Pipeline struct {
+	Id        int64 `db:"id,key"`
+	Iid       int64 `db:"iid"`
+	ProjectId int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Job struct {
+	Id         int64 `db:"id,key"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Section struct {
+	Id         int64 `db:"id,key"`
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Metric struct {
+	Id         string `db:"id,key"`
+	Iid        int64  `db:"iid"`
+	JobId      int64  `db:"job_id"`
+	PipelineId int64  `db:"pipeline_id"`
+	ProjectId  int64  `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type CoverageReport struct {
+	Id string `db:"id,key"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type CoveragePackage struct {
+	Id       string `db:"id,key"`
+	ReportId string `db:"report_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type CoverageClass struct {
+	Id        string `db:"id,key"`
+	PackageId string `db:"package_id"`
+	ReportId  string `db:"report_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type CoverageMethod struct {
+	Id        string `db:"id,key"`
+	ClassId   string `db:"class_id"`
+	PackageId string `db:"package_id"`
+	ReportId  string `db:"report_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type TestReport struct {
+	Id string `db:"id,key"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type TestSuite struct {
+	Id           string `db:"id,key"`
+	TestReportId string `db:"test_report_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type TestCase struct {
+	Id           string `db:"id,key"`
+	TestSuiteId  string `db:"test_suite_id"`
+	TestReportId string `db:"test_report_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type JobArtifact struct {
+	Id int64 `db:"id,key"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	FileType string     `db:"file_type"`
+	Size     int64      `db:"size"`
+	Locked   bool       `db:"locked"`
+	ExpireAt *time.Time `db:"expire_at"`
+
+	Data []byte `db:"data"`
+}
+
+type Deployment struct {
+	Id            int64 `db:"id,key"`
+	Iid           int64 `db:"iid"`
+	EnvironmentId int64 `db:"environment_id"`
+
+	JobId      int64 `db:"job_id"`
+	PipelineId int64 `db:"pipeline_id"`
+	ProjectId  int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Environment struct {
+	Id               int64 `db:"id,key"`
+	ProjectId        int64 `db:"project_id"`
+	LastDeploymentId int64 `db:"last_deployment_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Issue struct {
+	Id        int64 `db:"id,key"`
+	Iid       int64 `db:"iid"`
+	ProjectId int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type MergeRequest struct {
+	Id        int64 `db:"id,key"`
+	Iid       int64 `db:"iid"`
+	ProjectId int64 `db:"project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type MergeRequestCommit struct {
+	Id                    string `db:"id,key"`
+	MergeRequestId        int64  `db:"merge_request_id,key"`
+	MergeRequestIid       int64  `db:"merge_request_iid"`
+	MergeRequestProjectId int64  `db:"merge_request_project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type MergeRequestEvent struct {
+	Kind                  int32 `db:"kind,key"`
+	Id                    int64 `db:"id,key"`
+	MergeRequestId        int64 `db:"merge_request_id"`
+	MergeRequestIid       int64 `db:"merge_request_iid"`
+	MergeRequestProjectId int64 `db:"merge_request_project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type MergeRequestNoteEvent struct {
+	Id                    int64 `db:"id,key"`
+	MergeRequestId        int64 `db:"merge_request_id"`
+	MergeRequestIid       int64 `db:"merge_request_iid"`
+	MergeRequestProjectId int64 `db:"merge_request_project_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Release struct {
+	ProjectId  int64  `db:"project_id,key"`
+	TagName    string `db:"tag_name,key"`
+	CommitSha  string `db:"commit_sha"`
+	PipelineId int64  `db:"pipeline_id"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Runner struct {
+	Id int64 `db:"id,key"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type Tag struct {
+	ProjectId int64  `db:"project_id,key"`
+	Name      string `db:"name,key"`
+	CommitSha string `db:"commit_sha"`
+
+	UpdatedAt *time.Time `db:"updated_at"`
+	Data      []byte     `db:"data"`
+}
+
+type TraceSpan struct {
+	Timestamp          time.Time `db:"timestamp"`
+	TraceId            string    `db:"trace_id,key"`
+	SpanId             string    `db:"span_id,key"`
+	ParentSpanId       string    `db:"parent_span_id"`
+	TraceState         string    `db:"trace_state"`
+	SpanName           string    `db:"span_name"`
+	SpanKind           string    `db:"span_kind"`
+	ServiceName        string    `db:"service_name"`
+	ResourceAttributes []byte    `db:"resource_attributes"` // JSON object
+	ScopeName          string    `db:"scope_name"`
+	ScopeVersion       string    `db:"scope_version"`
+	SpanAttributes     []byte    `db:"span_attributes"` // JSON object
+	Duration           int64     `db:"duration"`        // [ns]
+	StatusCode         int32     `db:"status_code"`
+	StatusMessage      string    `db:"status_message"`
+	Events             []byte    `db:"events"` // JSON array of {Timestamp, Name, Attributes}
+	Links              []byte    `db:"links"`  // JSON array of {TraceId, SpanId, TraceState, Attributes}
+}
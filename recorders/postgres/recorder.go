@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+// Recorder implements the recorder.Recorder interface for PostgreSQL storage
+type Recorder struct {
+	servicepb.UnimplementedGitLabExporterServer
+
+	pool     *pgxpool.Pool
+	address  string
+	settings Settings
+}
+
+// Settings holds PostgreSQL-specific configuration
+type Settings struct {
+	// Connection string, either as URL or as key/value pairs. Unset
+	// parameters are taken from the libpq environment variables, e.g. PGHOST.
+	DSN string `yaml:"dsn"`
+
+	// Maximum number of open connections
+	MaxConns int32 `yaml:"max_conns"`
+}
+
+// SettingsSchema is the JSON schema of the recorder settings.
+const SettingsSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "dsn": {
+      "type": "string",
+      "description": "Connection string, either as URL or as key/value pairs; unset parameters are taken from the libpq environment variables"
+    },
+    "max_conns": {
+      "type": "integer",
+      "minimum": 1,
+      "description": "Maximum number of open connections"
+    }
+  },
+  "additionalProperties": false
+}`
+
+// New creates a new PostgreSQL recorder instance
+func New(address string) *Recorder {
+	return &Recorder{
+		address: address,
+	}
+}
+
+// Name returns the recorder type name
+func (r *Recorder) Name() string {
+	return "postgres"
+}
+
+// Initialize prepares the PostgreSQL recorder with configuration
+func (r *Recorder) Initialize(ctx context.Context, settings Settings) error {
+	// Set defaults
+	r.settings = Settings{
+		MaxConns: 4,
+	}
+
+	// Override with options if provided
+	if settings.DSN != "" {
+		r.settings.DSN = settings.DSN
+	}
+	if settings.MaxConns != 0 {
+		r.settings.MaxConns = settings.MaxConns
+	}
+
+	// Validate settings
+	if _, err := pgxpool.ParseConfig(r.settings.DSN); err != nil {
+		return fmt.Errorf("invalid dsn: %w", err)
+	}
+	if r.settings.MaxConns < 1 {
+		return fmt.Errorf("invalid max conns: %d", r.settings.MaxConns)
+	}
+
+	return nil
+}
+
+// Start opens the connection pool and runs migrations
+func (r *Recorder) Start(ctx context.Context) error {
+	cfg, err := pgxpool.ParseConfig(r.settings.DSN)
+	if err != nil {
+		return fmt.Errorf("parse dsn: %w", err)
+	}
+	cfg.MaxConns = r.settings.MaxConns
+
+	// Open connection pool
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("open database: %w", err)
+	}
+
+	r.pool = pool
+
+	if err := r.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("connect database: %w", err)
+	}
+
+	// Run migrations
+	if err := RunMigrations(r.pool); err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+
+	return nil
+}
+
+// Stop closes the connection pool
+func (r *Recorder) Stop(ctx context.Context) error {
+	if r.pool != nil {
+		r.pool.Close()
+	}
+	return nil
+}
+
+// CheckHealth checks if the database connection is alive
+func (r *Recorder) CheckHealth(ctx context.Context) error {
+	if r.pool == nil {
+		return fmt.Errorf("database not initialized")
+	}
+
+	return r.pool.Ping(ctx)
+}
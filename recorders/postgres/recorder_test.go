@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func TestNew(t *testing.T) {
+	r := New("unix:///tmp/postgres.sock")
+	if r == nil {
+		t.Fatal("New() returned nil")
+	}
+
+	if r.Name() != "postgres" {
+		t.Errorf("Name() = %s, want postgres", r.Name())
+	}
+}
+
+func TestRecorder_Initialize(t *testing.T) {
+	r := New("unix:///tmp/postgres.sock")
+
+	if err := r.Initialize(context.Background(), Settings{DSN: "postgres://localhost/gitlab_ci"}); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if r.settings.MaxConns != 4 {
+		t.Errorf("MaxConns = %d, want 4", r.settings.MaxConns)
+	}
+
+	if err := r.Initialize(context.Background(), Settings{DSN: "postgres://localhost:invalid"}); err == nil {
+		t.Error("Initialize() with invalid dsn should return error")
+	}
+	if err := r.Initialize(context.Background(), Settings{MaxConns: -1}); err == nil {
+		t.Error("Initialize() with negative max conns should return error")
+	}
+}
+
+func TestRecorder_CheckHealth(t *testing.T) {
+	r := New("unix:///tmp/postgres.sock")
+	if err := r.CheckHealth(context.Background()); err == nil {
+		t.Error("CheckHealth() before Start() should return error")
+	}
+}
+
+// TestRecorder_Postgres runs against the database given by the
+// POSTGRES_TEST_DSN environment variable.
+func TestRecorder_Postgres(t *testing.T) {
+	dsn := os.Getenv("POSTGRES_TEST_DSN")
+	if dsn == "" {
+		t.Skip("POSTGRES_TEST_DSN not set")
+	}
+
+	ctx := context.Background()
+	r := New("unix:///tmp/postgres.sock")
+	if err := r.Initialize(ctx, Settings{DSN: dsn}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = r.Stop(ctx) }()
+
+	// migrations are idempotent
+	if err := RunMigrations(r.pool); err != nil {
+		t.Fatal(err)
+	}
+
+	pipeline := func(status string, updatedAt time.Time) *typespb.Pipeline {
+		return &typespb.Pipeline{
+			Id:         1,
+			Status:     status,
+			Project:    &typespb.ProjectReference{Id: 1},
+			Timestamps: &typespb.PipelineTimestamps{UpdatedAt: timestamppb.New(updatedAt)},
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+
+	summary, err := r.RecordPipelines(ctx, &servicepb.RecordPipelinesRequest{
+		Data: []*typespb.Pipeline{
+			pipeline("running", now),
+			pipeline("success", now.Add(time.Minute)),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.GetRecordedCount() != 2 {
+		t.Errorf("want 2 recorded pipelines, got %d", summary.GetRecordedCount())
+	}
+
+	// older records do not replace newer ones
+	if _, err := r.RecordPipelines(ctx, &servicepb.RecordPipelinesRequest{
+		Data: []*typespb.Pipeline{pipeline("pending", now.Add(-time.Minute))},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var status string
+	if err := r.pool.QueryRow(ctx, "SELECT data->>'status' FROM pipelines WHERE id = 1").Scan(&status); err != nil {
+		t.Fatal(err)
+	}
+	if status != "success" {
+		t.Errorf("status = %s, want success", status)
+	}
+
+	// expired artifacts are only rolled up while they are locked
+	job := &typespb.JobReference{
+		Id:       1,
+		Pipeline: &typespb.PipelineReference{Id: 1, Project: &typespb.ProjectReference{Id: 1}},
+	}
+	if _, err := r.RecordJobArtifacts(ctx, &servicepb.RecordJobArtifactsRequest{
+		Data: []*typespb.JobArtifact{
+			{Id: 1, Job: job, FileType: "trace", Size: 1, ExpireAt: timestamppb.New(now.Add(-time.Hour))},
+			{Id: 2, Job: job, FileType: "trace", Size: 20, ExpireAt: timestamppb.New(now.Add(-time.Hour)), Locked: true},
+			{Id: 3, Job: job, FileType: "trace", Size: 300, ExpireAt: timestamppb.New(now.Add(time.Hour))},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var count, size, lockedSize int64
+	if err := r.pool.QueryRow(ctx, "SELECT artifacts_count, artifacts_size, locked_size FROM project_job_artifacts_view WHERE project_id = 1 AND file_type = 'trace'").Scan(&count, &size, &lockedSize); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 || size != 320 || lockedSize != 20 {
+		t.Errorf("rollup = (%d, %d, %d), want (2, 320, 20)", count, size, lockedSize)
+	}
+}
@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+func (r *Recorder) RecordCommits(ctx context.Context, req *servicepb.RecordCommitsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "commits", req.Data, ConvertCommit)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordCoverageClasses(ctx context.Context, req *servicepb.RecordCoverageClassesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "coverage_classes", req.Data, ConvertCoverageClass)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordCoverageMethods(ctx context.Context, req *servicepb.RecordCoverageMethodsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "coverage_methods", req.Data, ConvertCoverageMethod)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordCoveragePackages(ctx context.Context, req *servicepb.RecordCoveragePackagesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "coverage_packages", req.Data, ConvertCoveragePackage)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordCoverageReports(ctx context.Context, req *servicepb.RecordCoverageReportsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "coverage_reports", req.Data, ConvertCoverageReport)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordDeployments(ctx context.Context, req *servicepb.RecordDeploymentsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "deployments", req.Data, ConvertDeployment)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordEnvironments(ctx context.Context, req *servicepb.RecordEnvironmentsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "environments", req.Data, ConvertEnvironment)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordIssues(ctx context.Context, req *servicepb.RecordIssuesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "issues", req.Data, ConvertIssue)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordJobs(ctx context.Context, req *servicepb.RecordJobsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "jobs", req.Data, ConvertJob)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordJobArtifacts(ctx context.Context, req *servicepb.RecordJobArtifactsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "job_artifacts", req.Data, ConvertJobArtifact)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMergeRequestCommits(ctx context.Context, req *servicepb.RecordMergeRequestCommitsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "merge_request_commits", req.Data, ConvertMergeRequestCommit)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMergeRequestEvents(ctx context.Context, req *servicepb.RecordMergeRequestEventsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "merge_request_events", req.Data, ConvertMergeRequestEvent)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMergeRequestNoteEvents(ctx context.Context, req *servicepb.RecordMergeRequestNoteEventsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "merge_request_note_events", req.Data, ConvertMergeRequestNoteEvent)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMergeRequests(ctx context.Context, req *servicepb.RecordMergeRequestsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "merge_requests", req.Data, ConvertMergeRequest)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMetrics(ctx context.Context, req *servicepb.RecordMetricsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "metrics", req.Data, ConvertMetric)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordPipelines(ctx context.Context, req *servicepb.RecordPipelinesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "pipelines", req.Data, ConvertPipeline)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordProjects(ctx context.Context, req *servicepb.RecordProjectsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "projects", req.Data, ConvertProject)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordReleases(ctx context.Context, req *servicepb.RecordReleasesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "releases", req.Data, ConvertRelease)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordRunners(ctx context.Context, req *servicepb.RecordRunnersRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "runners", req.Data, ConvertRunner)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordSections(ctx context.Context, req *servicepb.RecordSectionsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "sections", req.Data, ConvertSection)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordTags(ctx context.Context, req *servicepb.RecordTagsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "tags", req.Data, ConvertTag)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordTestCases(ctx context.Context, req *servicepb.RecordTestCasesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "test_cases", req.Data, ConvertTestCase)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordTestReports(ctx context.Context, req *servicepb.RecordTestReportsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "test_reports", req.Data, ConvertTestReport)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordTestSuites(ctx context.Context, req *servicepb.RecordTestSuitesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.pool, "test_suites", req.Data, ConvertTestSuite)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordTraces(ctx context.Context, req *servicepb.RecordTracesRequest) (*servicepb.RecordSummary, error) {
+	var spans []TraceSpan
+	for _, msg := range req.Data {
+		traceSpans, err := ConvertTrace(msg)
+		if err != nil {
+			return &servicepb.RecordSummary{}, fmt.Errorf("convert trace: %w", err)
+		}
+		spans = append(spans, traceSpans...)
+	}
+
+	n, err := upsert(ctx, r.pool, "traces", spans)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(r, stream)
+}
+
+func (r *Recorder) GetCapabilities(ctx context.Context, req *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	return &servicepb.Capabilities{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+		RecordKinds: []servicepb.RecordKind{
+			servicepb.RecordKind_RECORD_KIND_COMMITS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_PACKAGES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_CLASSES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_METHODS,
+			servicepb.RecordKind_RECORD_KIND_DEPLOYMENTS,
+			servicepb.RecordKind_RECORD_KIND_ENVIRONMENTS,
+			servicepb.RecordKind_RECORD_KIND_ISSUES,
+			servicepb.RecordKind_RECORD_KIND_JOBS,
+			servicepb.RecordKind_RECORD_KIND_JOB_ARTIFACTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUESTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_COMMITS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_METRICS,
+			servicepb.RecordKind_RECORD_KIND_PIPELINES,
+			servicepb.RecordKind_RECORD_KIND_PROJECTS,
+			servicepb.RecordKind_RECORD_KIND_RELEASES,
+			servicepb.RecordKind_RECORD_KIND_RUNNERS,
+			servicepb.RecordKind_RECORD_KIND_SECTIONS,
+			servicepb.RecordKind_RECORD_KIND_TAGS,
+			servicepb.RecordKind_RECORD_KIND_TEST_CASES,
+			servicepb.RecordKind_RECORD_KIND_TEST_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_TEST_SUITES,
+			servicepb.RecordKind_RECORD_KIND_TRACES,
+		},
+		RecordStream: true,
+	}, nil
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	migrate_pgx "github.com/golang-migrate/migrate/v4/database/pgx/v5"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+//go:embed db/migrations/*.sql
+var migrationsFS embed.FS
+
+// migrationsPath is the path to the migrations directory in the embedded filesystem
+const migrationsPath = "db/migrations"
+
+// RunMigrations applies the pending migrations to the database of the pool.
+func RunMigrations(pool *pgxpool.Pool) error {
+	srcDrv, err := iofs.New(migrationsFS, migrationsPath)
+	if err != nil {
+		return fmt.Errorf("create migration source driver: %w", err)
+	}
+
+	// closing the database does not close the pool
+	db := stdlib.OpenDBFromPool(pool)
+	dbDrv, err := migrate_pgx.WithInstance(db, &migrate_pgx.Config{
+		MigrationsTable: migrate_pgx.DefaultMigrationsTable,
+	})
+	if err != nil {
+		_ = db.Close()
+		return fmt.Errorf("create migration database driver: %w", err)
+	}
+	defer func() { _ = dbDrv.Close() }()
+
+	m, err := migrate.NewWithInstance("iofs", srcDrv, "pgx5", dbDrv)
+	if err != nil {
+		return fmt.Errorf("create migration: %w", err)
+	}
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+package prometheus
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+// overflowValue replaces the label values of series exceeding the limit.
+const overflowValue = "__overflow__"
+
+var (
+	pipelineDurationBuckets = []float64{30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600, 5400, 7200, 10800}
+	jobDurationBuckets      = []float64{5, 10, 30, 60, 120, 300, 600, 900, 1200, 1800, 2700, 3600}
+	queuedDurationBuckets   = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}
+)
+
+// finalStatus holds the pipeline and job statuses that do not change anymore,
+// unless the pipeline or job is retried.
+var finalStatus = map[string]bool{
+	"success":  true,
+	"failed":   true,
+	"canceled": true,
+	"skipped":  true,
+}
+
+// metrics aggregates the recorded data.
+type metrics struct {
+	mu          sync.Mutex
+	limiter     *seriesLimiter
+	pipelines   *recordSet
+	jobs        *recordSet
+	deployments *recordSet
+
+	pipelinesTotal         *prometheus.CounterVec
+	pipelineDuration       *prometheus.HistogramVec
+	pipelineQueuedDuration *prometheus.HistogramVec
+
+	jobsTotal         *prometheus.CounterVec
+	jobDuration       *prometheus.HistogramVec
+	jobQueuedDuration *prometheus.HistogramVec
+
+	deploymentsTotal   *prometheus.CounterVec
+	deploymentDuration *prometheus.HistogramVec
+
+	jobMetric *prometheus.GaugeVec
+
+	droppedSeries *prometheus.CounterVec
+}
+
+func newMetrics(maxSeries int, dedupWindow int) *metrics {
+	m := &metrics{
+		pipelines:   newRecordSet(dedupWindow),
+		jobs:        newRecordSet(dedupWindow),
+		deployments: newRecordSet(dedupWindow),
+
+		pipelinesTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_ci_pipelines_total",
+			Help: "Number of finished pipelines.",
+		}, []string{"project", "ref", "status"}),
+		pipelineDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_ci_pipeline_duration_seconds",
+			Help:    "Duration of finished pipelines.",
+			Buckets: pipelineDurationBuckets,
+		}, []string{"project", "ref", "status"}),
+		pipelineQueuedDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_ci_pipeline_queued_duration_seconds",
+			Help:    "Time finished pipelines waited in the queue.",
+			Buckets: queuedDurationBuckets,
+		}, []string{"project", "ref"}),
+
+		jobsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_ci_jobs_total",
+			Help: "Number of finished jobs.",
+		}, []string{"project", "ref", "stage", "job", "status"}),
+		jobDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_ci_job_duration_seconds",
+			Help:    "Duration of finished jobs.",
+			Buckets: jobDurationBuckets,
+		}, []string{"project", "ref", "stage", "job", "status"}),
+		jobQueuedDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_ci_job_queued_duration_seconds",
+			Help:    "Time finished jobs waited for a runner.",
+			Buckets: queuedDurationBuckets,
+		}, []string{"project", "ref", "stage", "job"}),
+
+		deploymentsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_ci_deployments_total",
+			Help: "Number of finished deployments.",
+		}, []string{"project", "environment", "status"}),
+		deploymentDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "gitlab_ci_deployment_duration_seconds",
+			Help:    "Duration of finished deployments.",
+			Buckets: jobDurationBuckets,
+		}, []string{"project", "environment", "status"}),
+
+		jobMetric: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gitlab_ci_job_log_metric",
+			Help: "Last value of the metrics embedded in job logs.",
+		}, []string{"project", "job", "name"}),
+
+		droppedSeries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitlab_exporter_recorder_dropped_series_total",
+			Help: "Number of observations folded into the overflow series because the metric reached its series limit.",
+		}, []string{"metric"}),
+	}
+	m.limiter = newSeriesLimiter(maxSeries, m.droppedSeries)
+	return m
+}
+
+func (m *metrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.pipelinesTotal,
+		m.pipelineDuration,
+		m.pipelineQueuedDuration,
+		m.jobsTotal,
+		m.jobDuration,
+		m.jobQueuedDuration,
+		m.deploymentsTotal,
+		m.deploymentDuration,
+		m.jobMetric,
+		m.droppedSeries,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *metrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, c := range m.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (m *metrics) Collect(ch chan<- prometheus.Metric) {
+	for _, c := range m.collectors() {
+		c.Collect(ch)
+	}
+}
+
+// recordPipeline counts a finished pipeline once and reports whether it did.
+func (m *metrics) recordPipeline(p *typespb.Pipeline) bool {
+	if !finalStatus[p.GetStatus()] {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.pipelines.add(p.GetId()) {
+		return false
+	}
+
+	project := p.GetProject().GetFullPath()
+	status := p.GetStatus()
+
+	lvs := m.limiter.labels("gitlab_ci_pipelines_total", []string{project, p.GetRef()}, status)
+	m.pipelinesTotal.WithLabelValues(lvs...).Inc()
+
+	if p.GetDuration() != nil {
+		lvs := m.limiter.labels("gitlab_ci_pipeline_duration_seconds", []string{project, p.GetRef()}, status)
+		m.pipelineDuration.WithLabelValues(lvs...).Observe(p.GetDuration().AsDuration().Seconds())
+	}
+	if p.GetQueuedDuration() != nil {
+		lvs := m.limiter.labels("gitlab_ci_pipeline_queued_duration_seconds", []string{project, p.GetRef()})
+		m.pipelineQueuedDuration.WithLabelValues(lvs...).Observe(p.GetQueuedDuration().AsDuration().Seconds())
+	}
+
+	return true
+}
+
+// recordJob counts a finished job once and reports whether it did.
+func (m *metrics) recordJob(j *typespb.Job) bool {
+	if !finalStatus[j.GetStatus()] {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.jobs.add(j.GetId()) {
+		return false
+	}
+
+	labels := []string{
+		j.GetPipeline().GetProject().GetFullPath(),
+		j.GetRef(),
+		j.GetStage(),
+		j.GetName(),
+	}
+	status := j.GetStatus()
+
+	lvs := m.limiter.labels("gitlab_ci_jobs_total", labels, status)
+	m.jobsTotal.WithLabelValues(lvs...).Inc()
+
+	if j.GetDuration() != nil {
+		lvs := m.limiter.labels("gitlab_ci_job_duration_seconds", labels, status)
+		m.jobDuration.WithLabelValues(lvs...).Observe(j.GetDuration().AsDuration().Seconds())
+	}
+	if j.GetQueuedDuration() != nil {
+		lvs := m.limiter.labels("gitlab_ci_job_queued_duration_seconds", labels)
+		m.jobQueuedDuration.WithLabelValues(lvs...).Observe(j.GetQueuedDuration().AsDuration().Seconds())
+	}
+
+	return true
+}
+
+// recordDeployment counts a finished deployment once and reports whether it
+// did.
+func (m *metrics) recordDeployment(d *typespb.Deployment) bool {
+	var status string
+	switch d.GetStatus() {
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS:
+		status = "success"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_FAILED:
+		status = "failed"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_CANCELED:
+		status = "canceled"
+	case typespb.DeploymentStatus_DEPLOYMENT_STATUS_SKIPPED:
+		status = "skipped"
+	default:
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.deployments.add(d.GetId()) {
+		return false
+	}
+
+	labels := []string{
+		d.GetEnvironment().GetProject().GetFullPath(),
+		d.GetEnvironment().GetName(),
+	}
+
+	lvs := m.limiter.labels("gitlab_ci_deployments_total", labels, status)
+	m.deploymentsTotal.WithLabelValues(lvs...).Inc()
+
+	ts := d.GetTimestamps()
+	if ts.GetCreatedAt() != nil && ts.GetFinishedAt() != nil {
+		duration := ts.GetFinishedAt().AsTime().Sub(ts.GetCreatedAt().AsTime())
+		lvs := m.limiter.labels("gitlab_ci_deployment_duration_seconds", labels, status)
+		m.deploymentDuration.WithLabelValues(lvs...).Observe(duration.Seconds())
+	}
+
+	return true
+}
+
+// recordMetric sets the gauge of a job log metric to its value.
+func (m *metrics) recordMetric(metric *typespb.Metric) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lvs := m.limiter.labels("gitlab_ci_job_log_metric", []string{
+		metric.GetJob().GetPipeline().GetProject().GetFullPath(),
+		metric.GetJob().GetName(),
+		metric.GetName(),
+	})
+	m.jobMetric.WithLabelValues(lvs...).Set(metric.GetValue())
+}
+
+// seriesLimiter caps the number of label sets per metric. Observations of
+// new label sets beyond the limit are folded into a single overflow series.
+type seriesLimiter struct {
+	max     int
+	series  map[string]map[string]struct{}
+	dropped *prometheus.CounterVec
+}
+
+func newSeriesLimiter(max int, dropped *prometheus.CounterVec) *seriesLimiter {
+	return &seriesLimiter{
+		max:     max,
+		series:  make(map[string]map[string]struct{}),
+		dropped: dropped,
+	}
+}
+
+// labels returns the label values to use for the given metric. The bounded
+// values, e.g. a status, are appended and kept for overflow series.
+func (l *seriesLimiter) labels(metric string, values []string, bounded ...string) []string {
+	lvs := slices.Concat(values, bounded)
+
+	series, ok := l.series[metric]
+	if !ok {
+		series = make(map[string]struct{})
+		l.series[metric] = series
+	}
+
+	key := strings.Join(lvs, "\xff")
+	if _, ok := series[key]; ok {
+		return lvs
+	}
+	if len(series) < l.max {
+		series[key] = struct{}{}
+		return lvs
+	}
+
+	l.dropped.WithLabelValues(metric).Inc()
+
+	overflow := make([]string, 0, len(lvs))
+	for range values {
+		overflow = append(overflow, overflowValue)
+	}
+	return append(overflow, bounded...)
+}
+
+// recordSet remembers the ids of the most recently recorded items.
+type recordSet struct {
+	ids   map[int64]struct{}
+	order []int64
+	next  int
+}
+
+func newRecordSet(size int) *recordSet {
+	return &recordSet{
+		ids:   make(map[int64]struct{}, size),
+		order: make([]int64, 0, size),
+	}
+}
+
+// add adds the id to the set and reports whether it was not in the set yet.
+// The oldest id is evicted if the set is full.
+func (s *recordSet) add(id int64) bool {
+	if _, ok := s.ids[id]; ok {
+		return false
+	}
+
+	if len(s.order) < cap(s.order) {
+		s.order = append(s.order, id)
+	} else {
+		delete(s.ids, s.order[s.next])
+		s.order[s.next] = id
+		s.next = (s.next + 1) % len(s.order)
+	}
+	s.ids[id] = struct{}{}
+	return true
+}
@@ -0,0 +1,212 @@
+package prometheus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+// gather returns the metric families of the registry by name.
+func gather(t *testing.T, reg *prometheus.Registry) map[string]*dto.MetricFamily {
+	t.Helper()
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	families := make(map[string]*dto.MetricFamily, len(mfs))
+	for _, mf := range mfs {
+		families[mf.GetName()] = mf
+	}
+	return families
+}
+
+// find returns the metric of the family with the given label values.
+func find(mf *dto.MetricFamily, labels map[string]string) *dto.Metric {
+	for _, m := range mf.GetMetric() {
+		match := true
+		for _, lp := range m.GetLabel() {
+			if v, ok := labels[lp.GetName()]; ok && v != lp.GetValue() {
+				match = false
+			}
+		}
+		if match {
+			return m
+		}
+	}
+	return nil
+}
+
+func newTestRecorder(t *testing.T, settings Settings) *Recorder {
+	t.Helper()
+
+	r := New("unix:///tmp/prometheus.sock")
+	if err := r.Initialize(context.Background(), settings); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestRecorder_RecordPipelines(t *testing.T) {
+	r := newTestRecorder(t, Settings{})
+	ctx := context.Background()
+
+	project := &typespb.ProjectReference{Id: 1, FullPath: "group/project"}
+	req := &servicepb.RecordPipelinesRequest{
+		Data: []*typespb.Pipeline{
+			{Id: 1, Project: project, Ref: "main", Status: "success", Duration: durationpb.New(90 * time.Second), QueuedDuration: durationpb.New(5 * time.Second)},
+			{Id: 2, Project: project, Ref: "main", Status: "failed", Duration: durationpb.New(30 * time.Second)},
+			{Id: 3, Project: project, Ref: "main", Status: "running"},
+		},
+	}
+
+	summary, err := r.RecordPipelines(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.GetRecordedCount() != 2 {
+		t.Errorf("want 2 recorded pipelines, got %d", summary.GetRecordedCount())
+	}
+
+	// records are not counted twice
+	summary, err = r.RecordPipelines(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.GetRecordedCount() != 0 {
+		t.Errorf("want no recorded pipelines, got %d", summary.GetRecordedCount())
+	}
+
+	families := gather(t, r.registry)
+
+	total := families["gitlab_ci_pipelines_total"]
+	if n := len(total.GetMetric()); n != 2 {
+		t.Fatalf("want 2 pipeline series, got %d", n)
+	}
+	m := find(total, map[string]string{"project": "group/project", "ref": "main", "status": "success"})
+	if m.GetCounter().GetValue() != 1 {
+		t.Errorf("want 1 successful pipeline, got %v", m.GetCounter().GetValue())
+	}
+
+	duration := families["gitlab_ci_pipeline_duration_seconds"]
+	m = find(duration, map[string]string{"status": "success"})
+	if m.GetHistogram().GetSampleCount() != 1 || m.GetHistogram().GetSampleSum() != 90 {
+		t.Errorf("unexpected pipeline duration histogram: %v", m.GetHistogram())
+	}
+
+	queued := families["gitlab_ci_pipeline_queued_duration_seconds"]
+	if n := queued.GetMetric()[0].GetHistogram().GetSampleCount(); n != 1 {
+		t.Errorf("want 1 queued duration sample, got %d", n)
+	}
+}
+
+func TestRecorder_RecordJobsAndDeployments(t *testing.T) {
+	r := newTestRecorder(t, Settings{})
+	ctx := context.Background()
+
+	pipeline := &typespb.PipelineReference{Id: 1, Project: &typespb.ProjectReference{Id: 1, FullPath: "group/project"}}
+	_, err := r.RecordJobs(ctx, &servicepb.RecordJobsRequest{
+		Data: []*typespb.Job{
+			{Id: 1, Name: "build", Stage: "build", Ref: "main", Status: "success", Pipeline: pipeline, Duration: durationpb.New(time.Minute)},
+			{Id: 2, Name: "test", Stage: "test", Ref: "main", Status: "failed", Pipeline: pipeline},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = r.RecordDeployments(ctx, &servicepb.RecordDeploymentsRequest{
+		Data: []*typespb.Deployment{
+			{
+				Id:          1,
+				Status:      typespb.DeploymentStatus_DEPLOYMENT_STATUS_SUCCESS,
+				Environment: &typespb.EnvironmentReference{Name: "production", Project: pipeline.GetProject()},
+				Timestamps: &typespb.DeploymentTimestamps{
+					CreatedAt:  timestamppb.New(created),
+					FinishedAt: timestamppb.New(created.Add(2 * time.Minute)),
+				},
+			},
+			{Id: 2, Status: typespb.DeploymentStatus_DEPLOYMENT_STATUS_RUNNING},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	families := gather(t, r.registry)
+
+	m := find(families["gitlab_ci_jobs_total"], map[string]string{"stage": "test", "job": "test", "status": "failed"})
+	if m.GetCounter().GetValue() != 1 {
+		t.Errorf("want 1 failed test job, got %v", m.GetCounter().GetValue())
+	}
+
+	m = find(families["gitlab_ci_deployment_duration_seconds"], map[string]string{"environment": "production", "status": "success"})
+	if m.GetHistogram().GetSampleSum() != 120 {
+		t.Errorf("want deployment duration of 120s, got %v", m.GetHistogram().GetSampleSum())
+	}
+	if n := len(families["gitlab_ci_deployments_total"].GetMetric()); n != 1 {
+		t.Errorf("want 1 deployment series, got %d", n)
+	}
+}
+
+func TestRecorder_MaxSeries(t *testing.T) {
+	r := newTestRecorder(t, Settings{MaxSeries: 2})
+
+	project := &typespb.ProjectReference{Id: 1, FullPath: "group/project"}
+	var pipelines []*typespb.Pipeline
+	for i, ref := range []string{"main", "feature-a", "feature-b", "feature-c"} {
+		pipelines = append(pipelines, &typespb.Pipeline{Id: int64(i), Project: project, Ref: ref, Status: "success"})
+	}
+	if _, err := r.RecordPipelines(context.Background(), &servicepb.RecordPipelinesRequest{Data: pipelines}); err != nil {
+		t.Fatal(err)
+	}
+
+	families := gather(t, r.registry)
+
+	total := families["gitlab_ci_pipelines_total"]
+	if n := len(total.GetMetric()); n != 3 {
+		t.Fatalf("want 2 series and the overflow series, got %d", n)
+	}
+	m := find(total, map[string]string{"project": overflowValue, "ref": overflowValue, "status": "success"})
+	if m.GetCounter().GetValue() != 2 {
+		t.Errorf("want 2 pipelines in the overflow series, got %v", m.GetCounter().GetValue())
+	}
+
+	dropped := find(families["gitlab_exporter_recorder_dropped_series_total"], map[string]string{"metric": "gitlab_ci_pipelines_total"})
+	if dropped.GetCounter().GetValue() != 2 {
+		t.Errorf("want 2 dropped observations, got %v", dropped.GetCounter().GetValue())
+	}
+}
+
+func TestRecordSet(t *testing.T) {
+	s := newRecordSet(2)
+
+	for _, id := range []int64{1, 2} {
+		if !s.add(id) {
+			t.Errorf("want %d to be added", id)
+		}
+	}
+	if s.add(1) {
+		t.Error("want 1 to be in the set")
+	}
+
+	// evicts the oldest id
+	if !s.add(3) {
+		t.Error("want 3 to be added")
+	}
+	if !s.add(1) {
+		t.Error("want 1 to be evicted")
+	}
+	if s.add(3) {
+		t.Error("want 3 to be in the set")
+	}
+}
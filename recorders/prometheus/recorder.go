@@ -0,0 +1,179 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+// Recorder keeps in-memory aggregations of the recorded data and exposes
+// them in the Prometheus exposition format.
+type Recorder struct {
+	servicepb.UnimplementedGitLabExporterServer
+
+	address  string
+	settings Settings
+
+	registry *prometheus.Registry
+	metrics  *metrics
+
+	mu     sync.Mutex
+	server *http.Server
+}
+
+// Settings holds Prometheus-specific configuration
+type Settings struct {
+	// Address to serve the /metrics endpoint on
+	ListenAddress string `yaml:"listen_address"`
+
+	// Maximum number of label sets per metric
+	MaxSeries int `yaml:"max_series"`
+
+	// Number of recent pipelines, jobs and deployments per kind that are
+	// remembered to not count them twice
+	DedupWindow int `yaml:"dedup_window"`
+}
+
+// SettingsSchema is the JSON schema of the recorder settings.
+const SettingsSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "listen_address": {
+      "type": "string",
+      "description": "Address to serve the /metrics endpoint on"
+    },
+    "max_series": {
+      "type": "integer",
+      "minimum": 1,
+      "description": "Maximum number of label sets per metric"
+    },
+    "dedup_window": {
+      "type": "integer",
+      "minimum": 1,
+      "description": "Number of recent pipelines, jobs and deployments remembered to not count them twice"
+    }
+  },
+  "additionalProperties": false
+}`
+
+// New creates a new Prometheus recorder instance
+func New(address string) *Recorder {
+	return &Recorder{
+		address: address,
+	}
+}
+
+// Name returns the recorder type name
+func (r *Recorder) Name() string {
+	return "prometheus"
+}
+
+// Initialize prepares the Prometheus recorder with configuration
+func (r *Recorder) Initialize(ctx context.Context, settings Settings) error {
+	// Set defaults
+	r.settings = Settings{
+		ListenAddress: ":9464",
+		MaxSeries:     10000,
+		DedupWindow:   100000,
+	}
+
+	// Override with options if provided
+	if settings.ListenAddress != "" {
+		r.settings.ListenAddress = settings.ListenAddress
+	}
+	if settings.MaxSeries != 0 {
+		r.settings.MaxSeries = settings.MaxSeries
+	}
+	if settings.DedupWindow != 0 {
+		r.settings.DedupWindow = settings.DedupWindow
+	}
+
+	// Validate settings
+	if r.settings.MaxSeries < 0 {
+		return fmt.Errorf("max series must be positive")
+	}
+	if r.settings.DedupWindow < 0 {
+		return fmt.Errorf("dedup window must be positive")
+	}
+
+	r.metrics = newMetrics(r.settings.MaxSeries, r.settings.DedupWindow)
+	r.registry = prometheus.NewRegistry()
+	if err := r.registry.Register(r.metrics); err != nil {
+		return fmt.Errorf("register metrics: %w", err)
+	}
+
+	return nil
+}
+
+// Handler returns the handler serving the aggregated metrics.
+func (r *Recorder) Handler() http.Handler {
+	return promhttp.HandlerFor(r.registry, promhttp.HandlerOpts{})
+}
+
+// Start serves the /metrics endpoint
+func (r *Recorder) Start(ctx context.Context) error {
+	if r.registry == nil {
+		return fmt.Errorf("recorder not initialized")
+	}
+
+	lis, err := net.Listen("tcp", r.settings.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", r.Handler())
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	r.mu.Lock()
+	r.server = srv
+	r.mu.Unlock()
+
+	go func() {
+		slog.Info("Serving metrics", "address", lis.Addr().String())
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("error serving metrics", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Stop shuts down the /metrics endpoint
+func (r *Recorder) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	srv := r.server
+	r.server = nil
+	r.mu.Unlock()
+
+	if srv != nil {
+		return srv.Shutdown(ctx)
+	}
+	return nil
+}
+
+// CheckHealth checks if the /metrics endpoint is served
+func (r *Recorder) CheckHealth(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.server == nil {
+		return fmt.Errorf("metrics server not started")
+	}
+	return nil
+}
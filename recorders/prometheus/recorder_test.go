@@ -0,0 +1,89 @@
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+)
+
+func TestNew(t *testing.T) {
+	r := New("unix:///tmp/prometheus.sock")
+	if r == nil {
+		t.Fatal("New() returned nil")
+	}
+
+	if r.Name() != "prometheus" {
+		t.Errorf("Name() = %s, want prometheus", r.Name())
+	}
+}
+
+func TestRecorder_Initialize(t *testing.T) {
+	r := New("unix:///tmp/prometheus.sock")
+	if err := r.Initialize(context.Background(), Settings{MaxSeries: 10}); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+
+	want := Settings{
+		ListenAddress: ":9464",
+		MaxSeries:     10,
+		DedupWindow:   100000,
+	}
+	if r.settings != want {
+		t.Errorf("settings = %+v, want %+v", r.settings, want)
+	}
+
+	if err := r.Initialize(context.Background(), Settings{MaxSeries: -1}); err == nil {
+		t.Error("Initialize() with negative max series should return error")
+	}
+}
+
+func TestRecorder_Lifecycle(t *testing.T) {
+	r := New("unix:///tmp/prometheus.sock")
+	ctx := context.Background()
+
+	if err := r.CheckHealth(ctx); err == nil {
+		t.Error("CheckHealth() before Start() should return error")
+	}
+
+	if err := r.Initialize(ctx, Settings{ListenAddress: "127.0.0.1:0"}); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if err := r.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := r.CheckHealth(ctx); err != nil {
+		t.Errorf("CheckHealth() error = %v", err)
+	}
+	if err := r.Stop(ctx); err != nil {
+		t.Errorf("Stop() error = %v", err)
+	}
+	if err := r.CheckHealth(ctx); err == nil {
+		t.Error("CheckHealth() after Stop() should return error")
+	}
+}
+
+func TestRecorder_Handler(t *testing.T) {
+	r := newTestRecorder(t, Settings{})
+
+	_, err := r.RecordPipelines(context.Background(), &servicepb.RecordPipelinesRequest{
+		Data: []*typespb.Pipeline{{Id: 1, Ref: "main", Status: "success"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("want status 200, got %d", w.Code)
+	}
+	if body := w.Body.String(); !strings.Contains(body, `gitlab_ci_pipelines_total{project="",ref="main",status="success"} 1`) {
+		t.Errorf("unexpected metrics response:\n%s", body)
+	}
+}
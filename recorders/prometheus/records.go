@@ -0,0 +1,66 @@
+package prometheus
+
+import (
+	"context"
+
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
+	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
+)
+
+// The recorded count is the number of records that changed the aggregations.
+// Records of unfinished or already counted pipelines, jobs and deployments
+// are accepted but ignored.
+
+func (r *Recorder) RecordPipelines(ctx context.Context, req *servicepb.RecordPipelinesRequest) (*servicepb.RecordSummary, error) {
+	var n int32
+	for _, p := range req.GetData() {
+		if r.metrics.recordPipeline(p) {
+			n++
+		}
+	}
+	return &servicepb.RecordSummary{RecordedCount: n}, nil
+}
+
+func (r *Recorder) RecordJobs(ctx context.Context, req *servicepb.RecordJobsRequest) (*servicepb.RecordSummary, error) {
+	var n int32
+	for _, j := range req.GetData() {
+		if r.metrics.recordJob(j) {
+			n++
+		}
+	}
+	return &servicepb.RecordSummary{RecordedCount: n}, nil
+}
+
+func (r *Recorder) RecordDeployments(ctx context.Context, req *servicepb.RecordDeploymentsRequest) (*servicepb.RecordSummary, error) {
+	var n int32
+	for _, d := range req.GetData() {
+		if r.metrics.recordDeployment(d) {
+			n++
+		}
+	}
+	return &servicepb.RecordSummary{RecordedCount: n}, nil
+}
+
+func (r *Recorder) RecordMetrics(ctx context.Context, req *servicepb.RecordMetricsRequest) (*servicepb.RecordSummary, error) {
+	for _, m := range req.GetData() {
+		r.metrics.recordMetric(m)
+	}
+	return &servicepb.RecordSummary{RecordedCount: int32(len(req.GetData()))}, nil
+}
+
+func (r *Recorder) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(r, stream)
+}
+
+func (r *Recorder) GetCapabilities(ctx context.Context, req *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	return &servicepb.Capabilities{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+		RecordKinds: []servicepb.RecordKind{
+			servicepb.RecordKind_RECORD_KIND_DEPLOYMENTS,
+			servicepb.RecordKind_RECORD_KIND_JOBS,
+			servicepb.RecordKind_RECORD_KIND_METRICS,
+			servicepb.RecordKind_RECORD_KIND_PIPELINES,
+		},
+		RecordStream: true,
+	}, nil
+}
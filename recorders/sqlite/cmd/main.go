@@ -24,14 +24,28 @@ func main() {
 
 func run() error {
 	var (
-		address    string
-		configPath string
+		address      string
+		configPath   string
+		configSchema bool
+
+		tlsConfig server.TLSConfig
+		tokenFile string
 	)
 
 	flag.StringVar(&address, "address", "", "Address to listen on (e.g., unix:///tmp/recorder.sock or :9090)")
 	flag.StringVar(&configPath, "config", "", "Path to configuration file")
+	flag.BoolVar(&configSchema, "config-schema", false, "Print the JSON schema of the configuration and exit")
+	flag.StringVar(&tlsConfig.CertFile, "tls-cert-file", "", "Path to the TLS server certificate, enables TLS")
+	flag.StringVar(&tlsConfig.KeyFile, "tls-key-file", "", "Path to the TLS server key")
+	flag.StringVar(&tlsConfig.ClientCAFile, "tls-client-ca-file", "", "Path to the CA bundle to verify client certificates, enables mutual TLS")
+	flag.StringVar(&tokenFile, "token-file", "", "Path to a file containing the bearer token clients must send, requires TLS")
 	flag.Parse()
 
+	if configSchema {
+		fmt.Println(sqlite.SettingsSchema)
+		return nil
+	}
+
 	if address == "" {
 		return fmt.Errorf("--address is required")
 	}
@@ -71,15 +85,24 @@ func run() error {
 	}
 
 	// Create and start gRPC server
-	srv := server.New(rec)
+	opts, err := server.OptionsFromFiles(tlsConfig, tokenFile)
+	if err != nil {
+		return fmt.Errorf("configure server: %w", err)
+	}
+	srv := server.New(rec, opts...)
 
 	slog.Info("Starting SQLite recorder", "address", address)
 
 	return srv.ListenAndServe(ctx, address)
 }
 
-// loadConfig reads the config file.
+// loadConfig reads the config file. Without a config file, the default
+// settings are used.
 func loadConfig(configPath string) ([]byte, error) {
+	if configPath == "" {
+		return nil, nil
+	}
+
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		return nil, fmt.Errorf("read config file: %w", err)
@@ -83,6 +83,44 @@ func ConvertCoverageReport(msg *typespb.CoverageReport) (CoverageReport, error)
 	}, nil
 }
 
+func ConvertJobArtifact(msg *typespb.JobArtifact) (JobArtifact, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return JobArtifact{}, err
+	}
+
+	return JobArtifact{
+		Id: int(msg.GetId()),
+
+		JobId:      int(msg.GetJob().GetId()),
+		PipelineId: int(msg.GetJob().GetPipeline().GetId()),
+		ProjectId:  int(msg.GetJob().GetPipeline().GetProject().GetId()),
+
+		FileType: msg.GetFileType(),
+		Size:     int(msg.GetSize()),
+		Locked:   msg.GetLocked(),
+		ExpireAt: msg.GetExpireAt().GetSeconds(),
+
+		Data: data,
+	}, nil
+}
+
+func ConvertCommit(msg *typespb.Commit) (Commit, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return Commit{}, err
+	}
+
+	return Commit{
+		Id:          msg.GetId(),
+		ProjectId:   int(msg.GetProjectId()),
+		Ref:         msg.GetRef(),
+		CommittedAt: msg.GetCommittedDate().GetSeconds(),
+
+		Data: data,
+	}, nil
+}
+
 func ConvertDeployment(msg *typespb.Deployment) (Deployment, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -102,6 +140,21 @@ func ConvertDeployment(msg *typespb.Deployment) (Deployment, error) {
 	}, nil
 }
 
+func ConvertEnvironment(msg *typespb.Environment) (Environment, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	return Environment{
+		Id:               int(msg.GetId()),
+		ProjectId:        int(msg.GetProject().GetId()),
+		LastDeploymentId: int(msg.GetLastDeployment().GetId()),
+
+		Data: data,
+	}, nil
+}
+
 func ConvertIssue(msg *typespb.Issue) (Issue, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -147,6 +200,23 @@ func ConvertMergeRequest(msg *typespb.MergeRequest) (MergeRequest, error) {
 	}, nil
 }
 
+func ConvertMergeRequestEvent(msg *typespb.MergeRequestEvent) (MergeRequestEvent, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return MergeRequestEvent{}, err
+	}
+
+	return MergeRequestEvent{
+		Kind:                  int(msg.GetKind()),
+		Id:                    int(msg.GetId()),
+		MergeRequestId:        int(msg.GetMergeRequest().GetId()),
+		MergeRequestIid:       int(msg.GetMergeRequest().GetIid()),
+		MergeRequestProjectId: int(msg.GetMergeRequest().GetProject().GetId()),
+
+		Data: data,
+	}, nil
+}
+
 func ConvertMergeRequestNoteEvent(msg *typespb.MergeRequestNoteEvent) (MergeRequestNoteEvent, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -209,6 +279,22 @@ func ConvertProject(msg *typespb.Project) (Project, error) {
 	}, nil
 }
 
+func ConvertRelease(msg *typespb.Release) (Release, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return Release{}, err
+	}
+
+	return Release{
+		ProjectId:  int(msg.GetProject().GetId()),
+		TagName:    msg.GetTagName(),
+		CommitSha:  msg.GetCommitSha(),
+		PipelineId: int(msg.GetPipeline().GetId()),
+
+		Data: data,
+	}, nil
+}
+
 func ConvertRunner(msg *typespb.Runner) (Runner, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -238,6 +324,21 @@ func ConvertSection(msg *typespb.Section) (Section, error) {
 	}, nil
 }
 
+func ConvertTag(msg *typespb.Tag) (Tag, error) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return Tag{}, err
+	}
+
+	return Tag{
+		ProjectId: int(msg.GetProject().GetId()),
+		Name:      msg.GetName(),
+		CommitSha: msg.GetCommitSha(),
+
+		Data: data,
+	}, nil
+}
+
 func ConvertTestCase(msg *typespb.TestCase) (TestCase, error) {
 	data, err := json.Marshal(msg)
 	if err != nil {
@@ -0,0 +1,10 @@
+-- environments
+CREATE TABLE IF NOT EXISTS environments (
+    id INTEGER PRIMARY KEY,
+    project_id INTEGER NOT NULL,
+    last_deployment_id INTEGER NOT NULL,
+
+    _data BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_environments_project ON environments(project_id);
@@ -0,0 +1,2 @@
+DROP VIEW IF EXISTS project_job_artifacts_view;
+DROP TABLE IF EXISTS job_artifacts;
@@ -0,0 +1,13 @@
+-- commits
+CREATE TABLE IF NOT EXISTS commits (
+    id TEXT NOT NULL,
+    project_id INTEGER NOT NULL,
+    ref TEXT NOT NULL,
+    committed_at INTEGER NOT NULL, -- Unix seconds
+
+    _data BLOB NOT NULL,
+
+    PRIMARY KEY (project_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_commits_ref ON commits(project_id, ref, committed_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS releases;
+DROP TABLE IF EXISTS tags;
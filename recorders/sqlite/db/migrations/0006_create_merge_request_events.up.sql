@@ -0,0 +1,14 @@
+-- merge_request_events
+CREATE TABLE IF NOT EXISTS merge_request_events (
+    kind INTEGER NOT NULL,
+    id INTEGER NOT NULL,
+    merge_request_id INTEGER NOT NULL,
+    merge_request_iid INTEGER NOT NULL,
+    merge_request_project_id INTEGER NOT NULL,
+
+    _data BLOB NOT NULL,
+
+    PRIMARY KEY (kind, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_merge_request_events_mr ON merge_request_events(merge_request_project_id, merge_request_id);
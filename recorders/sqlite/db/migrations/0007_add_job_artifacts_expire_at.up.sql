@@ -0,0 +1,55 @@
+-- job_artifacts
+-- Adds the expiry time in unix seconds (0 if artifacts never expire) next to
+-- the other columns, as values are inserted by position.
+DROP VIEW IF EXISTS project_job_artifacts_view;
+
+CREATE TABLE IF NOT EXISTS job_artifacts_new (
+    id INTEGER PRIMARY KEY,
+    job_id INTEGER NOT NULL,
+    pipeline_id INTEGER NOT NULL,
+    project_id INTEGER NOT NULL,
+    file_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    locked INTEGER NOT NULL,
+    expire_at INTEGER NOT NULL,
+
+    _data BLOB NOT NULL
+);
+
+INSERT INTO job_artifacts_new
+SELECT
+    id, job_id, pipeline_id, project_id, file_type, size, locked,
+    COALESCE(json_extract(_data, '$.expire_at.seconds'), 0),
+    _data
+FROM job_artifacts;
+
+DROP TABLE job_artifacts;
+ALTER TABLE job_artifacts_new RENAME TO job_artifacts;
+
+CREATE INDEX IF NOT EXISTS idx_job_artifacts_project ON job_artifacts(project_id);
+CREATE INDEX IF NOT EXISTS idx_job_artifacts_job ON job_artifacts(project_id, pipeline_id, job_id);
+
+-- project_job_artifacts_view
+-- Created again after rebuilding the table, leaving out expired artifacts
+-- that are not locked.
+CREATE VIEW IF NOT EXISTS project_job_artifacts_view AS
+SELECT
+    a.project_id AS project_id,
+    a.file_type AS file_type,
+    a.artifacts_count AS artifacts_count,
+    a.artifacts_size AS artifacts_size,
+    a.locked_size AS locked_size,
+    json_extract(p._data, '$.statistics.job_artifacts_size') AS project_job_artifacts_size
+FROM (
+    SELECT
+        project_id,
+        file_type,
+        COUNT(*) AS artifacts_count,
+        SUM(size) AS artifacts_size,
+        SUM(CASE WHEN locked THEN size ELSE 0 END) AS locked_size
+    FROM job_artifacts
+    WHERE locked OR expire_at = 0 OR expire_at > CAST(strftime('%s', 'now') AS INTEGER)
+    GROUP BY project_id, file_type
+) a
+LEFT JOIN projects p ON p.id = a.project_id
+;
@@ -121,6 +121,30 @@ type TestCase struct {
 	Data []byte
 }
 
+type JobArtifact struct {
+	Id int
+
+	JobId      int
+	PipelineId int
+	ProjectId  int
+
+	FileType string
+	Size     int
+	Locked   bool
+	ExpireAt int64 // Unix seconds, 0 if the artifacts never expire
+
+	Data []byte
+}
+
+type Commit struct {
+	Id          string
+	ProjectId   int
+	Ref         string
+	CommittedAt int64 // Unix seconds
+
+	Data []byte
+}
+
 type Deployment struct {
 	Id            int
 	Iid           int
@@ -133,6 +157,14 @@ type Deployment struct {
 	Data []byte
 }
 
+type Environment struct {
+	Id               int
+	ProjectId        int
+	LastDeploymentId int
+
+	Data []byte
+}
+
 type Issue struct {
 	Id        int
 	Iid       int
@@ -149,6 +181,16 @@ type MergeRequest struct {
 	Data []byte
 }
 
+type MergeRequestEvent struct {
+	Kind                  int
+	Id                    int
+	MergeRequestId        int
+	MergeRequestIid       int
+	MergeRequestProjectId int
+
+	Data []byte
+}
+
 type MergeRequestNoteEvent struct {
 	Id                    int
 	MergeRequestId        int
@@ -158,12 +200,29 @@ type MergeRequestNoteEvent struct {
 	Data []byte
 }
 
+type Release struct {
+	ProjectId  int
+	TagName    string
+	CommitSha  string
+	PipelineId int
+
+	Data []byte
+}
+
 type Runner struct {
 	Id int
 
 	Data []byte
 }
 
+type Tag struct {
+	ProjectId int
+	Name      string
+	CommitSha string
+
+	Data []byte
+}
+
 type TraceSpan struct {
 	Timestamp          uint64 // Unix Nano
 	TraceId            []byte
@@ -31,6 +31,28 @@ type Settings struct {
 	BatchSize int `yaml:"batch_size"`
 }
 
+// SettingsSchema is the JSON schema of the recorder settings.
+const SettingsSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "path": {
+      "type": "string",
+      "description": "Database file path"
+    },
+    "wal_mode": {
+      "type": "boolean",
+      "description": "Enable WAL mode for better concurrency"
+    },
+    "batch_size": {
+      "type": "integer",
+      "minimum": 1,
+      "description": "Batch size for inserts"
+    }
+  },
+  "additionalProperties": false
+}`
+
 // New creates a new SQLite recorder instance
 func New(address string) *Recorder {
 	return &Recorder{
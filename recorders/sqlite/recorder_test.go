@@ -2,8 +2,11 @@ package sqlite
 
 import (
 	"context"
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"gopkg.in/yaml.v3"
@@ -144,3 +147,29 @@ func TestRecorder_Stop_NotStarted(t *testing.T) {
 		t.Errorf("Stop() on non-started recorder should not error, got: %v", err)
 	}
 }
+
+func TestSettingsSchema(t *testing.T) {
+	var schema struct {
+		Type       string                    `json:"type"`
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal([]byte(SettingsSchema), &schema); err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+
+	if schema.Type != "object" {
+		t.Errorf("schema type = %s, want object", schema.Type)
+	}
+
+	// every setting must be described by the schema
+	typ := reflect.TypeOf(Settings{})
+	for i := range typ.NumField() {
+		name, _, _ := strings.Cut(typ.Field(i).Tag.Get("yaml"), ",")
+		if _, ok := schema.Properties[name]; !ok {
+			t.Errorf("schema is missing property %q", name)
+		}
+	}
+	if len(schema.Properties) != typ.NumField() {
+		t.Errorf("schema has %d properties, want %d", len(schema.Properties), typ.NumField())
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"go.cluttr.dev/gitlab-exporter/grpc/server"
 	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 	"google.golang.org/protobuf/proto"
 )
@@ -78,6 +79,13 @@ func record[P proto.Message, T any](ctx context.Context, db *sql.DB, table strin
 	return int32(nrows), nil
 }
 
+func (r *Recorder) RecordCommits(ctx context.Context, req *servicepb.RecordCommitsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "commits", req.Data, ConvertCommit)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
 func (r *Recorder) RecordCoverageClasses(ctx context.Context, req *servicepb.RecordCoverageClassesRequest) (*servicepb.RecordSummary, error) {
 	n, err := record(ctx, r.db, "coverage_classes", req.Data, ConvertCoverageClass)
 	return &servicepb.RecordSummary{
@@ -113,6 +121,13 @@ func (r *Recorder) RecordDeployments(ctx context.Context, req *servicepb.RecordD
 	}, err
 }
 
+func (r *Recorder) RecordEnvironments(ctx context.Context, req *servicepb.RecordEnvironmentsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "environments", req.Data, ConvertEnvironment)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
 func (r *Recorder) RecordIssues(ctx context.Context, req *servicepb.RecordIssuesRequest) (*servicepb.RecordSummary, error) {
 	n, err := record(ctx, r.db, "issues", req.Data, ConvertIssue)
 	return &servicepb.RecordSummary{
@@ -127,6 +142,20 @@ func (r *Recorder) RecordJobs(ctx context.Context, req *servicepb.RecordJobsRequ
 	}, err
 }
 
+func (r *Recorder) RecordJobArtifacts(ctx context.Context, req *servicepb.RecordJobArtifactsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "job_artifacts", req.Data, ConvertJobArtifact)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
+func (r *Recorder) RecordMergeRequestEvents(ctx context.Context, req *servicepb.RecordMergeRequestEventsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "merge_request_events", req.Data, ConvertMergeRequestEvent)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
 func (r *Recorder) RecordMergeRequestNoteEvents(ctx context.Context, req *servicepb.RecordMergeRequestNoteEventsRequest) (*servicepb.RecordSummary, error) {
 	n, err := record(ctx, r.db, "merge_request_note_events", req.Data, ConvertMergeRequestNoteEvent)
 	return &servicepb.RecordSummary{
@@ -162,6 +191,13 @@ func (r *Recorder) RecordProjects(ctx context.Context, req *servicepb.RecordProj
 	}, err
 }
 
+func (r *Recorder) RecordReleases(ctx context.Context, req *servicepb.RecordReleasesRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "releases", req.Data, ConvertRelease)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
 func (r *Recorder) RecordRunners(ctx context.Context, req *servicepb.RecordRunnersRequest) (*servicepb.RecordSummary, error) {
 	n, err := record(ctx, r.db, "runners", req.Data, ConvertRunner)
 	return &servicepb.RecordSummary{
@@ -176,6 +212,13 @@ func (r *Recorder) RecordSections(ctx context.Context, req *servicepb.RecordSect
 	}, err
 }
 
+func (r *Recorder) RecordTags(ctx context.Context, req *servicepb.RecordTagsRequest) (*servicepb.RecordSummary, error) {
+	n, err := record(ctx, r.db, "tags", req.Data, ConvertTag)
+	return &servicepb.RecordSummary{
+		RecordedCount: n,
+	}, err
+}
+
 func (r *Recorder) RecordTestCases(ctx context.Context, req *servicepb.RecordTestCasesRequest) (*servicepb.RecordSummary, error) {
 	n, err := record(ctx, r.db, "test_cases", req.Data, ConvertTestCase)
 	return &servicepb.RecordSummary{
@@ -240,3 +283,40 @@ func (r *Recorder) RecordTraces(ctx context.Context, req *servicepb.RecordTraces
 		RecordedCount: int32(nrows),
 	}, nil
 }
+
+func (r *Recorder) RecordStream(stream servicepb.GitLabExporter_RecordStreamServer) error {
+	return server.RecordStream(r, stream)
+}
+
+func (r *Recorder) GetCapabilities(ctx context.Context, req *servicepb.GetCapabilitiesRequest) (*servicepb.Capabilities, error) {
+	return &servicepb.Capabilities{
+		ProtocolVersion: servicepb.ProtocolVersion_PROTOCOL_VERSION_1,
+		RecordKinds: []servicepb.RecordKind{
+			servicepb.RecordKind_RECORD_KIND_COMMITS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_PACKAGES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_CLASSES,
+			servicepb.RecordKind_RECORD_KIND_COVERAGE_METHODS,
+			servicepb.RecordKind_RECORD_KIND_DEPLOYMENTS,
+			servicepb.RecordKind_RECORD_KIND_ENVIRONMENTS,
+			servicepb.RecordKind_RECORD_KIND_ISSUES,
+			servicepb.RecordKind_RECORD_KIND_JOBS,
+			servicepb.RecordKind_RECORD_KIND_JOB_ARTIFACTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUESTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_MERGE_REQUEST_NOTE_EVENTS,
+			servicepb.RecordKind_RECORD_KIND_METRICS,
+			servicepb.RecordKind_RECORD_KIND_PIPELINES,
+			servicepb.RecordKind_RECORD_KIND_PROJECTS,
+			servicepb.RecordKind_RECORD_KIND_RELEASES,
+			servicepb.RecordKind_RECORD_KIND_RUNNERS,
+			servicepb.RecordKind_RECORD_KIND_SECTIONS,
+			servicepb.RecordKind_RECORD_KIND_TAGS,
+			servicepb.RecordKind_RECORD_KIND_TEST_CASES,
+			servicepb.RecordKind_RECORD_KIND_TEST_REPORTS,
+			servicepb.RecordKind_RECORD_KIND_TEST_SUITES,
+			servicepb.RecordKind_RECORD_KIND_TRACES,
+		},
+		RecordStream: true,
+	}, nil
+}
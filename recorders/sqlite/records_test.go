@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"go.cluttr.dev/gitlab-exporter/protobuf/servicepb"
 	"go.cluttr.dev/gitlab-exporter/protobuf/typespb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // setupTestDB creates an in-memory database for testing
@@ -211,6 +213,263 @@ func TestRecorder_RecordIssues(t *testing.T) {
 	}
 }
 
+func TestRecorder_RecordCommits(t *testing.T) {
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	r := &Recorder{db: db}
+
+	req := &servicepb.RecordCommitsRequest{
+		Data: []*typespb.Commit{
+			{
+				Id:            "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+				ProjectId:     123,
+				Ref:           "main",
+				CommittedDate: &timestamppb.Timestamp{Seconds: 1700000000},
+				Trailers: []*typespb.CommitTrailer{
+					{Key: "Changelog", Value: "fixed"},
+				},
+			},
+			{
+				Id:        "6104942438c14ec7bd21c6cd5bd995272b3faff6",
+				ProjectId: 124,
+				Ref:       "main",
+			},
+		},
+	}
+
+	summary, err := r.RecordCommits(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RecordCommits() error = %v", err)
+	}
+
+	if summary.RecordedCount != 2 {
+		t.Errorf("RecordedCount = %d, want 2", summary.RecordedCount)
+	}
+
+	var committedAt int64
+	err = db.QueryRow("SELECT committed_at FROM commits WHERE project_id = 123").Scan(&committedAt)
+	if err != nil {
+		t.Fatalf("query commits: %v", err)
+	}
+	if committedAt != 1700000000 {
+		t.Errorf("committed_at = %d, want 1700000000", committedAt)
+	}
+}
+
+func TestRecorder_RecordReleases(t *testing.T) {
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	r := &Recorder{db: db}
+	project := &typespb.ProjectReference{Id: 123}
+
+	_, err := r.RecordTags(context.Background(), &servicepb.RecordTagsRequest{
+		Data: []*typespb.Tag{
+			{Name: "v1.0.0", Project: project, CommitSha: "a1"},
+			{Name: "v1.1.0", Project: project, CommitSha: "b2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RecordTags() error = %v", err)
+	}
+
+	summary, err := r.RecordReleases(context.Background(), &servicepb.RecordReleasesRequest{
+		Data: []*typespb.Release{
+			{
+				TagName:   "v1.1.0",
+				Project:   project,
+				CommitSha: "b2",
+				Pipeline: &typespb.PipelineReference{
+					Id:      555,
+					Project: project,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RecordReleases() error = %v", err)
+	}
+	if summary.RecordedCount != 1 {
+		t.Errorf("RecordedCount = %d, want 1", summary.RecordedCount)
+	}
+
+	var pipelineId int64
+	err = db.QueryRow(`
+		SELECT r.pipeline_id FROM releases r
+		JOIN tags t ON t.project_id = r.project_id AND t.name = r.tag_name
+		WHERE t.commit_sha = 'b2'
+	`).Scan(&pipelineId)
+	if err != nil {
+		t.Fatalf("query releases: %v", err)
+	}
+	if pipelineId != 555 {
+		t.Errorf("pipeline_id = %d, want 555", pipelineId)
+	}
+}
+
+func TestRecorder_RecordMergeRequestEvents(t *testing.T) {
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	r := &Recorder{db: db}
+	mr := &typespb.MergeRequestReference{
+		Id:      42,
+		Iid:     7,
+		Project: &typespb.ProjectReference{Id: 123},
+	}
+
+	events := []*typespb.MergeRequestEvent{
+		{Id: 1, MergeRequest: mr, Kind: typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_STATE, Action: "opened"},
+		{Id: 1, MergeRequest: mr, Kind: typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_LABEL, Action: "add", Label: "bug"},
+		{Id: 9, MergeRequest: mr, Kind: typespb.MergeRequestEventKind_MERGE_REQUEST_EVENT_KIND_APPROVAL, Action: "approved"},
+	}
+	for range 2 { // recording the same events again replaces them
+		summary, err := r.RecordMergeRequestEvents(context.Background(), &servicepb.RecordMergeRequestEventsRequest{
+			Data: events,
+		})
+		if err != nil {
+			t.Fatalf("RecordMergeRequestEvents() error = %v", err)
+		}
+		if summary.RecordedCount != 3 {
+			t.Errorf("RecordedCount = %d, want 3", summary.RecordedCount)
+		}
+	}
+
+	var count int
+	err := db.QueryRow(`
+		SELECT COUNT(*) FROM merge_request_events
+		WHERE merge_request_project_id = 123 AND merge_request_id = 42
+	`).Scan(&count)
+	if err != nil {
+		t.Fatalf("query merge request events: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("count = %d, want 3", count)
+	}
+}
+
+func TestRecorder_RecordEnvironments(t *testing.T) {
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	r := &Recorder{db: db}
+
+	req := &servicepb.RecordEnvironmentsRequest{
+		Data: []*typespb.Environment{
+			{
+				Id:    444,
+				Name:  "production",
+				State: typespb.EnvironmentState_ENVIRONMENT_STATE_AVAILABLE,
+				Project: &typespb.ProjectReference{
+					Id: 123,
+				},
+				LastDeployment: &typespb.Deployment{
+					Id: 333,
+				},
+			},
+			{
+				Id:    445,
+				Name:  "review/feature",
+				State: typespb.EnvironmentState_ENVIRONMENT_STATE_STOPPED,
+				Project: &typespb.ProjectReference{
+					Id: 123,
+				},
+			},
+		},
+	}
+
+	summary, err := r.RecordEnvironments(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RecordEnvironments() error = %v", err)
+	}
+
+	if summary.RecordedCount != 2 {
+		t.Errorf("RecordedCount = %d, want 2", summary.RecordedCount)
+	}
+}
+
+func TestRecorder_RecordJobArtifacts(t *testing.T) {
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	r := &Recorder{db: db}
+
+	_, err := r.RecordProjects(context.Background(), &servicepb.RecordProjectsRequest{
+		Data: []*typespb.Project{
+			{
+				Id: 123,
+				Statistics: &typespb.ProjectStatistics{
+					JobArtifactsSize: 1000,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RecordProjects() error = %v", err)
+	}
+
+	job := &typespb.JobReference{
+		Id: 999,
+		Pipeline: &typespb.PipelineReference{
+			Id: 789,
+			Project: &typespb.ProjectReference{
+				Id: 123,
+			},
+		},
+	}
+	req := &servicepb.RecordJobArtifactsRequest{
+		Data: []*typespb.JobArtifact{
+			{Id: 1, Job: job, FileType: "archive", Size: 300},
+			{Id: 2, Job: job, FileType: "archive", Size: 200, Locked: true},
+			{Id: 3, Job: job, FileType: "junit", Size: 10},
+		},
+	}
+
+	summary, err := r.RecordJobArtifacts(context.Background(), req)
+	if err != nil {
+		t.Fatalf("RecordJobArtifacts() error = %v", err)
+	}
+
+	if summary.RecordedCount != 3 {
+		t.Errorf("RecordedCount = %d, want 3", summary.RecordedCount)
+	}
+
+	// Verify the project rollup
+	var count, size, lockedSize, projectSize int
+	err = db.QueryRow("SELECT artifacts_count, artifacts_size, locked_size, project_job_artifacts_size FROM project_job_artifacts_view WHERE project_id = 123 AND file_type = 'archive'").Scan(&count, &size, &lockedSize, &projectSize)
+	if err != nil {
+		t.Fatalf("Failed to query rollup: %v", err)
+	}
+
+	if count != 2 || size != 500 || lockedSize != 200 || projectSize != 1000 {
+		t.Errorf("Rollup = (%d, %d, %d, %d), want (2, 500, 200, 1000)", count, size, lockedSize, projectSize)
+	}
+
+	// Expired artifacts are only rolled up while they are locked
+	expired := timestamppb.New(time.Now().Add(-time.Hour))
+	unexpired := timestamppb.New(time.Now().Add(time.Hour))
+	_, err = r.RecordJobArtifacts(context.Background(), &servicepb.RecordJobArtifactsRequest{
+		Data: []*typespb.JobArtifact{
+			{Id: 4, Job: job, FileType: "trace", Size: 1, ExpireAt: expired},
+			{Id: 5, Job: job, FileType: "trace", Size: 20, ExpireAt: expired, Locked: true},
+			{Id: 6, Job: job, FileType: "trace", Size: 300, ExpireAt: unexpired},
+		},
+	})
+	if err != nil {
+		t.Fatalf("RecordJobArtifacts() error = %v", err)
+	}
+
+	err = db.QueryRow("SELECT artifacts_count, artifacts_size, locked_size FROM project_job_artifacts_view WHERE project_id = 123 AND file_type = 'trace'").Scan(&count, &size, &lockedSize)
+	if err != nil {
+		t.Fatalf("Failed to query rollup: %v", err)
+	}
+
+	if count != 2 || size != 320 || lockedSize != 20 {
+		t.Errorf("Rollup = (%d, %d, %d), want (2, 320, 20)", count, size, lockedSize)
+	}
+}
+
 func TestRecorder_RecordMergeRequests(t *testing.T) {
 	db := setupTestDB(t)
 	defer func() { _ = db.Close() }()